@@ -5,6 +5,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/pflag"
@@ -24,6 +25,8 @@ var (
 	loglevel    int
 	imagesFile  string
 	region      string
+
+	userCertificateTTL time.Duration
 )
 
 func init() {
@@ -32,6 +35,7 @@ func init() {
 	pflag.StringVar(&region, "region", "eu-de-1", "Used for localizing image uris")
 	pflag.IntVar(&metricsPort, "metrics-port", 9100, "Lister port for metric exposition")
 	pflag.IntVar(&loglevel, "v", 0, "log level")
+	pflag.DurationVar(&userCertificateTTL, "user-certificate-ttl", apipkg.DefaultUserCertificateTTL, "Maximum lifetime of user client certificates")
 }
 
 func main() {
@@ -70,6 +74,7 @@ func main() {
 		os.Exit(1)
 	}
 	rt := apipkg.NewRuntime(namespace, kubernikusClient, k8sclient, logger)
+	rt.UserCertificateTTL = userCertificateTTL
 	if imagesFile != "" {
 		if rt.Images, err = version.NewImageRegistry(imagesFile, region); err != nil {
 			logger.Log(
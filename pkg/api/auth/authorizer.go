@@ -98,11 +98,7 @@ func (o *osloPolicyAuthorizer) Authorize(req *http.Request, principal interface{
 		requestVars[param.Name] = param.Value
 	}
 
-	allowed := o.enforcer.Enforce(operationID, policy.Context{
-		Auth:    map[string]string{"user_id": authUser.ID, "project_id": authUser.Account, "project_name": authUser.AccountName, "domain_name": authUser.Domain},
-		Roles:   authUser.Roles,
-		Request: requestVars,
-	})
+	allowed := o.enforcer.Enforce(operationID, policyContext(authUser, requestVars))
 
 	if !allowed {
 		return fmt.Errorf("authorization failed for user %s for operation %s", authUser.Name, operationID)
@@ -110,3 +106,17 @@ func (o *osloPolicyAuthorizer) Authorize(req *http.Request, principal interface{
 
 	return nil
 }
+
+// Enforce checks the principal against a policy rule that is not tied to an
+// operation, e.g. for changing individual fields of a kluster
+func (o *osloPolicyAuthorizer) Enforce(rule string, principal *models.Principal) bool {
+	return o.enforcer.Enforce(rule, policyContext(principal, map[string]string{}))
+}
+
+func policyContext(principal *models.Principal, requestVars map[string]string) policy.Context {
+	return policy.Context{
+		Auth:    map[string]string{"user_id": principal.ID, "project_id": principal.Account, "project_name": principal.AccountName, "domain_name": principal.Domain},
+		Roles:   principal.Roles,
+		Request: requestVars,
+	}
+}
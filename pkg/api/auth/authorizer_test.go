@@ -26,6 +26,14 @@ func TestAuthorizer(t *testing.T) {
 		Account: "testaccount",
 		Roles:   []string{"kubernetes_member"},
 	}
+	cloudAdmin := models.Principal{
+		ID:          "admin",
+		Name:        "Cloud Admin",
+		Domain:      "ccadmin",
+		Account:     "testaccount",
+		AccountName: "cloud_admin",
+		Roles:       []string{"kubernetes_admin"},
+	}
 	document, err := spec.Spec()
 	assert.NoError(t, err)
 	rules, err := LoadPolicy("../../../etc/policy.json")
@@ -40,4 +48,9 @@ func TestAuthorizer(t *testing.T) {
 	req = httptest.NewRequest("POST", "/api/v1/clusters", nil)
 	assert.NoError(t, authorizer.Authorize(req, &admin), "admin can create clusters")
 	assert.Error(t, authorizer.Authorize(req, &user), "user can not create clusters")
+
+	enforcer := authorizer.(*osloPolicyAuthorizer)
+	assert.True(t, enforcer.Enforce("SetTerminationProtection", &cloudAdmin), "cloud admin can change termination protection")
+	assert.True(t, enforcer.Enforce("SetTerminationProtection", &admin), "kluster admin can change termination protection")
+	assert.False(t, enforcer.Enforce("SetTerminationProtection", &user), "user can not change termination protection")
 }
@@ -0,0 +1,26 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+func TestFakeKeystone(t *testing.T) {
+	authenticate := FakeKeystone(models.Principal{ID: "dev", Account: "project", Roles: []string{"kubernetes_admin"}})
+
+	_, err := authenticate("")
+	assert.Error(t, err)
+
+	principal, err := authenticate("anything")
+	require.NoError(t, err)
+	assert.Equal(t, "project", principal.Account)
+	assert.Equal(t, []string{"kubernetes_admin"}, principal.Roles)
+
+	principal, err = authenticate("roles:member,kubernetes_member")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"member", "kubernetes_member"}, principal.Roles)
+}
@@ -0,0 +1,167 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewAllowUserCertificatesParams creates a new AllowUserCertificatesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewAllowUserCertificatesParams() *AllowUserCertificatesParams {
+	return &AllowUserCertificatesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAllowUserCertificatesParamsWithTimeout creates a new AllowUserCertificatesParams object
+// with the ability to set a timeout on a request.
+func NewAllowUserCertificatesParamsWithTimeout(timeout time.Duration) *AllowUserCertificatesParams {
+	return &AllowUserCertificatesParams{
+		timeout: timeout,
+	}
+}
+
+// NewAllowUserCertificatesParamsWithContext creates a new AllowUserCertificatesParams object
+// with the ability to set a context for a request.
+func NewAllowUserCertificatesParamsWithContext(ctx context.Context) *AllowUserCertificatesParams {
+	return &AllowUserCertificatesParams{
+		Context: ctx,
+	}
+}
+
+// NewAllowUserCertificatesParamsWithHTTPClient creates a new AllowUserCertificatesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewAllowUserCertificatesParamsWithHTTPClient(client *http.Client) *AllowUserCertificatesParams {
+	return &AllowUserCertificatesParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+AllowUserCertificatesParams contains all the parameters to send to the API endpoint
+
+	for the allow user certificates operation.
+
+	Typically these are written to a http.Request.
+*/
+type AllowUserCertificatesParams struct {
+
+	// Name.
+	Name string
+
+	// User.
+	User string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the allow user certificates params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AllowUserCertificatesParams) WithDefaults() *AllowUserCertificatesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the allow user certificates params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AllowUserCertificatesParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the allow user certificates params
+func (o *AllowUserCertificatesParams) WithTimeout(timeout time.Duration) *AllowUserCertificatesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the allow user certificates params
+func (o *AllowUserCertificatesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the allow user certificates params
+func (o *AllowUserCertificatesParams) WithContext(ctx context.Context) *AllowUserCertificatesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the allow user certificates params
+func (o *AllowUserCertificatesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the allow user certificates params
+func (o *AllowUserCertificatesParams) WithHTTPClient(client *http.Client) *AllowUserCertificatesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the allow user certificates params
+func (o *AllowUserCertificatesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithName adds the name to the allow user certificates params
+func (o *AllowUserCertificatesParams) WithName(name string) *AllowUserCertificatesParams {
+	o.SetName(name)
+	return o
+}
+
+// SetName adds the name to the allow user certificates params
+func (o *AllowUserCertificatesParams) SetName(name string) {
+	o.Name = name
+}
+
+// WithUser adds the user to the allow user certificates params
+func (o *AllowUserCertificatesParams) WithUser(user string) *AllowUserCertificatesParams {
+	o.SetUser(user)
+	return o
+}
+
+// SetUser adds the user to the allow user certificates params
+func (o *AllowUserCertificatesParams) SetUser(user string) {
+	o.User = user
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *AllowUserCertificatesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param name
+	if err := r.SetPathParam("name", o.Name); err != nil {
+		return err
+	}
+
+	// path param user
+	if err := r.SetPathParam("user", o.User); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// AllowUserCertificatesReader is a Reader for the AllowUserCertificates structure.
+type AllowUserCertificatesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *AllowUserCertificatesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewAllowUserCertificatesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewAllowUserCertificatesDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewAllowUserCertificatesOK creates a AllowUserCertificatesOK with default headers values
+func NewAllowUserCertificatesOK() *AllowUserCertificatesOK {
+	return &AllowUserCertificatesOK{}
+}
+
+/*
+AllowUserCertificatesOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type AllowUserCertificatesOK struct {
+	Payload *models.UserCertificateList
+}
+
+// IsSuccess returns true when this allow user certificates o k response has a 2xx status code
+func (o *AllowUserCertificatesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this allow user certificates o k response has a 3xx status code
+func (o *AllowUserCertificatesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this allow user certificates o k response has a 4xx status code
+func (o *AllowUserCertificatesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this allow user certificates o k response has a 5xx status code
+func (o *AllowUserCertificatesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this allow user certificates o k response a status code equal to that given
+func (o *AllowUserCertificatesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *AllowUserCertificatesOK) Error() string {
+	return fmt.Sprintf("[DELETE /api/v1/clusters/{name}/usercertificates/denied/{user}][%d] allowUserCertificatesOK  %+v", 200, o.Payload)
+}
+
+func (o *AllowUserCertificatesOK) String() string {
+	return fmt.Sprintf("[DELETE /api/v1/clusters/{name}/usercertificates/denied/{user}][%d] allowUserCertificatesOK  %+v", 200, o.Payload)
+}
+
+func (o *AllowUserCertificatesOK) GetPayload() *models.UserCertificateList {
+	return o.Payload
+}
+
+func (o *AllowUserCertificatesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.UserCertificateList)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAllowUserCertificatesDefault creates a AllowUserCertificatesDefault with default headers values
+func NewAllowUserCertificatesDefault(code int) *AllowUserCertificatesDefault {
+	return &AllowUserCertificatesDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+AllowUserCertificatesDefault describes a response with status code -1, with default header values.
+
+Error
+*/
+type AllowUserCertificatesDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the allow user certificates default response
+func (o *AllowUserCertificatesDefault) Code() int {
+	return o._statusCode
+}
+
+// IsSuccess returns true when this allow user certificates default response has a 2xx status code
+func (o *AllowUserCertificatesDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this allow user certificates default response has a 3xx status code
+func (o *AllowUserCertificatesDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this allow user certificates default response has a 4xx status code
+func (o *AllowUserCertificatesDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this allow user certificates default response has a 5xx status code
+func (o *AllowUserCertificatesDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this allow user certificates default response a status code equal to that given
+func (o *AllowUserCertificatesDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+func (o *AllowUserCertificatesDefault) Error() string {
+	return fmt.Sprintf("[DELETE /api/v1/clusters/{name}/usercertificates/denied/{user}][%d] AllowUserCertificates default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *AllowUserCertificatesDefault) String() string {
+	return fmt.Sprintf("[DELETE /api/v1/clusters/{name}/usercertificates/denied/{user}][%d] AllowUserCertificates default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *AllowUserCertificatesDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *AllowUserCertificatesDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewCreateClusterBackupParams creates a new CreateClusterBackupParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewCreateClusterBackupParams() *CreateClusterBackupParams {
+	return &CreateClusterBackupParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCreateClusterBackupParamsWithTimeout creates a new CreateClusterBackupParams object
+// with the ability to set a timeout on a request.
+func NewCreateClusterBackupParamsWithTimeout(timeout time.Duration) *CreateClusterBackupParams {
+	return &CreateClusterBackupParams{
+		timeout: timeout,
+	}
+}
+
+// NewCreateClusterBackupParamsWithContext creates a new CreateClusterBackupParams object
+// with the ability to set a context for a request.
+func NewCreateClusterBackupParamsWithContext(ctx context.Context) *CreateClusterBackupParams {
+	return &CreateClusterBackupParams{
+		Context: ctx,
+	}
+}
+
+// NewCreateClusterBackupParamsWithHTTPClient creates a new CreateClusterBackupParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewCreateClusterBackupParamsWithHTTPClient(client *http.Client) *CreateClusterBackupParams {
+	return &CreateClusterBackupParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+CreateClusterBackupParams contains all the parameters to send to the API endpoint
+
+	for the create cluster backup operation.
+
+	Typically these are written to a http.Request.
+*/
+type CreateClusterBackupParams struct {
+
+	// Account.
+	Account string
+
+	// Name.
+	Name string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the create cluster backup params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CreateClusterBackupParams) WithDefaults() *CreateClusterBackupParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the create cluster backup params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CreateClusterBackupParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the create cluster backup params
+func (o *CreateClusterBackupParams) WithTimeout(timeout time.Duration) *CreateClusterBackupParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the create cluster backup params
+func (o *CreateClusterBackupParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the create cluster backup params
+func (o *CreateClusterBackupParams) WithContext(ctx context.Context) *CreateClusterBackupParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the create cluster backup params
+func (o *CreateClusterBackupParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the create cluster backup params
+func (o *CreateClusterBackupParams) WithHTTPClient(client *http.Client) *CreateClusterBackupParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the create cluster backup params
+func (o *CreateClusterBackupParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAccount adds the account to the create cluster backup params
+func (o *CreateClusterBackupParams) WithAccount(account string) *CreateClusterBackupParams {
+	o.SetAccount(account)
+	return o
+}
+
+// SetAccount adds the account to the create cluster backup params
+func (o *CreateClusterBackupParams) SetAccount(account string) {
+	o.Account = account
+}
+
+// WithName adds the name to the create cluster backup params
+func (o *CreateClusterBackupParams) WithName(name string) *CreateClusterBackupParams {
+	o.SetName(name)
+	return o
+}
+
+// SetName adds the name to the create cluster backup params
+func (o *CreateClusterBackupParams) SetName(name string) {
+	o.Name = name
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CreateClusterBackupParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param account
+	if err := r.SetPathParam("account", o.Account); err != nil {
+		return err
+	}
+
+	// path param name
+	if err := r.SetPathParam("name", o.Name); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// CreateClusterBackupReader is a Reader for the CreateClusterBackup structure.
+type CreateClusterBackupReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CreateClusterBackupReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewCreateClusterBackupOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewCreateClusterBackupDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewCreateClusterBackupOK creates a CreateClusterBackupOK with default headers values
+func NewCreateClusterBackupOK() *CreateClusterBackupOK {
+	return &CreateClusterBackupOK{}
+}
+
+/*
+CreateClusterBackupOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type CreateClusterBackupOK struct {
+	Payload *models.EtcdSnapshotReference
+}
+
+// IsSuccess returns true when this create cluster backup o k response has a 2xx status code
+func (o *CreateClusterBackupOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this create cluster backup o k response has a 3xx status code
+func (o *CreateClusterBackupOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create cluster backup o k response has a 4xx status code
+func (o *CreateClusterBackupOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this create cluster backup o k response has a 5xx status code
+func (o *CreateClusterBackupOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create cluster backup o k response a status code equal to that given
+func (o *CreateClusterBackupOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *CreateClusterBackupOK) Error() string {
+	return fmt.Sprintf("[POST /api/v1/{account}/clusters/{name}/backups][%d] createClusterBackupOK  %+v", 200, o.Payload)
+}
+
+func (o *CreateClusterBackupOK) String() string {
+	return fmt.Sprintf("[POST /api/v1/{account}/clusters/{name}/backups][%d] createClusterBackupOK  %+v", 200, o.Payload)
+}
+
+func (o *CreateClusterBackupOK) GetPayload() *models.EtcdSnapshotReference {
+	return o.Payload
+}
+
+func (o *CreateClusterBackupOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.EtcdSnapshotReference)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateClusterBackupDefault creates a CreateClusterBackupDefault with default headers values
+func NewCreateClusterBackupDefault(code int) *CreateClusterBackupDefault {
+	return &CreateClusterBackupDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+CreateClusterBackupDefault describes a response with status code -1, with default header values.
+
+Error
+*/
+type CreateClusterBackupDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the create cluster backup default response
+func (o *CreateClusterBackupDefault) Code() int {
+	return o._statusCode
+}
+
+// IsSuccess returns true when this create cluster backup default response has a 2xx status code
+func (o *CreateClusterBackupDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this create cluster backup default response has a 3xx status code
+func (o *CreateClusterBackupDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this create cluster backup default response has a 4xx status code
+func (o *CreateClusterBackupDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this create cluster backup default response has a 5xx status code
+func (o *CreateClusterBackupDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this create cluster backup default response a status code equal to that given
+func (o *CreateClusterBackupDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+func (o *CreateClusterBackupDefault) Error() string {
+	return fmt.Sprintf("[POST /api/v1/{account}/clusters/{name}/backups][%d] CreateClusterBackup default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *CreateClusterBackupDefault) String() string {
+	return fmt.Sprintf("[POST /api/v1/{account}/clusters/{name}/backups][%d] CreateClusterBackup default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *CreateClusterBackupDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *CreateClusterBackupDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
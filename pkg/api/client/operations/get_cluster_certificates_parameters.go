@@ -0,0 +1,167 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetClusterCertificatesParams creates a new GetClusterCertificatesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetClusterCertificatesParams() *GetClusterCertificatesParams {
+	return &GetClusterCertificatesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetClusterCertificatesParamsWithTimeout creates a new GetClusterCertificatesParams object
+// with the ability to set a timeout on a request.
+func NewGetClusterCertificatesParamsWithTimeout(timeout time.Duration) *GetClusterCertificatesParams {
+	return &GetClusterCertificatesParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetClusterCertificatesParamsWithContext creates a new GetClusterCertificatesParams object
+// with the ability to set a context for a request.
+func NewGetClusterCertificatesParamsWithContext(ctx context.Context) *GetClusterCertificatesParams {
+	return &GetClusterCertificatesParams{
+		Context: ctx,
+	}
+}
+
+// NewGetClusterCertificatesParamsWithHTTPClient creates a new GetClusterCertificatesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetClusterCertificatesParamsWithHTTPClient(client *http.Client) *GetClusterCertificatesParams {
+	return &GetClusterCertificatesParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetClusterCertificatesParams contains all the parameters to send to the API endpoint
+
+	for the get cluster certificates operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetClusterCertificatesParams struct {
+
+	// Account.
+	Account string
+
+	// Name.
+	Name string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get cluster certificates params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetClusterCertificatesParams) WithDefaults() *GetClusterCertificatesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get cluster certificates params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetClusterCertificatesParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get cluster certificates params
+func (o *GetClusterCertificatesParams) WithTimeout(timeout time.Duration) *GetClusterCertificatesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get cluster certificates params
+func (o *GetClusterCertificatesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get cluster certificates params
+func (o *GetClusterCertificatesParams) WithContext(ctx context.Context) *GetClusterCertificatesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get cluster certificates params
+func (o *GetClusterCertificatesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get cluster certificates params
+func (o *GetClusterCertificatesParams) WithHTTPClient(client *http.Client) *GetClusterCertificatesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get cluster certificates params
+func (o *GetClusterCertificatesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAccount adds the account to the get cluster certificates params
+func (o *GetClusterCertificatesParams) WithAccount(account string) *GetClusterCertificatesParams {
+	o.SetAccount(account)
+	return o
+}
+
+// SetAccount adds the account to the get cluster certificates params
+func (o *GetClusterCertificatesParams) SetAccount(account string) {
+	o.Account = account
+}
+
+// WithName adds the name to the get cluster certificates params
+func (o *GetClusterCertificatesParams) WithName(name string) *GetClusterCertificatesParams {
+	o.SetName(name)
+	return o
+}
+
+// SetName adds the name to the get cluster certificates params
+func (o *GetClusterCertificatesParams) SetName(name string) {
+	o.Name = name
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetClusterCertificatesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param account
+	if err := r.SetPathParam("account", o.Account); err != nil {
+		return err
+	}
+
+	// path param name
+	if err := r.SetPathParam("name", o.Name); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// GetClusterCertificatesReader is a Reader for the GetClusterCertificates structure.
+type GetClusterCertificatesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetClusterCertificatesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetClusterCertificatesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetClusterCertificatesDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetClusterCertificatesOK creates a GetClusterCertificatesOK with default headers values
+func NewGetClusterCertificatesOK() *GetClusterCertificatesOK {
+	return &GetClusterCertificatesOK{}
+}
+
+/*
+GetClusterCertificatesOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type GetClusterCertificatesOK struct {
+	Payload []models.CertificateInfo
+}
+
+// IsSuccess returns true when this get cluster certificates o k response has a 2xx status code
+func (o *GetClusterCertificatesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get cluster certificates o k response has a 3xx status code
+func (o *GetClusterCertificatesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get cluster certificates o k response has a 4xx status code
+func (o *GetClusterCertificatesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get cluster certificates o k response has a 5xx status code
+func (o *GetClusterCertificatesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get cluster certificates o k response a status code equal to that given
+func (o *GetClusterCertificatesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *GetClusterCertificatesOK) Error() string {
+	return fmt.Sprintf("[GET /api/v1/{account}/clusters/{name}/certificates][%d] getClusterCertificatesOK  %+v", 200, o.Payload)
+}
+
+func (o *GetClusterCertificatesOK) String() string {
+	return fmt.Sprintf("[GET /api/v1/{account}/clusters/{name}/certificates][%d] getClusterCertificatesOK  %+v", 200, o.Payload)
+}
+
+func (o *GetClusterCertificatesOK) GetPayload() []models.CertificateInfo {
+	return o.Payload
+}
+
+func (o *GetClusterCertificatesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetClusterCertificatesDefault creates a GetClusterCertificatesDefault with default headers values
+func NewGetClusterCertificatesDefault(code int) *GetClusterCertificatesDefault {
+	return &GetClusterCertificatesDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetClusterCertificatesDefault describes a response with status code -1, with default header values.
+
+Error
+*/
+type GetClusterCertificatesDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the get cluster certificates default response
+func (o *GetClusterCertificatesDefault) Code() int {
+	return o._statusCode
+}
+
+// IsSuccess returns true when this get cluster certificates default response has a 2xx status code
+func (o *GetClusterCertificatesDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get cluster certificates default response has a 3xx status code
+func (o *GetClusterCertificatesDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get cluster certificates default response has a 4xx status code
+func (o *GetClusterCertificatesDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get cluster certificates default response has a 5xx status code
+func (o *GetClusterCertificatesDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get cluster certificates default response a status code equal to that given
+func (o *GetClusterCertificatesDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+func (o *GetClusterCertificatesDefault) Error() string {
+	return fmt.Sprintf("[GET /api/v1/{account}/clusters/{name}/certificates][%d] GetClusterCertificates default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetClusterCertificatesDefault) String() string {
+	return fmt.Sprintf("[GET /api/v1/{account}/clusters/{name}/certificates][%d] GetClusterCertificates default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetClusterCertificatesDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetClusterCertificatesDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
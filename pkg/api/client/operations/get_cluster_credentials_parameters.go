@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewGetClusterCredentialsParams creates a new GetClusterCredentialsParams object,
@@ -64,6 +65,12 @@ type GetClusterCredentialsParams struct {
 	// Name.
 	Name string
 
+	/* TTL.
+
+	   Lifetime of the client certificate in seconds. Limited by the API, defaults to the maximum.
+	*/
+	TTL *int64
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -128,6 +135,17 @@ func (o *GetClusterCredentialsParams) SetName(name string) {
 	o.Name = name
 }
 
+// WithTTL adds the ttl to the get cluster credentials params
+func (o *GetClusterCredentialsParams) WithTTL(ttl *int64) *GetClusterCredentialsParams {
+	o.SetTTL(ttl)
+	return o
+}
+
+// SetTTL adds the ttl to the get cluster credentials params
+func (o *GetClusterCredentialsParams) SetTTL(ttl *int64) {
+	o.TTL = ttl
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *GetClusterCredentialsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -141,6 +159,23 @@ func (o *GetClusterCredentialsParams) WriteToRequest(r runtime.ClientRequest, re
 		return err
 	}
 
+	if o.TTL != nil {
+
+		// query param ttl
+		var qrTTL int64
+
+		if o.TTL != nil {
+			qrTTL = *o.TTL
+		}
+		qTTL := swag.FormatInt64(qrTTL)
+		if qTTL != "" {
+
+			if err := r.SetQueryParam("ttl", qTTL); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
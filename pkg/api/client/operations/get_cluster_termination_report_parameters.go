@@ -0,0 +1,167 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetClusterTerminationReportParams creates a new GetClusterTerminationReportParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetClusterTerminationReportParams() *GetClusterTerminationReportParams {
+	return &GetClusterTerminationReportParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetClusterTerminationReportParamsWithTimeout creates a new GetClusterTerminationReportParams object
+// with the ability to set a timeout on a request.
+func NewGetClusterTerminationReportParamsWithTimeout(timeout time.Duration) *GetClusterTerminationReportParams {
+	return &GetClusterTerminationReportParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetClusterTerminationReportParamsWithContext creates a new GetClusterTerminationReportParams object
+// with the ability to set a context for a request.
+func NewGetClusterTerminationReportParamsWithContext(ctx context.Context) *GetClusterTerminationReportParams {
+	return &GetClusterTerminationReportParams{
+		Context: ctx,
+	}
+}
+
+// NewGetClusterTerminationReportParamsWithHTTPClient creates a new GetClusterTerminationReportParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetClusterTerminationReportParamsWithHTTPClient(client *http.Client) *GetClusterTerminationReportParams {
+	return &GetClusterTerminationReportParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetClusterTerminationReportParams contains all the parameters to send to the API endpoint
+
+	for the get cluster termination report operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetClusterTerminationReportParams struct {
+
+	// Account.
+	Account string
+
+	// Name.
+	Name string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get cluster termination report params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetClusterTerminationReportParams) WithDefaults() *GetClusterTerminationReportParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get cluster termination report params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetClusterTerminationReportParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get cluster termination report params
+func (o *GetClusterTerminationReportParams) WithTimeout(timeout time.Duration) *GetClusterTerminationReportParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get cluster termination report params
+func (o *GetClusterTerminationReportParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get cluster termination report params
+func (o *GetClusterTerminationReportParams) WithContext(ctx context.Context) *GetClusterTerminationReportParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get cluster termination report params
+func (o *GetClusterTerminationReportParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get cluster termination report params
+func (o *GetClusterTerminationReportParams) WithHTTPClient(client *http.Client) *GetClusterTerminationReportParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get cluster termination report params
+func (o *GetClusterTerminationReportParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAccount adds the account to the get cluster termination report params
+func (o *GetClusterTerminationReportParams) WithAccount(account string) *GetClusterTerminationReportParams {
+	o.SetAccount(account)
+	return o
+}
+
+// SetAccount adds the account to the get cluster termination report params
+func (o *GetClusterTerminationReportParams) SetAccount(account string) {
+	o.Account = account
+}
+
+// WithName adds the name to the get cluster termination report params
+func (o *GetClusterTerminationReportParams) WithName(name string) *GetClusterTerminationReportParams {
+	o.SetName(name)
+	return o
+}
+
+// SetName adds the name to the get cluster termination report params
+func (o *GetClusterTerminationReportParams) SetName(name string) {
+	o.Name = name
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetClusterTerminationReportParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param account
+	if err := r.SetPathParam("account", o.Account); err != nil {
+		return err
+	}
+
+	// path param name
+	if err := r.SetPathParam("name", o.Name); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// GetClusterTerminationReportReader is a Reader for the GetClusterTerminationReport structure.
+type GetClusterTerminationReportReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetClusterTerminationReportReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetClusterTerminationReportOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetClusterTerminationReportDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetClusterTerminationReportOK creates a GetClusterTerminationReportOK with default headers values
+func NewGetClusterTerminationReportOK() *GetClusterTerminationReportOK {
+	return &GetClusterTerminationReportOK{}
+}
+
+/*
+GetClusterTerminationReportOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type GetClusterTerminationReportOK struct {
+	Payload *models.TerminationReport
+}
+
+// IsSuccess returns true when this get cluster termination report o k response has a 2xx status code
+func (o *GetClusterTerminationReportOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get cluster termination report o k response has a 3xx status code
+func (o *GetClusterTerminationReportOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get cluster termination report o k response has a 4xx status code
+func (o *GetClusterTerminationReportOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get cluster termination report o k response has a 5xx status code
+func (o *GetClusterTerminationReportOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get cluster termination report o k response a status code equal to that given
+func (o *GetClusterTerminationReportOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *GetClusterTerminationReportOK) Error() string {
+	return fmt.Sprintf("[GET /api/v1/{account}/clusters/{name}/terminationreport][%d] getClusterTerminationReportOK  %+v", 200, o.Payload)
+}
+
+func (o *GetClusterTerminationReportOK) String() string {
+	return fmt.Sprintf("[GET /api/v1/{account}/clusters/{name}/terminationreport][%d] getClusterTerminationReportOK  %+v", 200, o.Payload)
+}
+
+func (o *GetClusterTerminationReportOK) GetPayload() *models.TerminationReport {
+	return o.Payload
+}
+
+func (o *GetClusterTerminationReportOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.TerminationReport)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetClusterTerminationReportDefault creates a GetClusterTerminationReportDefault with default headers values
+func NewGetClusterTerminationReportDefault(code int) *GetClusterTerminationReportDefault {
+	return &GetClusterTerminationReportDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetClusterTerminationReportDefault describes a response with status code -1, with default header values.
+
+Error
+*/
+type GetClusterTerminationReportDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the get cluster termination report default response
+func (o *GetClusterTerminationReportDefault) Code() int {
+	return o._statusCode
+}
+
+// IsSuccess returns true when this get cluster termination report default response has a 2xx status code
+func (o *GetClusterTerminationReportDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get cluster termination report default response has a 3xx status code
+func (o *GetClusterTerminationReportDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get cluster termination report default response has a 4xx status code
+func (o *GetClusterTerminationReportDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get cluster termination report default response has a 5xx status code
+func (o *GetClusterTerminationReportDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get cluster termination report default response a status code equal to that given
+func (o *GetClusterTerminationReportDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+func (o *GetClusterTerminationReportDefault) Error() string {
+	return fmt.Sprintf("[GET /api/v1/{account}/clusters/{name}/terminationreport][%d] GetClusterTerminationReport default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetClusterTerminationReportDefault) String() string {
+	return fmt.Sprintf("[GET /api/v1/{account}/clusters/{name}/terminationreport][%d] GetClusterTerminationReport default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetClusterTerminationReportDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetClusterTerminationReportDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListClusterBackupsParams creates a new ListClusterBackupsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListClusterBackupsParams() *ListClusterBackupsParams {
+	return &ListClusterBackupsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListClusterBackupsParamsWithTimeout creates a new ListClusterBackupsParams object
+// with the ability to set a timeout on a request.
+func NewListClusterBackupsParamsWithTimeout(timeout time.Duration) *ListClusterBackupsParams {
+	return &ListClusterBackupsParams{
+		timeout: timeout,
+	}
+}
+
+// NewListClusterBackupsParamsWithContext creates a new ListClusterBackupsParams object
+// with the ability to set a context for a request.
+func NewListClusterBackupsParamsWithContext(ctx context.Context) *ListClusterBackupsParams {
+	return &ListClusterBackupsParams{
+		Context: ctx,
+	}
+}
+
+// NewListClusterBackupsParamsWithHTTPClient creates a new ListClusterBackupsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListClusterBackupsParamsWithHTTPClient(client *http.Client) *ListClusterBackupsParams {
+	return &ListClusterBackupsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ListClusterBackupsParams contains all the parameters to send to the API endpoint
+
+	for the list cluster backups operation.
+
+	Typically these are written to a http.Request.
+*/
+type ListClusterBackupsParams struct {
+
+	// Account.
+	Account string
+
+	// Name.
+	Name string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list cluster backups params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListClusterBackupsParams) WithDefaults() *ListClusterBackupsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list cluster backups params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListClusterBackupsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list cluster backups params
+func (o *ListClusterBackupsParams) WithTimeout(timeout time.Duration) *ListClusterBackupsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list cluster backups params
+func (o *ListClusterBackupsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list cluster backups params
+func (o *ListClusterBackupsParams) WithContext(ctx context.Context) *ListClusterBackupsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list cluster backups params
+func (o *ListClusterBackupsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list cluster backups params
+func (o *ListClusterBackupsParams) WithHTTPClient(client *http.Client) *ListClusterBackupsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list cluster backups params
+func (o *ListClusterBackupsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAccount adds the account to the list cluster backups params
+func (o *ListClusterBackupsParams) WithAccount(account string) *ListClusterBackupsParams {
+	o.SetAccount(account)
+	return o
+}
+
+// SetAccount adds the account to the list cluster backups params
+func (o *ListClusterBackupsParams) SetAccount(account string) {
+	o.Account = account
+}
+
+// WithName adds the name to the list cluster backups params
+func (o *ListClusterBackupsParams) WithName(name string) *ListClusterBackupsParams {
+	o.SetName(name)
+	return o
+}
+
+// SetName adds the name to the list cluster backups params
+func (o *ListClusterBackupsParams) SetName(name string) {
+	o.Name = name
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListClusterBackupsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param account
+	if err := r.SetPathParam("account", o.Account); err != nil {
+		return err
+	}
+
+	// path param name
+	if err := r.SetPathParam("name", o.Name); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
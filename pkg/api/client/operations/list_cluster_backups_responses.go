@@ -0,0 +1,175 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// ListClusterBackupsReader is a Reader for the ListClusterBackups structure.
+type ListClusterBackupsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListClusterBackupsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListClusterBackupsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListClusterBackupsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListClusterBackupsOK creates a ListClusterBackupsOK with default headers values
+func NewListClusterBackupsOK() *ListClusterBackupsOK {
+	return &ListClusterBackupsOK{}
+}
+
+/*
+ListClusterBackupsOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ListClusterBackupsOK struct {
+	Payload []models.EtcdSnapshot
+}
+
+// IsSuccess returns true when this list cluster backups o k response has a 2xx status code
+func (o *ListClusterBackupsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list cluster backups o k response has a 3xx status code
+func (o *ListClusterBackupsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list cluster backups o k response has a 4xx status code
+func (o *ListClusterBackupsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list cluster backups o k response has a 5xx status code
+func (o *ListClusterBackupsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list cluster backups o k response a status code equal to that given
+func (o *ListClusterBackupsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *ListClusterBackupsOK) Error() string {
+	return fmt.Sprintf("[GET /api/v1/{account}/clusters/{name}/backups][%d] listClusterBackupsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListClusterBackupsOK) String() string {
+	return fmt.Sprintf("[GET /api/v1/{account}/clusters/{name}/backups][%d] listClusterBackupsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListClusterBackupsOK) GetPayload() []models.EtcdSnapshot {
+	return o.Payload
+}
+
+func (o *ListClusterBackupsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListClusterBackupsDefault creates a ListClusterBackupsDefault with default headers values
+func NewListClusterBackupsDefault(code int) *ListClusterBackupsDefault {
+	return &ListClusterBackupsDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+ListClusterBackupsDefault describes a response with status code -1, with default header values.
+
+Error
+*/
+type ListClusterBackupsDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the list cluster backups default response
+func (o *ListClusterBackupsDefault) Code() int {
+	return o._statusCode
+}
+
+// IsSuccess returns true when this list cluster backups default response has a 2xx status code
+func (o *ListClusterBackupsDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this list cluster backups default response has a 3xx status code
+func (o *ListClusterBackupsDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this list cluster backups default response has a 4xx status code
+func (o *ListClusterBackupsDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this list cluster backups default response has a 5xx status code
+func (o *ListClusterBackupsDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this list cluster backups default response a status code equal to that given
+func (o *ListClusterBackupsDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+func (o *ListClusterBackupsDefault) Error() string {
+	return fmt.Sprintf("[GET /api/v1/{account}/clusters/{name}/backups][%d] ListClusterBackups default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListClusterBackupsDefault) String() string {
+	return fmt.Sprintf("[GET /api/v1/{account}/clusters/{name}/backups][%d] ListClusterBackups default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListClusterBackupsDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListClusterBackupsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
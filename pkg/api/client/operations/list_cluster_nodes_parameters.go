@@ -0,0 +1,148 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListClusterNodesParams creates a new ListClusterNodesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListClusterNodesParams() *ListClusterNodesParams {
+	return &ListClusterNodesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListClusterNodesParamsWithTimeout creates a new ListClusterNodesParams object
+// with the ability to set a timeout on a request.
+func NewListClusterNodesParamsWithTimeout(timeout time.Duration) *ListClusterNodesParams {
+	return &ListClusterNodesParams{
+		timeout: timeout,
+	}
+}
+
+// NewListClusterNodesParamsWithContext creates a new ListClusterNodesParams object
+// with the ability to set a context for a request.
+func NewListClusterNodesParamsWithContext(ctx context.Context) *ListClusterNodesParams {
+	return &ListClusterNodesParams{
+		Context: ctx,
+	}
+}
+
+// NewListClusterNodesParamsWithHTTPClient creates a new ListClusterNodesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListClusterNodesParamsWithHTTPClient(client *http.Client) *ListClusterNodesParams {
+	return &ListClusterNodesParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ListClusterNodesParams contains all the parameters to send to the API endpoint
+
+	for the list cluster nodes operation.
+
+	Typically these are written to a http.Request.
+*/
+type ListClusterNodesParams struct {
+
+	// Name.
+	Name string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list cluster nodes params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListClusterNodesParams) WithDefaults() *ListClusterNodesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list cluster nodes params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListClusterNodesParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list cluster nodes params
+func (o *ListClusterNodesParams) WithTimeout(timeout time.Duration) *ListClusterNodesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list cluster nodes params
+func (o *ListClusterNodesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list cluster nodes params
+func (o *ListClusterNodesParams) WithContext(ctx context.Context) *ListClusterNodesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list cluster nodes params
+func (o *ListClusterNodesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list cluster nodes params
+func (o *ListClusterNodesParams) WithHTTPClient(client *http.Client) *ListClusterNodesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list cluster nodes params
+func (o *ListClusterNodesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithName adds the name to the list cluster nodes params
+func (o *ListClusterNodesParams) WithName(name string) *ListClusterNodesParams {
+	o.SetName(name)
+	return o
+}
+
+// SetName adds the name to the list cluster nodes params
+func (o *ListClusterNodesParams) SetName(name string) {
+	o.Name = name
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListClusterNodesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param name
+	if err := r.SetPathParam("name", o.Name); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
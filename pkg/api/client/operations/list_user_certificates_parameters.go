@@ -0,0 +1,148 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListUserCertificatesParams creates a new ListUserCertificatesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListUserCertificatesParams() *ListUserCertificatesParams {
+	return &ListUserCertificatesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListUserCertificatesParamsWithTimeout creates a new ListUserCertificatesParams object
+// with the ability to set a timeout on a request.
+func NewListUserCertificatesParamsWithTimeout(timeout time.Duration) *ListUserCertificatesParams {
+	return &ListUserCertificatesParams{
+		timeout: timeout,
+	}
+}
+
+// NewListUserCertificatesParamsWithContext creates a new ListUserCertificatesParams object
+// with the ability to set a context for a request.
+func NewListUserCertificatesParamsWithContext(ctx context.Context) *ListUserCertificatesParams {
+	return &ListUserCertificatesParams{
+		Context: ctx,
+	}
+}
+
+// NewListUserCertificatesParamsWithHTTPClient creates a new ListUserCertificatesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListUserCertificatesParamsWithHTTPClient(client *http.Client) *ListUserCertificatesParams {
+	return &ListUserCertificatesParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ListUserCertificatesParams contains all the parameters to send to the API endpoint
+
+	for the list user certificates operation.
+
+	Typically these are written to a http.Request.
+*/
+type ListUserCertificatesParams struct {
+
+	// Name.
+	Name string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list user certificates params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListUserCertificatesParams) WithDefaults() *ListUserCertificatesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list user certificates params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListUserCertificatesParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list user certificates params
+func (o *ListUserCertificatesParams) WithTimeout(timeout time.Duration) *ListUserCertificatesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list user certificates params
+func (o *ListUserCertificatesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list user certificates params
+func (o *ListUserCertificatesParams) WithContext(ctx context.Context) *ListUserCertificatesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list user certificates params
+func (o *ListUserCertificatesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list user certificates params
+func (o *ListUserCertificatesParams) WithHTTPClient(client *http.Client) *ListUserCertificatesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list user certificates params
+func (o *ListUserCertificatesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithName adds the name to the list user certificates params
+func (o *ListUserCertificatesParams) WithName(name string) *ListUserCertificatesParams {
+	o.SetName(name)
+	return o
+}
+
+// SetName adds the name to the list user certificates params
+func (o *ListUserCertificatesParams) SetName(name string) {
+	o.Name = name
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListUserCertificatesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param name
+	if err := r.SetPathParam("name", o.Name); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// ListUserCertificatesReader is a Reader for the ListUserCertificates structure.
+type ListUserCertificatesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListUserCertificatesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListUserCertificatesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListUserCertificatesDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListUserCertificatesOK creates a ListUserCertificatesOK with default headers values
+func NewListUserCertificatesOK() *ListUserCertificatesOK {
+	return &ListUserCertificatesOK{}
+}
+
+/*
+ListUserCertificatesOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ListUserCertificatesOK struct {
+	Payload *models.UserCertificateList
+}
+
+// IsSuccess returns true when this list user certificates o k response has a 2xx status code
+func (o *ListUserCertificatesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list user certificates o k response has a 3xx status code
+func (o *ListUserCertificatesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list user certificates o k response has a 4xx status code
+func (o *ListUserCertificatesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list user certificates o k response has a 5xx status code
+func (o *ListUserCertificatesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list user certificates o k response a status code equal to that given
+func (o *ListUserCertificatesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *ListUserCertificatesOK) Error() string {
+	return fmt.Sprintf("[GET /api/v1/clusters/{name}/usercertificates][%d] listUserCertificatesOK  %+v", 200, o.Payload)
+}
+
+func (o *ListUserCertificatesOK) String() string {
+	return fmt.Sprintf("[GET /api/v1/clusters/{name}/usercertificates][%d] listUserCertificatesOK  %+v", 200, o.Payload)
+}
+
+func (o *ListUserCertificatesOK) GetPayload() *models.UserCertificateList {
+	return o.Payload
+}
+
+func (o *ListUserCertificatesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.UserCertificateList)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListUserCertificatesDefault creates a ListUserCertificatesDefault with default headers values
+func NewListUserCertificatesDefault(code int) *ListUserCertificatesDefault {
+	return &ListUserCertificatesDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+ListUserCertificatesDefault describes a response with status code -1, with default header values.
+
+Error
+*/
+type ListUserCertificatesDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the list user certificates default response
+func (o *ListUserCertificatesDefault) Code() int {
+	return o._statusCode
+}
+
+// IsSuccess returns true when this list user certificates default response has a 2xx status code
+func (o *ListUserCertificatesDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this list user certificates default response has a 3xx status code
+func (o *ListUserCertificatesDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this list user certificates default response has a 4xx status code
+func (o *ListUserCertificatesDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this list user certificates default response has a 5xx status code
+func (o *ListUserCertificatesDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this list user certificates default response a status code equal to that given
+func (o *ListUserCertificatesDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+func (o *ListUserCertificatesDefault) Error() string {
+	return fmt.Sprintf("[GET /api/v1/clusters/{name}/usercertificates][%d] ListUserCertificates default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListUserCertificatesDefault) String() string {
+	return fmt.Sprintf("[GET /api/v1/clusters/{name}/usercertificates][%d] ListUserCertificates default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListUserCertificatesDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListUserCertificatesDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -30,14 +30,20 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	AllowUserCertificates(params *AllowUserCertificatesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AllowUserCertificatesOK, error)
+
 	CreateCluster(params *CreateClusterParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateClusterCreated, error)
 
+	CreateClusterBackup(params *CreateClusterBackupParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateClusterBackupOK, error)
+
 	GetAuthCallback(params *GetAuthCallbackParams, opts ...ClientOption) (*GetAuthCallbackOK, error)
 
 	GetAuthLogin(params *GetAuthLoginParams, opts ...ClientOption) error
 
 	GetBootstrapConfig(params *GetBootstrapConfigParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetBootstrapConfigOK, error)
 
+	GetClusterCertificates(params *GetClusterCertificatesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetClusterCertificatesOK, error)
+
 	GetClusterCredentials(params *GetClusterCredentialsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetClusterCredentialsOK, error)
 
 	GetClusterCredentialsOIDC(params *GetClusterCredentialsOIDCParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetClusterCredentialsOIDCOK, error)
@@ -48,6 +54,8 @@ type ClientService interface {
 
 	GetClusterKubeadmSecret(params *GetClusterKubeadmSecretParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetClusterKubeadmSecretOK, error)
 
+	GetClusterTerminationReport(params *GetClusterTerminationReportParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetClusterTerminationReportOK, error)
+
 	GetClusterValues(params *GetClusterValuesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetClusterValuesOK, error)
 
 	GetOpenstackMetadata(params *GetOpenstackMetadataParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetOpenstackMetadataOK, error)
@@ -56,17 +64,69 @@ type ClientService interface {
 
 	ListAPIVersions(params *ListAPIVersionsParams, opts ...ClientOption) (*ListAPIVersionsOK, error)
 
+	ListClusterBackups(params *ListClusterBackupsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListClusterBackupsOK, error)
+
+	ListClusterNodes(params *ListClusterNodesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListClusterNodesOK, error)
+
 	ListClusters(params *ListClustersParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListClustersOK, error)
 
+	ListUserCertificates(params *ListUserCertificatesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListUserCertificatesOK, error)
+
+	RestoreClusterBackup(params *RestoreClusterBackupParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RestoreClusterBackupOK, error)
+
+	RevokeUserCertificates(params *RevokeUserCertificatesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RevokeUserCertificatesOK, error)
+
+	RotateClusterCA(params *RotateClusterCAParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RotateClusterCAOK, error)
+
 	ShowCluster(params *ShowClusterParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ShowClusterOK, error)
 
-	TerminateCluster(params *TerminateClusterParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TerminateClusterAccepted, error)
+	TerminateCluster(params *TerminateClusterParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TerminateClusterOK, *TerminateClusterAccepted, error)
+
+	UndeleteCluster(params *UndeleteClusterParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*UndeleteClusterOK, error)
 
 	UpdateCluster(params *UpdateClusterParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*UpdateClusterOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+AllowUserCertificates removes a user from the deny list
+*/
+func (a *Client) AllowUserCertificates(params *AllowUserCertificatesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AllowUserCertificatesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAllowUserCertificatesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "AllowUserCertificates",
+		Method:             "DELETE",
+		PathPattern:        "/api/v1/clusters/{name}/usercertificates/denied/{user}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &AllowUserCertificatesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AllowUserCertificatesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*AllowUserCertificatesDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 CreateCluster creates a cluster
 */
@@ -105,6 +165,44 @@ func (a *Client) CreateCluster(params *CreateClusterParams, authInfo runtime.Cli
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+CreateClusterBackup takes a full etcd snapshot of the cluster and wait until it is stored admin only
+*/
+func (a *Client) CreateClusterBackup(params *CreateClusterBackupParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateClusterBackupOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateClusterBackupParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "CreateClusterBackup",
+		Method:             "POST",
+		PathPattern:        "/api/v1/{account}/clusters/{name}/backups",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &CreateClusterBackupReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CreateClusterBackupOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*CreateClusterBackupDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 GetAuthCallback callbacks for oauth result
 */
@@ -211,6 +309,44 @@ func (a *Client) GetBootstrapConfig(params *GetBootstrapConfigParams, authInfo r
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+GetClusterCertificates lists the certificates and c as of the cluster with their expiry admin only
+*/
+func (a *Client) GetClusterCertificates(params *GetClusterCertificatesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetClusterCertificatesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetClusterCertificatesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetClusterCertificates",
+		Method:             "GET",
+		PathPattern:        "/api/v1/{account}/clusters/{name}/certificates",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &GetClusterCertificatesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetClusterCertificatesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetClusterCertificatesDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 GetClusterCredentials gets user specific credentials to access the cluster
 */
@@ -401,6 +537,44 @@ func (a *Client) GetClusterKubeadmSecret(params *GetClusterKubeadmSecretParams,
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+GetClusterTerminationReport gets open stack resources left behind by a terminated cluster admin only
+*/
+func (a *Client) GetClusterTerminationReport(params *GetClusterTerminationReportParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetClusterTerminationReportOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetClusterTerminationReportParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetClusterTerminationReport",
+		Method:             "GET",
+		PathPattern:        "/api/v1/{account}/clusters/{name}/terminationreport",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &GetClusterTerminationReportReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetClusterTerminationReportOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetClusterTerminationReportDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 GetClusterValues gets values for cluster chart admin only
 */
@@ -553,6 +727,82 @@ func (a *Client) ListAPIVersions(params *ListAPIVersionsParams, opts ...ClientOp
 	panic(msg)
 }
 
+/*
+ListClusterBackups lists the etcd snapshots available for restoring the cluster admin only
+*/
+func (a *Client) ListClusterBackups(params *ListClusterBackupsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListClusterBackupsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListClusterBackupsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ListClusterBackups",
+		Method:             "GET",
+		PathPattern:        "/api/v1/{account}/clusters/{name}/backups",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListClusterBackupsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListClusterBackupsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListClusterBackupsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+ListClusterNodes lists the servers and kubernetes nodes of the cluster side by side
+*/
+func (a *Client) ListClusterNodes(params *ListClusterNodesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListClusterNodesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListClusterNodesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ListClusterNodes",
+		Method:             "GET",
+		PathPattern:        "/api/v1/clusters/{name}/nodes",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListClusterNodesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListClusterNodesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListClusterNodesDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 ListClusters lists available clusters
 */
@@ -591,6 +841,158 @@ func (a *Client) ListClusters(params *ListClustersParams, authInfo runtime.Clien
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+ListUserCertificates lists the client certificates issued to users and the denied users
+*/
+func (a *Client) ListUserCertificates(params *ListUserCertificatesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListUserCertificatesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListUserCertificatesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ListUserCertificates",
+		Method:             "GET",
+		PathPattern:        "/api/v1/clusters/{name}/usercertificates",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListUserCertificatesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListUserCertificatesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListUserCertificatesDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+RestoreClusterBackup restores the etcd of the cluster to a snapshot admin only
+*/
+func (a *Client) RestoreClusterBackup(params *RestoreClusterBackupParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RestoreClusterBackupOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRestoreClusterBackupParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "RestoreClusterBackup",
+		Method:             "POST",
+		PathPattern:        "/api/v1/{account}/clusters/{name}/restore",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &RestoreClusterBackupReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RestoreClusterBackupOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RestoreClusterBackupDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+RevokeUserCertificates revokes all user certificates by replacing the users c a and optionally deny users new ones
+*/
+func (a *Client) RevokeUserCertificates(params *RevokeUserCertificatesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RevokeUserCertificatesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRevokeUserCertificatesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "RevokeUserCertificates",
+		Method:             "POST",
+		PathPattern:        "/api/v1/clusters/{name}/usercertificates/revoke",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &RevokeUserCertificatesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RevokeUserCertificatesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RevokeUserCertificatesDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+RotateClusterCA starts a c a rotation or advance a manual one to its next phase admin only
+*/
+func (a *Client) RotateClusterCA(params *RotateClusterCAParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RotateClusterCAOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRotateClusterCAParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "RotateClusterCA",
+		Method:             "POST",
+		PathPattern:        "/api/v1/{account}/clusters/{name}/carotation",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &RotateClusterCAReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RotateClusterCAOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RotateClusterCADefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 ShowCluster shows the specified cluster
 */
@@ -632,7 +1034,7 @@ func (a *Client) ShowCluster(params *ShowClusterParams, authInfo runtime.ClientA
 /*
 TerminateCluster terminates the specified cluster
 */
-func (a *Client) TerminateCluster(params *TerminateClusterParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TerminateClusterAccepted, error) {
+func (a *Client) TerminateCluster(params *TerminateClusterParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TerminateClusterOK, *TerminateClusterAccepted, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewTerminateClusterParams()
@@ -654,16 +1056,56 @@ func (a *Client) TerminateCluster(params *TerminateClusterParams, authInfo runti
 		opt(op)
 	}
 
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch value := result.(type) {
+	case *TerminateClusterOK:
+		return value, nil, nil
+	case *TerminateClusterAccepted:
+		return nil, value, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*TerminateClusterDefault)
+	return nil, nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+UndeleteCluster cancels the scheduled deletion of the specified cluster
+*/
+func (a *Client) UndeleteCluster(params *UndeleteClusterParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*UndeleteClusterOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUndeleteClusterParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "UndeleteCluster",
+		Method:             "POST",
+		PathPattern:        "/api/v1/clusters/{name}/undelete",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &UndeleteClusterReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
 	result, err := a.transport.Submit(op)
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*TerminateClusterAccepted)
+	success, ok := result.(*UndeleteClusterOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*TerminateClusterDefault)
+	unexpectedSuccess := result.(*UndeleteClusterDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
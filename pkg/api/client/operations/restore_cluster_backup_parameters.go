@@ -0,0 +1,188 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// NewRestoreClusterBackupParams creates a new RestoreClusterBackupParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewRestoreClusterBackupParams() *RestoreClusterBackupParams {
+	return &RestoreClusterBackupParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewRestoreClusterBackupParamsWithTimeout creates a new RestoreClusterBackupParams object
+// with the ability to set a timeout on a request.
+func NewRestoreClusterBackupParamsWithTimeout(timeout time.Duration) *RestoreClusterBackupParams {
+	return &RestoreClusterBackupParams{
+		timeout: timeout,
+	}
+}
+
+// NewRestoreClusterBackupParamsWithContext creates a new RestoreClusterBackupParams object
+// with the ability to set a context for a request.
+func NewRestoreClusterBackupParamsWithContext(ctx context.Context) *RestoreClusterBackupParams {
+	return &RestoreClusterBackupParams{
+		Context: ctx,
+	}
+}
+
+// NewRestoreClusterBackupParamsWithHTTPClient creates a new RestoreClusterBackupParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewRestoreClusterBackupParamsWithHTTPClient(client *http.Client) *RestoreClusterBackupParams {
+	return &RestoreClusterBackupParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+RestoreClusterBackupParams contains all the parameters to send to the API endpoint
+
+	for the restore cluster backup operation.
+
+	Typically these are written to a http.Request.
+*/
+type RestoreClusterBackupParams struct {
+
+	// Account.
+	Account string
+
+	// Body.
+	Body *models.EtcdRestoreRequest
+
+	// Name.
+	Name string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the restore cluster backup params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RestoreClusterBackupParams) WithDefaults() *RestoreClusterBackupParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the restore cluster backup params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RestoreClusterBackupParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the restore cluster backup params
+func (o *RestoreClusterBackupParams) WithTimeout(timeout time.Duration) *RestoreClusterBackupParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the restore cluster backup params
+func (o *RestoreClusterBackupParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the restore cluster backup params
+func (o *RestoreClusterBackupParams) WithContext(ctx context.Context) *RestoreClusterBackupParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the restore cluster backup params
+func (o *RestoreClusterBackupParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the restore cluster backup params
+func (o *RestoreClusterBackupParams) WithHTTPClient(client *http.Client) *RestoreClusterBackupParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the restore cluster backup params
+func (o *RestoreClusterBackupParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAccount adds the account to the restore cluster backup params
+func (o *RestoreClusterBackupParams) WithAccount(account string) *RestoreClusterBackupParams {
+	o.SetAccount(account)
+	return o
+}
+
+// SetAccount adds the account to the restore cluster backup params
+func (o *RestoreClusterBackupParams) SetAccount(account string) {
+	o.Account = account
+}
+
+// WithBody adds the body to the restore cluster backup params
+func (o *RestoreClusterBackupParams) WithBody(body *models.EtcdRestoreRequest) *RestoreClusterBackupParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the restore cluster backup params
+func (o *RestoreClusterBackupParams) SetBody(body *models.EtcdRestoreRequest) {
+	o.Body = body
+}
+
+// WithName adds the name to the restore cluster backup params
+func (o *RestoreClusterBackupParams) WithName(name string) *RestoreClusterBackupParams {
+	o.SetName(name)
+	return o
+}
+
+// SetName adds the name to the restore cluster backup params
+func (o *RestoreClusterBackupParams) SetName(name string) {
+	o.Name = name
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *RestoreClusterBackupParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param account
+	if err := r.SetPathParam("account", o.Account); err != nil {
+		return err
+	}
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param name
+	if err := r.SetPathParam("name", o.Name); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
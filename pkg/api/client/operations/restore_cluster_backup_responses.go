@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// RestoreClusterBackupReader is a Reader for the RestoreClusterBackup structure.
+type RestoreClusterBackupReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RestoreClusterBackupReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewRestoreClusterBackupOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewRestoreClusterBackupDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewRestoreClusterBackupOK creates a RestoreClusterBackupOK with default headers values
+func NewRestoreClusterBackupOK() *RestoreClusterBackupOK {
+	return &RestoreClusterBackupOK{}
+}
+
+/*
+RestoreClusterBackupOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type RestoreClusterBackupOK struct {
+	Payload *models.EtcdRestoreStatus
+}
+
+// IsSuccess returns true when this restore cluster backup o k response has a 2xx status code
+func (o *RestoreClusterBackupOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this restore cluster backup o k response has a 3xx status code
+func (o *RestoreClusterBackupOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this restore cluster backup o k response has a 4xx status code
+func (o *RestoreClusterBackupOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this restore cluster backup o k response has a 5xx status code
+func (o *RestoreClusterBackupOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this restore cluster backup o k response a status code equal to that given
+func (o *RestoreClusterBackupOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *RestoreClusterBackupOK) Error() string {
+	return fmt.Sprintf("[POST /api/v1/{account}/clusters/{name}/restore][%d] restoreClusterBackupOK  %+v", 200, o.Payload)
+}
+
+func (o *RestoreClusterBackupOK) String() string {
+	return fmt.Sprintf("[POST /api/v1/{account}/clusters/{name}/restore][%d] restoreClusterBackupOK  %+v", 200, o.Payload)
+}
+
+func (o *RestoreClusterBackupOK) GetPayload() *models.EtcdRestoreStatus {
+	return o.Payload
+}
+
+func (o *RestoreClusterBackupOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.EtcdRestoreStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRestoreClusterBackupDefault creates a RestoreClusterBackupDefault with default headers values
+func NewRestoreClusterBackupDefault(code int) *RestoreClusterBackupDefault {
+	return &RestoreClusterBackupDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+RestoreClusterBackupDefault describes a response with status code -1, with default header values.
+
+Error
+*/
+type RestoreClusterBackupDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the restore cluster backup default response
+func (o *RestoreClusterBackupDefault) Code() int {
+	return o._statusCode
+}
+
+// IsSuccess returns true when this restore cluster backup default response has a 2xx status code
+func (o *RestoreClusterBackupDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this restore cluster backup default response has a 3xx status code
+func (o *RestoreClusterBackupDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this restore cluster backup default response has a 4xx status code
+func (o *RestoreClusterBackupDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this restore cluster backup default response has a 5xx status code
+func (o *RestoreClusterBackupDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this restore cluster backup default response a status code equal to that given
+func (o *RestoreClusterBackupDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+func (o *RestoreClusterBackupDefault) Error() string {
+	return fmt.Sprintf("[POST /api/v1/{account}/clusters/{name}/restore][%d] RestoreClusterBackup default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *RestoreClusterBackupDefault) String() string {
+	return fmt.Sprintf("[POST /api/v1/{account}/clusters/{name}/restore][%d] RestoreClusterBackup default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *RestoreClusterBackupDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *RestoreClusterBackupDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// NewRevokeUserCertificatesParams creates a new RevokeUserCertificatesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewRevokeUserCertificatesParams() *RevokeUserCertificatesParams {
+	return &RevokeUserCertificatesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewRevokeUserCertificatesParamsWithTimeout creates a new RevokeUserCertificatesParams object
+// with the ability to set a timeout on a request.
+func NewRevokeUserCertificatesParamsWithTimeout(timeout time.Duration) *RevokeUserCertificatesParams {
+	return &RevokeUserCertificatesParams{
+		timeout: timeout,
+	}
+}
+
+// NewRevokeUserCertificatesParamsWithContext creates a new RevokeUserCertificatesParams object
+// with the ability to set a context for a request.
+func NewRevokeUserCertificatesParamsWithContext(ctx context.Context) *RevokeUserCertificatesParams {
+	return &RevokeUserCertificatesParams{
+		Context: ctx,
+	}
+}
+
+// NewRevokeUserCertificatesParamsWithHTTPClient creates a new RevokeUserCertificatesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewRevokeUserCertificatesParamsWithHTTPClient(client *http.Client) *RevokeUserCertificatesParams {
+	return &RevokeUserCertificatesParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+RevokeUserCertificatesParams contains all the parameters to send to the API endpoint
+
+	for the revoke user certificates operation.
+
+	Typically these are written to a http.Request.
+*/
+type RevokeUserCertificatesParams struct {
+
+	// Body.
+	Body *models.UserCertificateRevocation
+
+	// Name.
+	Name string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the revoke user certificates params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RevokeUserCertificatesParams) WithDefaults() *RevokeUserCertificatesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the revoke user certificates params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RevokeUserCertificatesParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the revoke user certificates params
+func (o *RevokeUserCertificatesParams) WithTimeout(timeout time.Duration) *RevokeUserCertificatesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the revoke user certificates params
+func (o *RevokeUserCertificatesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the revoke user certificates params
+func (o *RevokeUserCertificatesParams) WithContext(ctx context.Context) *RevokeUserCertificatesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the revoke user certificates params
+func (o *RevokeUserCertificatesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the revoke user certificates params
+func (o *RevokeUserCertificatesParams) WithHTTPClient(client *http.Client) *RevokeUserCertificatesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the revoke user certificates params
+func (o *RevokeUserCertificatesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the revoke user certificates params
+func (o *RevokeUserCertificatesParams) WithBody(body *models.UserCertificateRevocation) *RevokeUserCertificatesParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the revoke user certificates params
+func (o *RevokeUserCertificatesParams) SetBody(body *models.UserCertificateRevocation) {
+	o.Body = body
+}
+
+// WithName adds the name to the revoke user certificates params
+func (o *RevokeUserCertificatesParams) WithName(name string) *RevokeUserCertificatesParams {
+	o.SetName(name)
+	return o
+}
+
+// SetName adds the name to the revoke user certificates params
+func (o *RevokeUserCertificatesParams) SetName(name string) {
+	o.Name = name
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *RevokeUserCertificatesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param name
+	if err := r.SetPathParam("name", o.Name); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
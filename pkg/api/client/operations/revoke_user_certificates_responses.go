@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// RevokeUserCertificatesReader is a Reader for the RevokeUserCertificates structure.
+type RevokeUserCertificatesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RevokeUserCertificatesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewRevokeUserCertificatesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewRevokeUserCertificatesDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewRevokeUserCertificatesOK creates a RevokeUserCertificatesOK with default headers values
+func NewRevokeUserCertificatesOK() *RevokeUserCertificatesOK {
+	return &RevokeUserCertificatesOK{}
+}
+
+/*
+RevokeUserCertificatesOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type RevokeUserCertificatesOK struct {
+	Payload *models.UserCertificateList
+}
+
+// IsSuccess returns true when this revoke user certificates o k response has a 2xx status code
+func (o *RevokeUserCertificatesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this revoke user certificates o k response has a 3xx status code
+func (o *RevokeUserCertificatesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this revoke user certificates o k response has a 4xx status code
+func (o *RevokeUserCertificatesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this revoke user certificates o k response has a 5xx status code
+func (o *RevokeUserCertificatesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this revoke user certificates o k response a status code equal to that given
+func (o *RevokeUserCertificatesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *RevokeUserCertificatesOK) Error() string {
+	return fmt.Sprintf("[POST /api/v1/clusters/{name}/usercertificates/revoke][%d] revokeUserCertificatesOK  %+v", 200, o.Payload)
+}
+
+func (o *RevokeUserCertificatesOK) String() string {
+	return fmt.Sprintf("[POST /api/v1/clusters/{name}/usercertificates/revoke][%d] revokeUserCertificatesOK  %+v", 200, o.Payload)
+}
+
+func (o *RevokeUserCertificatesOK) GetPayload() *models.UserCertificateList {
+	return o.Payload
+}
+
+func (o *RevokeUserCertificatesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.UserCertificateList)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRevokeUserCertificatesDefault creates a RevokeUserCertificatesDefault with default headers values
+func NewRevokeUserCertificatesDefault(code int) *RevokeUserCertificatesDefault {
+	return &RevokeUserCertificatesDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+RevokeUserCertificatesDefault describes a response with status code -1, with default header values.
+
+Error
+*/
+type RevokeUserCertificatesDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the revoke user certificates default response
+func (o *RevokeUserCertificatesDefault) Code() int {
+	return o._statusCode
+}
+
+// IsSuccess returns true when this revoke user certificates default response has a 2xx status code
+func (o *RevokeUserCertificatesDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this revoke user certificates default response has a 3xx status code
+func (o *RevokeUserCertificatesDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this revoke user certificates default response has a 4xx status code
+func (o *RevokeUserCertificatesDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this revoke user certificates default response has a 5xx status code
+func (o *RevokeUserCertificatesDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this revoke user certificates default response a status code equal to that given
+func (o *RevokeUserCertificatesDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+func (o *RevokeUserCertificatesDefault) Error() string {
+	return fmt.Sprintf("[POST /api/v1/clusters/{name}/usercertificates/revoke][%d] RevokeUserCertificates default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *RevokeUserCertificatesDefault) String() string {
+	return fmt.Sprintf("[POST /api/v1/clusters/{name}/usercertificates/revoke][%d] RevokeUserCertificates default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *RevokeUserCertificatesDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *RevokeUserCertificatesDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
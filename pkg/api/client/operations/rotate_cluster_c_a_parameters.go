@@ -0,0 +1,188 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// NewRotateClusterCAParams creates a new RotateClusterCAParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewRotateClusterCAParams() *RotateClusterCAParams {
+	return &RotateClusterCAParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewRotateClusterCAParamsWithTimeout creates a new RotateClusterCAParams object
+// with the ability to set a timeout on a request.
+func NewRotateClusterCAParamsWithTimeout(timeout time.Duration) *RotateClusterCAParams {
+	return &RotateClusterCAParams{
+		timeout: timeout,
+	}
+}
+
+// NewRotateClusterCAParamsWithContext creates a new RotateClusterCAParams object
+// with the ability to set a context for a request.
+func NewRotateClusterCAParamsWithContext(ctx context.Context) *RotateClusterCAParams {
+	return &RotateClusterCAParams{
+		Context: ctx,
+	}
+}
+
+// NewRotateClusterCAParamsWithHTTPClient creates a new RotateClusterCAParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewRotateClusterCAParamsWithHTTPClient(client *http.Client) *RotateClusterCAParams {
+	return &RotateClusterCAParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+RotateClusterCAParams contains all the parameters to send to the API endpoint
+
+	for the rotate cluster c a operation.
+
+	Typically these are written to a http.Request.
+*/
+type RotateClusterCAParams struct {
+
+	// Account.
+	Account string
+
+	// Body.
+	Body *models.CARotationRequest
+
+	// Name.
+	Name string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the rotate cluster c a params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RotateClusterCAParams) WithDefaults() *RotateClusterCAParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the rotate cluster c a params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RotateClusterCAParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the rotate cluster c a params
+func (o *RotateClusterCAParams) WithTimeout(timeout time.Duration) *RotateClusterCAParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the rotate cluster c a params
+func (o *RotateClusterCAParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the rotate cluster c a params
+func (o *RotateClusterCAParams) WithContext(ctx context.Context) *RotateClusterCAParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the rotate cluster c a params
+func (o *RotateClusterCAParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the rotate cluster c a params
+func (o *RotateClusterCAParams) WithHTTPClient(client *http.Client) *RotateClusterCAParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the rotate cluster c a params
+func (o *RotateClusterCAParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAccount adds the account to the rotate cluster c a params
+func (o *RotateClusterCAParams) WithAccount(account string) *RotateClusterCAParams {
+	o.SetAccount(account)
+	return o
+}
+
+// SetAccount adds the account to the rotate cluster c a params
+func (o *RotateClusterCAParams) SetAccount(account string) {
+	o.Account = account
+}
+
+// WithBody adds the body to the rotate cluster c a params
+func (o *RotateClusterCAParams) WithBody(body *models.CARotationRequest) *RotateClusterCAParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the rotate cluster c a params
+func (o *RotateClusterCAParams) SetBody(body *models.CARotationRequest) {
+	o.Body = body
+}
+
+// WithName adds the name to the rotate cluster c a params
+func (o *RotateClusterCAParams) WithName(name string) *RotateClusterCAParams {
+	o.SetName(name)
+	return o
+}
+
+// SetName adds the name to the rotate cluster c a params
+func (o *RotateClusterCAParams) SetName(name string) {
+	o.Name = name
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *RotateClusterCAParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param account
+	if err := r.SetPathParam("account", o.Account); err != nil {
+		return err
+	}
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param name
+	if err := r.SetPathParam("name", o.Name); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// RotateClusterCAReader is a Reader for the RotateClusterCA structure.
+type RotateClusterCAReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RotateClusterCAReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewRotateClusterCAOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewRotateClusterCADefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewRotateClusterCAOK creates a RotateClusterCAOK with default headers values
+func NewRotateClusterCAOK() *RotateClusterCAOK {
+	return &RotateClusterCAOK{}
+}
+
+/*
+RotateClusterCAOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type RotateClusterCAOK struct {
+	Payload *models.CARotationStatus
+}
+
+// IsSuccess returns true when this rotate cluster c a o k response has a 2xx status code
+func (o *RotateClusterCAOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this rotate cluster c a o k response has a 3xx status code
+func (o *RotateClusterCAOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this rotate cluster c a o k response has a 4xx status code
+func (o *RotateClusterCAOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this rotate cluster c a o k response has a 5xx status code
+func (o *RotateClusterCAOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this rotate cluster c a o k response a status code equal to that given
+func (o *RotateClusterCAOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *RotateClusterCAOK) Error() string {
+	return fmt.Sprintf("[POST /api/v1/{account}/clusters/{name}/carotation][%d] rotateClusterCAOK  %+v", 200, o.Payload)
+}
+
+func (o *RotateClusterCAOK) String() string {
+	return fmt.Sprintf("[POST /api/v1/{account}/clusters/{name}/carotation][%d] rotateClusterCAOK  %+v", 200, o.Payload)
+}
+
+func (o *RotateClusterCAOK) GetPayload() *models.CARotationStatus {
+	return o.Payload
+}
+
+func (o *RotateClusterCAOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.CARotationStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRotateClusterCADefault creates a RotateClusterCADefault with default headers values
+func NewRotateClusterCADefault(code int) *RotateClusterCADefault {
+	return &RotateClusterCADefault{
+		_statusCode: code,
+	}
+}
+
+/*
+RotateClusterCADefault describes a response with status code -1, with default header values.
+
+Error
+*/
+type RotateClusterCADefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the rotate cluster c a default response
+func (o *RotateClusterCADefault) Code() int {
+	return o._statusCode
+}
+
+// IsSuccess returns true when this rotate cluster c a default response has a 2xx status code
+func (o *RotateClusterCADefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this rotate cluster c a default response has a 3xx status code
+func (o *RotateClusterCADefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this rotate cluster c a default response has a 4xx status code
+func (o *RotateClusterCADefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this rotate cluster c a default response has a 5xx status code
+func (o *RotateClusterCADefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this rotate cluster c a default response a status code equal to that given
+func (o *RotateClusterCADefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+func (o *RotateClusterCADefault) Error() string {
+	return fmt.Sprintf("[POST /api/v1/{account}/clusters/{name}/carotation][%d] RotateClusterCA default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *RotateClusterCADefault) String() string {
+	return fmt.Sprintf("[POST /api/v1/{account}/clusters/{name}/carotation][%d] RotateClusterCA default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *RotateClusterCADefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *RotateClusterCADefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -52,6 +52,11 @@ ShowClusterOK describes a response with status code 200, with default header val
 OK
 */
 type ShowClusterOK struct {
+
+	/* Version of the cluster, pass it as If-Match to update only this version
+	 */
+	ETag string
+
 	Payload *models.Kluster
 }
 
@@ -94,6 +99,13 @@ func (o *ShowClusterOK) GetPayload() *models.Kluster {
 
 func (o *ShowClusterOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
 	o.Payload = new(models.Kluster)
 
 	// response payload
@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewTerminateClusterParams creates a new TerminateClusterParams object,
@@ -61,6 +62,18 @@ TerminateClusterParams contains all the parameters to send to the API endpoint
 */
 type TerminateClusterParams struct {
 
+	/* DryRun.
+
+	   Only list what would be deleted
+	*/
+	DryRun *bool
+
+	/* GracePeriod.
+
+	   Scale the cluster to zero and delete it after this duration (e.g. 72h) unless it is undeleted
+	*/
+	GracePeriod *string
+
 	// Name.
 	Name string
 
@@ -117,6 +130,28 @@ func (o *TerminateClusterParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithDryRun adds the dryRun to the terminate cluster params
+func (o *TerminateClusterParams) WithDryRun(dryRun *bool) *TerminateClusterParams {
+	o.SetDryRun(dryRun)
+	return o
+}
+
+// SetDryRun adds the dryRun to the terminate cluster params
+func (o *TerminateClusterParams) SetDryRun(dryRun *bool) {
+	o.DryRun = dryRun
+}
+
+// WithGracePeriod adds the gracePeriod to the terminate cluster params
+func (o *TerminateClusterParams) WithGracePeriod(gracePeriod *string) *TerminateClusterParams {
+	o.SetGracePeriod(gracePeriod)
+	return o
+}
+
+// SetGracePeriod adds the gracePeriod to the terminate cluster params
+func (o *TerminateClusterParams) SetGracePeriod(gracePeriod *string) {
+	o.GracePeriod = gracePeriod
+}
+
 // WithName adds the name to the terminate cluster params
 func (o *TerminateClusterParams) WithName(name string) *TerminateClusterParams {
 	o.SetName(name)
@@ -136,6 +171,40 @@ func (o *TerminateClusterParams) WriteToRequest(r runtime.ClientRequest, reg str
 	}
 	var res []error
 
+	if o.DryRun != nil {
+
+		// query param dryRun
+		var qrDryRun bool
+
+		if o.DryRun != nil {
+			qrDryRun = *o.DryRun
+		}
+		qDryRun := swag.FormatBool(qrDryRun)
+		if qDryRun != "" {
+
+			if err := r.SetQueryParam("dryRun", qDryRun); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.GracePeriod != nil {
+
+		// query param gracePeriod
+		var qrGracePeriod string
+
+		if o.GracePeriod != nil {
+			qrGracePeriod = *o.GracePeriod
+		}
+		qGracePeriod := qrGracePeriod
+		if qGracePeriod != "" {
+
+			if err := r.SetQueryParam("gracePeriod", qGracePeriod); err != nil {
+				return err
+			}
+		}
+	}
+
 	// path param name
 	if err := r.SetPathParam("name", o.Name); err != nil {
 		return err
@@ -23,6 +23,12 @@ type TerminateClusterReader struct {
 // ReadResponse reads a server response into the received o.
 func (o *TerminateClusterReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
 	switch response.Code() {
+	case 200:
+		result := NewTerminateClusterOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
 	case 202:
 		result := NewTerminateClusterAccepted()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -41,6 +47,69 @@ func (o *TerminateClusterReader) ReadResponse(response runtime.ClientResponse, c
 	}
 }
 
+// NewTerminateClusterOK creates a TerminateClusterOK with default headers values
+func NewTerminateClusterOK() *TerminateClusterOK {
+	return &TerminateClusterOK{}
+}
+
+/*
+TerminateClusterOK describes a response with status code 200, with default header values.
+
+Dry run
+*/
+type TerminateClusterOK struct {
+	Payload *models.TerminationPlan
+}
+
+// IsSuccess returns true when this terminate cluster o k response has a 2xx status code
+func (o *TerminateClusterOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this terminate cluster o k response has a 3xx status code
+func (o *TerminateClusterOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this terminate cluster o k response has a 4xx status code
+func (o *TerminateClusterOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this terminate cluster o k response has a 5xx status code
+func (o *TerminateClusterOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this terminate cluster o k response a status code equal to that given
+func (o *TerminateClusterOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *TerminateClusterOK) Error() string {
+	return fmt.Sprintf("[DELETE /api/v1/clusters/{name}][%d] terminateClusterOK  %+v", 200, o.Payload)
+}
+
+func (o *TerminateClusterOK) String() string {
+	return fmt.Sprintf("[DELETE /api/v1/clusters/{name}][%d] terminateClusterOK  %+v", 200, o.Payload)
+}
+
+func (o *TerminateClusterOK) GetPayload() *models.TerminationPlan {
+	return o.Payload
+}
+
+func (o *TerminateClusterOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.TerminationPlan)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewTerminateClusterAccepted creates a TerminateClusterAccepted with default headers values
 func NewTerminateClusterAccepted() *TerminateClusterAccepted {
 	return &TerminateClusterAccepted{}
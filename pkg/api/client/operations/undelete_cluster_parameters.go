@@ -0,0 +1,148 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewUndeleteClusterParams creates a new UndeleteClusterParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewUndeleteClusterParams() *UndeleteClusterParams {
+	return &UndeleteClusterParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewUndeleteClusterParamsWithTimeout creates a new UndeleteClusterParams object
+// with the ability to set a timeout on a request.
+func NewUndeleteClusterParamsWithTimeout(timeout time.Duration) *UndeleteClusterParams {
+	return &UndeleteClusterParams{
+		timeout: timeout,
+	}
+}
+
+// NewUndeleteClusterParamsWithContext creates a new UndeleteClusterParams object
+// with the ability to set a context for a request.
+func NewUndeleteClusterParamsWithContext(ctx context.Context) *UndeleteClusterParams {
+	return &UndeleteClusterParams{
+		Context: ctx,
+	}
+}
+
+// NewUndeleteClusterParamsWithHTTPClient creates a new UndeleteClusterParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewUndeleteClusterParamsWithHTTPClient(client *http.Client) *UndeleteClusterParams {
+	return &UndeleteClusterParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+UndeleteClusterParams contains all the parameters to send to the API endpoint
+
+	for the undelete cluster operation.
+
+	Typically these are written to a http.Request.
+*/
+type UndeleteClusterParams struct {
+
+	// Name.
+	Name string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the undelete cluster params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *UndeleteClusterParams) WithDefaults() *UndeleteClusterParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the undelete cluster params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *UndeleteClusterParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the undelete cluster params
+func (o *UndeleteClusterParams) WithTimeout(timeout time.Duration) *UndeleteClusterParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the undelete cluster params
+func (o *UndeleteClusterParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the undelete cluster params
+func (o *UndeleteClusterParams) WithContext(ctx context.Context) *UndeleteClusterParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the undelete cluster params
+func (o *UndeleteClusterParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the undelete cluster params
+func (o *UndeleteClusterParams) WithHTTPClient(client *http.Client) *UndeleteClusterParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the undelete cluster params
+func (o *UndeleteClusterParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithName adds the name to the undelete cluster params
+func (o *UndeleteClusterParams) WithName(name string) *UndeleteClusterParams {
+	o.SetName(name)
+	return o
+}
+
+// SetName adds the name to the undelete cluster params
+func (o *UndeleteClusterParams) SetName(name string) {
+	o.Name = name
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *UndeleteClusterParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param name
+	if err := r.SetPathParam("name", o.Name); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// UndeleteClusterReader is a Reader for the UndeleteCluster structure.
+type UndeleteClusterReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UndeleteClusterReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewUndeleteClusterOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewUndeleteClusterDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewUndeleteClusterOK creates a UndeleteClusterOK with default headers values
+func NewUndeleteClusterOK() *UndeleteClusterOK {
+	return &UndeleteClusterOK{}
+}
+
+/*
+UndeleteClusterOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type UndeleteClusterOK struct {
+	Payload *models.Kluster
+}
+
+// IsSuccess returns true when this undelete cluster o k response has a 2xx status code
+func (o *UndeleteClusterOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this undelete cluster o k response has a 3xx status code
+func (o *UndeleteClusterOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this undelete cluster o k response has a 4xx status code
+func (o *UndeleteClusterOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this undelete cluster o k response has a 5xx status code
+func (o *UndeleteClusterOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this undelete cluster o k response a status code equal to that given
+func (o *UndeleteClusterOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *UndeleteClusterOK) Error() string {
+	return fmt.Sprintf("[POST /api/v1/clusters/{name}/undelete][%d] undeleteClusterOK  %+v", 200, o.Payload)
+}
+
+func (o *UndeleteClusterOK) String() string {
+	return fmt.Sprintf("[POST /api/v1/clusters/{name}/undelete][%d] undeleteClusterOK  %+v", 200, o.Payload)
+}
+
+func (o *UndeleteClusterOK) GetPayload() *models.Kluster {
+	return o.Payload
+}
+
+func (o *UndeleteClusterOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Kluster)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUndeleteClusterDefault creates a UndeleteClusterDefault with default headers values
+func NewUndeleteClusterDefault(code int) *UndeleteClusterDefault {
+	return &UndeleteClusterDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+UndeleteClusterDefault describes a response with status code -1, with default header values.
+
+Error
+*/
+type UndeleteClusterDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the undelete cluster default response
+func (o *UndeleteClusterDefault) Code() int {
+	return o._statusCode
+}
+
+// IsSuccess returns true when this undelete cluster default response has a 2xx status code
+func (o *UndeleteClusterDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this undelete cluster default response has a 3xx status code
+func (o *UndeleteClusterDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this undelete cluster default response has a 4xx status code
+func (o *UndeleteClusterDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this undelete cluster default response has a 5xx status code
+func (o *UndeleteClusterDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this undelete cluster default response a status code equal to that given
+func (o *UndeleteClusterDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+func (o *UndeleteClusterDefault) Error() string {
+	return fmt.Sprintf("[POST /api/v1/clusters/{name}/undelete][%d] UndeleteCluster default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *UndeleteClusterDefault) String() string {
+	return fmt.Sprintf("[POST /api/v1/clusters/{name}/undelete][%d] UndeleteCluster default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *UndeleteClusterDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UndeleteClusterDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -63,6 +63,12 @@ UpdateClusterParams contains all the parameters to send to the API endpoint
 */
 type UpdateClusterParams struct {
 
+	/* IfMatch.
+
+	   Only update the cluster if it still has the ETag returned by ShowCluster, fails with 412 otherwise
+	*/
+	IfMatch *string
+
 	// Body.
 	Body *models.Kluster
 
@@ -122,6 +128,17 @@ func (o *UpdateClusterParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithIfMatch adds the ifMatch to the update cluster params
+func (o *UpdateClusterParams) WithIfMatch(ifMatch *string) *UpdateClusterParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the update cluster params
+func (o *UpdateClusterParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WithBody adds the body to the update cluster params
 func (o *UpdateClusterParams) WithBody(body *models.Kluster) *UpdateClusterParams {
 	o.SetBody(body)
@@ -151,6 +168,14 @@ func (o *UpdateClusterParams) WriteToRequest(r runtime.ClientRequest, reg strfmt
 		return err
 	}
 	var res []error
+
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
 	if o.Body != nil {
 		if err := r.SetBodyParam(o.Body); err != nil {
 			return err
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/sapcc/kubernikus/pkg/api"
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	"github.com/sapcc/kubernikus/pkg/api/rest/operations"
+	"github.com/sapcc/kubernikus/pkg/util"
+)
+
+func NewAllowUserCertificates(rt *api.Runtime) operations.AllowUserCertificatesHandler {
+	return &allowUserCertificates{rt}
+}
+
+type allowUserCertificates struct {
+	*api.Runtime
+}
+
+func (d *allowUserCertificates) Handle(params operations.AllowUserCertificatesParams, principal *models.Principal) middleware.Responder {
+	kluster, err := d.Klusters.Klusters(d.Namespace).Get(qualifiedName(params.Name, principal.Account))
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return NewErrorResponse(&operations.AllowUserCertificatesDefault{}, 404, "Kluster not found")
+		}
+		return NewErrorResponse(&operations.AllowUserCertificatesDefault{}, 500, "%s", err)
+	}
+
+	users, err := util.UpdateUserCertificatesWithRetries(d.Kubernetes, kluster, func(users *util.UserCertificates) error {
+		if !users.Allow(params.User) {
+			return apierrors.NewNotFound(schema.GroupResource{Resource: "users"}, params.User)
+		}
+		return nil
+	})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return NewErrorResponse(&operations.AllowUserCertificatesDefault{}, 404, "User %s is not denied", params.User)
+		}
+		return NewErrorResponse(&operations.AllowUserCertificatesDefault{}, 500, "Failed to update user certificates: %s", err)
+	}
+
+	d.Logger.Log("msg", "allowed user certificates", "kluster", kluster.GetName(), "allowed", params.User, "user", principal.Name)
+	return operations.NewAllowUserCertificatesOK().WithPayload(userCertificateList(users))
+}
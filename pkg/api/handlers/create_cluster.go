@@ -17,6 +17,9 @@ import (
 	"github.com/sapcc/kubernikus/pkg/api/models"
 	"github.com/sapcc/kubernikus/pkg/api/rest/operations"
 	"github.com/sapcc/kubernikus/pkg/apis/kubernikus"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	"github.com/sapcc/kubernikus/pkg/util"
+	etcd_util "github.com/sapcc/kubernikus/pkg/util/etcd"
 	"github.com/sapcc/kubernikus/pkg/util/ip"
 	k8sutil "github.com/sapcc/kubernikus/pkg/util/k8s"
 )
@@ -47,6 +50,30 @@ func (d *createCluster) Handle(params operations.CreateClusterParams, principal
 		}
 	}
 
+	protected := spec.TerminationProtection != nil && *spec.TerminationProtection
+	if protected && !mayChangeTerminationProtection(d.Runtime, principal) {
+		return NewErrorResponse(&operations.CreateClusterDefault{}, 403, "Enabling termination protection is not allowed")
+	}
+
+	if err := etcd_util.ValidateBackupTarget(spec); err != nil {
+		return NewErrorResponse(&operations.CreateClusterDefault{}, 400, "%s", err)
+	}
+	// credentials are kept in the kluster secret only
+	var backupCredentials v1.Backup
+	if spec.BackupTarget != nil && spec.BackupTarget.S3 != nil {
+		s3 := spec.BackupTarget.S3
+		if s3.AccessKeyID == "" || s3.SecretAccessKey == "" {
+			return NewErrorResponse(&operations.CreateClusterDefault{}, 400, "backupTarget.s3 requires accessKeyID and secretAccessKey")
+		}
+		backupCredentials.S3AccessKeyID, backupCredentials.S3SecretAccessKey = s3.AccessKeyID, s3.SecretAccessKey
+		s3.AccessKeyID, s3.SecretAccessKey = "", ""
+	}
+	if spec.BackupTarget != nil {
+		if err := d.VerifyBackupTarget(params.HTTPRequest, principal, spec.BackupTarget); err != nil {
+			return NewErrorResponse(&operations.CreateClusterDefault{}, 400, "Invalid backupTarget: %s", err)
+		}
+	}
+
 	spec.Name = name
 	for i, pool := range spec.NodePools {
 		// Set default image
@@ -121,6 +148,16 @@ func (d *createCluster) Handle(params operations.CreateClusterParams, principal
 	}
 
 	k8sutil.EnsureNamespace(d.Kubernetes, d.Namespace)
+	prepared := kluster
+	if backupCredentials != (v1.Backup{}) {
+		kluster.Namespace = d.Namespace
+		if err := util.PrepareKlusterSecret(d.Kubernetes, kluster, &v1.Secret{Backup: backupCredentials}); err != nil {
+			if apierrors.IsAlreadyExists(err) {
+				return NewErrorResponse(&operations.CreateClusterDefault{}, 409, "Cluster with name %s already exists", name)
+			}
+			return NewErrorResponse(&operations.CreateClusterDefault{}, 500, "Failed to store backup credentials: %s", err)
+		}
+	}
 	kluster, err = d.Kubernikus.KubernikusV1().Klusters(d.Namespace).Create(context.TODO(), kluster, metav1.CreateOptions{})
 	if err != nil {
 		logger.Log(
@@ -129,12 +166,20 @@ func (d *createCluster) Handle(params operations.CreateClusterParams, principal
 			"project", kluster.Account(),
 			"err", err)
 
+		if backupCredentials != (v1.Backup{}) {
+			util.DeleteKlusterSecret(d.Kubernetes, prepared)
+		}
+
 		if apierrors.IsAlreadyExists(err) {
 			return NewErrorResponse(&operations.CreateClusterDefault{}, 409, "Cluster with name %s already exists", name)
 		}
 		return NewErrorResponse(&operations.CreateClusterDefault{}, 500, "%s", err)
 	}
 
+	if protected {
+		auditTerminationProtection(d.Runtime, params.HTTPRequest, principal, kluster)
+	}
+
 	//Wait for a second so that the newly created cluster shows up in the cache
 	//This is a hack so that a subsequent GET /api/v1/cluster/:name will not return 404
 	wait.Poll(50*time.Millisecond, 2*time.Second, func() (bool, error) { //nolint:staticcheck
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime/middleware"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/sapcc/kubernikus/pkg/api"
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	"github.com/sapcc/kubernikus/pkg/api/rest/operations"
+	etcd_util "github.com/sapcc/kubernikus/pkg/util/etcd"
+)
+
+func NewCreateClusterBackup(rt *api.Runtime) operations.CreateClusterBackupHandler {
+	return &createClusterBackup{Runtime: rt}
+}
+
+type createClusterBackup struct {
+	*api.Runtime
+}
+
+// Handle takes a full etcd snapshot and waits until it is stored
+func (d *createClusterBackup) Handle(params operations.CreateClusterBackupParams, principal *models.Principal) middleware.Responder {
+	//This is an admin-only api, the account is passed via parameters
+	client := d.Kubernikus.KubernikusV1().Klusters(d.Namespace)
+	kluster, err := client.Get(context.TODO(), qualifiedName(params.Name, params.Account), meta_v1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return NewErrorResponse(&operations.CreateClusterBackupDefault{}, 404, "Kluster not found")
+		}
+		return NewErrorResponse(&operations.CreateClusterBackupDefault{}, 500, "Failed to retrieve cluster: %s", err)
+	}
+
+	if kluster.Status.Phase != models.KlusterPhaseRunning {
+		return NewErrorResponse(&operations.CreateClusterBackupDefault{}, 409, "Backups can be taken in state %s only", models.KlusterPhaseRunning)
+	}
+	if !etcd_util.BackupEnabled(kluster) {
+		return NewErrorResponse(&operations.CreateClusterBackupDefault{}, 409, "Backup of the cluster is off")
+	}
+
+	snapshot, err := d.TakeFullSnapshot(d.Kubernetes, kluster, "requested by "+principal.Name)
+	if err != nil {
+		return NewErrorResponse(&operations.CreateClusterBackupDefault{}, 500, "Failed to take snapshot: %s", err)
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		kluster, err := client.Get(context.TODO(), kluster.GetName(), meta_v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		etcd_util.RecordSnapshot(kluster, snapshot)
+		_, err = client.Update(context.TODO(), kluster, meta_v1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return NewErrorResponse(&operations.CreateClusterBackupDefault{}, 500, "Snapshot %s taken but not recorded: %s", snapshot.Name, err)
+	}
+
+	d.Logger.Log("msg", "took etcd snapshot", "kluster", kluster.GetName(), "snapshot", snapshot.Name, "user", principal.Name)
+	return operations.NewCreateClusterBackupOK().WithPayload(&snapshot)
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sapcc/kubernikus/pkg/api"
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	"github.com/sapcc/kubernikus/pkg/api/rest/operations"
+	"github.com/sapcc/kubernikus/pkg/util"
+)
+
+func NewGetClusterCertificates(rt *api.Runtime) operations.GetClusterCertificatesHandler {
+	return &getClusterCertificates{Runtime: rt}
+}
+
+type getClusterCertificates struct {
+	*api.Runtime
+}
+
+func (d *getClusterCertificates) Handle(params operations.GetClusterCertificatesParams, principal *models.Principal) middleware.Responder {
+
+	//This is an admin-only api, the account is passed via parameters
+	kluster, err := d.Kubernikus.KubernikusV1().Klusters(d.Namespace).Get(context.TODO(), qualifiedName(params.Name, params.Account), meta_v1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return NewErrorResponse(&operations.GetClusterCertificatesDefault{}, 404, "Kluster not found")
+		}
+		return NewErrorResponse(&operations.GetClusterCertificatesDefault{}, 500, "Failed to retrieve cluster: %s", err)
+	}
+	secret, err := util.KlusterSecret(d.Kubernetes, kluster)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return NewErrorResponse(&operations.GetClusterCertificatesDefault{}, 404, "Secret not found")
+		}
+		return NewErrorResponse(&operations.GetClusterCertificatesDefault{}, 500, "Failed to retrieve cluster secret: %s", err)
+	}
+
+	certificates, err := util.ParseCertificates(&secret.Certificates)
+	if err != nil {
+		return NewErrorResponse(&operations.GetClusterCertificatesDefault{}, 500, "Failed to parse certificates: %s", err)
+	}
+
+	payload := make([]models.CertificateInfo, 0, len(certificates))
+	for _, cert := range certificates {
+		payload = append(payload, models.CertificateInfo{
+			Name:       cert.Name,
+			CommonName: cert.Subject.CommonName,
+			Issuer:     cert.Issuer.CommonName,
+			Ca:         cert.IsCA,
+			NotBefore:  cert.NotBefore.UTC().Format(time.RFC3339),
+			NotAfter:   cert.NotAfter.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return operations.NewGetClusterCertificatesOK().WithPayload(payload)
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/databus23/requestutil"
 	"github.com/ghodss/yaml"
@@ -40,9 +41,37 @@ func (d *getClusterCredentials) Handle(params operations.GetClusterCredentialsPa
 		return NewErrorResponse(&operations.GetClusterCredentialsDefault{}, 500, "%s", err)
 	}
 
+	validFor := d.UserCertificateTTL
+	if params.TTL != nil && time.Duration(*params.TTL)*time.Second < validFor {
+		validFor = time.Duration(*params.TTL) * time.Second
+	}
+
 	factory := util.NewCertificateFactory(kluster, &secret.Certificates, "")
 
-	cert, err := factory.UserCert(principal, fmt.Sprintf("%s://%s", requestutil.Scheme(params.HTTPRequest), requestutil.HostWithPort(params.HTTPRequest)))
+	// the certificate is only handed out if it was recorded
+	var cert *util.Bundle
+	_, err = util.UpdateUserCertificatesWithRetries(d.Kubernetes, kluster, func(users *util.UserCertificates) error {
+		if users.Denied(util.UserIdentity(principal)) {
+			return util.ErrUserDenied
+		}
+		var err error
+		cert, err = factory.UserCert(principal, fmt.Sprintf("%s://%s", requestutil.Scheme(params.HTTPRequest), requestutil.HostWithPort(params.HTTPRequest)), validFor)
+		if err != nil {
+			return err
+		}
+		users.Record(cert.Certificate.Subject.CommonName, cert.Certificate.SerialNumber.String(), time.Now(), cert.Certificate.NotAfter)
+		return nil
+	})
+	if err == util.ErrUserDenied {
+		return NewErrorResponse(&operations.GetClusterCredentialsDefault{}, 403, "User %s is denied to get credentials for this cluster", util.UserIdentity(principal))
+	}
+	if err == util.ErrUsersCAMissing {
+		return NewErrorResponse(&operations.GetClusterCredentialsDefault{}, 503, "Credentials for this cluster are not available yet, please retry later")
+	}
+	if err != nil {
+		return NewErrorResponse(&operations.GetClusterCredentialsDefault{}, 500, "Failed to issue cert: %s", err)
+	}
+	key, err := util.EncodePrivateKeyPEM(cert.PrivateKey)
 	if err != nil {
 		return NewErrorResponse(&operations.GetClusterCredentialsDefault{}, 500, "Failed to issue cert: %s", err)
 	}
@@ -50,7 +79,7 @@ func (d *getClusterCredentials) Handle(params operations.GetClusterCredentialsPa
 		params.Name,
 		fmt.Sprintf("%v@%v", principal.Name, params.Name),
 		kluster.Status.Apiserver,
-		util.EncodePrivateKeyPEM(cert.PrivateKey),
+		key,
 		util.EncodeCertPEM(cert.Certificate),
 		[]byte(secret.TLSCACertificate),
 		"",
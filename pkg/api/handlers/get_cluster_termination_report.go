@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/sapcc/kubernikus/pkg/api"
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	"github.com/sapcc/kubernikus/pkg/api/rest/operations"
+	"github.com/sapcc/kubernikus/pkg/util"
+)
+
+func NewGetClusterTerminationReport(rt *api.Runtime) operations.GetClusterTerminationReportHandler {
+	return &getClusterTerminationReport{Runtime: rt}
+}
+
+type getClusterTerminationReport struct {
+	*api.Runtime
+}
+
+func (d *getClusterTerminationReport) Handle(params operations.GetClusterTerminationReportParams, principal *models.Principal) middleware.Responder {
+
+	//This is an admin-only api, the account is passed via parameters. The
+	//kluster itself is usually gone already, the report outlives it.
+	report, err := util.TerminationReport(d.Kubernetes, d.Namespace, qualifiedName(params.Name, params.Account))
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return NewErrorResponse(&operations.GetClusterTerminationReportDefault{}, 404, "Termination report not found")
+		}
+		return NewErrorResponse(&operations.GetClusterTerminationReportDefault{}, 500, "Failed to retrieve termination report: %s", err)
+	}
+
+	return operations.NewGetClusterTerminationReportOK().WithPayload(report)
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime/middleware"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sapcc/kubernikus/pkg/api"
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	"github.com/sapcc/kubernikus/pkg/api/rest/operations"
+	etcd_util "github.com/sapcc/kubernikus/pkg/util/etcd"
+)
+
+func NewListClusterBackups(rt *api.Runtime) operations.ListClusterBackupsHandler {
+	return &listClusterBackups{Runtime: rt}
+}
+
+type listClusterBackups struct {
+	*api.Runtime
+}
+
+func (d *listClusterBackups) Handle(params operations.ListClusterBackupsParams, principal *models.Principal) middleware.Responder {
+
+	//This is an admin-only api, the account is passed via parameters
+	kluster, err := d.Kubernikus.KubernikusV1().Klusters(d.Namespace).Get(context.TODO(), qualifiedName(params.Name, params.Account), meta_v1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return NewErrorResponse(&operations.ListClusterBackupsDefault{}, 404, "Kluster not found")
+		}
+		return NewErrorResponse(&operations.ListClusterBackupsDefault{}, 500, "Failed to retrieve cluster: %s", err)
+	}
+	if !etcd_util.SwiftBackup(kluster) {
+		return NewErrorResponse(&operations.ListClusterBackupsDefault{}, 409, "Backups of the cluster are not stored in Swift in its project")
+	}
+
+	store, err := d.SnapshotStore(d.Kubernetes, params.HTTPRequest, kluster)
+	if err != nil {
+		return NewErrorResponse(&operations.ListClusterBackupsDefault{}, 500, "Failed to access backups: %s", err)
+	}
+	snapshots, err := store.List()
+	if err != nil {
+		return NewErrorResponse(&operations.ListClusterBackupsDefault{}, 500, "Failed to list backups: %s", err)
+	}
+
+	return operations.NewListClusterBackupsOK().WithPayload(snapshots)
+}
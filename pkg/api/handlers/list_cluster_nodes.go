@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	core_v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sapcc/kubernikus/pkg/api"
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	"github.com/sapcc/kubernikus/pkg/api/rest/operations"
+	"github.com/sapcc/kubernikus/pkg/util"
+	"github.com/sapcc/kubernikus/pkg/wormhole/client"
+)
+
+// nodeAnnotationPrefix selects the annotations of servicing and groundctl
+const nodeAnnotationPrefix = "kubernikus.cloud.sap/"
+
+func NewListClusterNodes(rt *api.Runtime) operations.ListClusterNodesHandler {
+	return &listClusterNodes{Runtime: rt}
+}
+
+type listClusterNodes struct {
+	*api.Runtime
+}
+
+// Handle joins the servers visible to the user with the nodes of the kluster.
+// The nodes are read with the admin credentials of the kluster, the
+// servers are listed even if its apiserver is down.
+func (d *listClusterNodes) Handle(params operations.ListClusterNodesParams, principal *models.Principal) middleware.Responder {
+	kluster, err := d.Klusters.Klusters(d.Namespace).Get(qualifiedName(params.Name, principal.Account))
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return NewErrorResponse(&operations.ListClusterNodesDefault{}, 404, "Kluster not found")
+		}
+		return NewErrorResponse(&operations.ListClusterNodesDefault{}, 500, "%s", err)
+	}
+
+	allServers, err := d.FetchClusterServers(params.HTTPRequest, principal, kluster)
+	if err != nil {
+		return NewErrorResponse(&operations.ListClusterNodesDefault{}, 500, "Failed to list servers: %s", err)
+	}
+
+	nodes := map[string]*models.ClusterNode{}
+	for _, server := range allServers {
+		for _, pool := range kluster.Spec.NodePools {
+			if util.IsKubernikusNode(server.Name, kluster.Spec.Name, pool.Name) {
+				nodes[server.Name] = &models.ClusterNode{Name: server.Name, Pool: pool.Name, ServerID: server.ID, ServerStatus: server.Status}
+				break
+			}
+		}
+	}
+
+	if kubernetes, err := d.KlusterClientFactory.ClientFor(kluster); err != nil {
+		getTracingLogger(params.HTTPRequest).Log("msg", "failed to connect to kluster", "kluster", kluster.GetName(), "err", err)
+	} else if nodeList, err := kubernetes.CoreV1().Nodes().List(context.TODO(), meta_v1.ListOptions{}); err != nil {
+		getTracingLogger(params.HTTPRequest).Log("msg", "failed to list nodes", "kluster", kluster.GetName(), "err", err)
+	} else {
+		for i := range nodeList.Items {
+			node := &nodeList.Items[i]
+			entry, found := nodes[node.Name]
+			if !found {
+				entry = &models.ClusterNode{Name: node.Name}
+				nodes[node.Name] = entry
+			}
+			if pool, ok := node.Labels["ccloud.sap.com/nodepool"]; ok {
+				entry.Pool = pool
+			}
+			entry.Ready = string(core_v1.ConditionUnknown)
+			for _, condition := range node.Status.Conditions {
+				if condition.Type == core_v1.NodeReady {
+					entry.Ready = string(condition.Status)
+				}
+			}
+			entry.RouteBroken = client.IsNodeRouteBroken(node)
+			entry.KubeletVersion = node.Status.NodeInfo.KubeletVersion
+			entry.OsImage = node.Status.NodeInfo.OSImage
+			for key, value := range node.Annotations {
+				if strings.HasPrefix(key, nodeAnnotationPrefix) {
+					if entry.Annotations == nil {
+						entry.Annotations = map[string]string{}
+					}
+					entry.Annotations[key] = value
+				}
+			}
+		}
+	}
+
+	result := make([]models.ClusterNode, 0, len(nodes))
+	for _, node := range nodes {
+		result = append(result, *node)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return operations.NewListClusterNodesOK().WithPayload(result)
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/sapcc/kubernikus/pkg/api"
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	"github.com/sapcc/kubernikus/pkg/api/rest/operations"
+	"github.com/sapcc/kubernikus/pkg/util"
+)
+
+func NewListUserCertificates(rt *api.Runtime) operations.ListUserCertificatesHandler {
+	return &listUserCertificates{rt}
+}
+
+type listUserCertificates struct {
+	*api.Runtime
+}
+
+func (d *listUserCertificates) Handle(params operations.ListUserCertificatesParams, principal *models.Principal) middleware.Responder {
+	kluster, err := d.Klusters.Klusters(d.Namespace).Get(qualifiedName(params.Name, principal.Account))
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return NewErrorResponse(&operations.ListUserCertificatesDefault{}, 404, "Kluster not found")
+		}
+		return NewErrorResponse(&operations.ListUserCertificatesDefault{}, 500, "%s", err)
+	}
+
+	users, err := util.GetUserCertificates(d.Kubernetes, kluster)
+	if err != nil {
+		return NewErrorResponse(&operations.ListUserCertificatesDefault{}, 500, "Failed to retrieve user certificates: %s", err)
+	}
+	users.Prune(time.Now())
+
+	return operations.NewListUserCertificatesOK().WithPayload(userCertificateList(users))
+}
+
+func userCertificateList(users *util.UserCertificates) *models.UserCertificateList {
+	list := &models.UserCertificateList{
+		Certificates: make([]models.UserCertificate, 0, len(users.Issued)),
+		DeniedUsers:  users.DeniedUsers,
+	}
+	for _, cert := range users.Issued {
+		list.Certificates = append(list.Certificates, models.UserCertificate{
+			Serial:    cert.Serial,
+			User:      cert.User,
+			IssuedAt:  cert.IssuedAt.UTC().Format(time.RFC3339),
+			ExpiresAt: cert.ExpiresAt.UTC().Format(time.RFC3339),
+			Revoked:   cert.Revoked,
+		})
+	}
+	return list
+}
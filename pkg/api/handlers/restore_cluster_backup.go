@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sapcc/kubernikus/pkg/api"
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	"github.com/sapcc/kubernikus/pkg/api/rest/operations"
+	etcd_util "github.com/sapcc/kubernikus/pkg/util/etcd"
+)
+
+func NewRestoreClusterBackup(rt *api.Runtime) operations.RestoreClusterBackupHandler {
+	return &restoreClusterBackup{Runtime: rt}
+}
+
+type restoreClusterBackup struct {
+	*api.Runtime
+}
+
+// Handle requests a restore which is carried out by groundctl
+func (d *restoreClusterBackup) Handle(params operations.RestoreClusterBackupParams, principal *models.Principal) middleware.Responder {
+	snapshot := *params.Body.Snapshot
+
+	//This is an admin-only api, the account is passed via parameters
+	client := d.Kubernikus.KubernikusV1().Klusters(d.Namespace)
+	kluster, err := client.Get(context.TODO(), qualifiedName(params.Name, params.Account), meta_v1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return NewErrorResponse(&operations.RestoreClusterBackupDefault{}, 404, "Kluster not found")
+		}
+		return NewErrorResponse(&operations.RestoreClusterBackupDefault{}, 500, "Failed to retrieve cluster: %s", err)
+	}
+
+	if kluster.Status.Phase != models.KlusterPhaseRunning {
+		return NewErrorResponse(&operations.RestoreClusterBackupDefault{}, 409, "Backups can be restored in state %s only", models.KlusterPhaseRunning)
+	}
+	if restore := kluster.Status.EtcdRestore; restore != nil && restore.Phase != models.EtcdRestorePhaseCompleted && restore.Phase != models.EtcdRestorePhaseFailed {
+		return NewErrorResponse(&operations.RestoreClusterBackupDefault{}, 409, "Restore of snapshot %s is in phase %s", restore.Snapshot, restore.Phase)
+	}
+	if !etcd_util.SwiftBackup(kluster) {
+		return NewErrorResponse(&operations.RestoreClusterBackupDefault{}, 409, "Backups of the cluster are not stored in Swift in its project")
+	}
+
+	store, err := d.SnapshotStore(d.Kubernetes, params.HTTPRequest, kluster)
+	if err != nil {
+		return NewErrorResponse(&operations.RestoreClusterBackupDefault{}, 500, "Failed to access backups: %s", err)
+	}
+	snapshots, err := store.List()
+	if err != nil {
+		return NewErrorResponse(&operations.RestoreClusterBackupDefault{}, 500, "Failed to list backups: %s", err)
+	}
+	if _, found := etcd_util.FindSnapshot(snapshots, snapshot); !found {
+		return NewErrorResponse(&operations.RestoreClusterBackupDefault{}, 404, "Snapshot %s not found", snapshot)
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	kluster.Status.Phase = models.KlusterPhaseRestoring
+	kluster.Status.EtcdRestore = &models.EtcdRestoreStatus{
+		Phase:          models.EtcdRestorePhaseScalingDown,
+		Snapshot:       snapshot,
+		StartedAt:      now,
+		PhaseStartedAt: now,
+	}
+	kluster, err = client.Update(context.TODO(), kluster, meta_v1.UpdateOptions{})
+	if err != nil {
+		if apierrors.IsConflict(err) {
+			return NewErrorResponse(&operations.RestoreClusterBackupDefault{}, 409, "%s", err)
+		}
+		return NewErrorResponse(&operations.RestoreClusterBackupDefault{}, 500, "Failed to update cluster: %s", err)
+	}
+
+	d.Logger.Log("msg", "etcd restore requested", "kluster", kluster.GetName(), "snapshot", snapshot, "user", principal.Name)
+	return operations.NewRestoreClusterBackupOK().WithPayload(kluster.Status.EtcdRestore)
+}
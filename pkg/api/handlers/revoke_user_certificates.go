@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/sapcc/kubernikus/pkg/api"
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	"github.com/sapcc/kubernikus/pkg/api/rest/operations"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	"github.com/sapcc/kubernikus/pkg/util"
+)
+
+func NewRevokeUserCertificates(rt *api.Runtime) operations.RevokeUserCertificatesHandler {
+	return &revokeUserCertificates{rt}
+}
+
+type revokeUserCertificates struct {
+	*api.Runtime
+}
+
+func (d *revokeUserCertificates) Handle(params operations.RevokeUserCertificatesParams, principal *models.Principal) middleware.Responder {
+	request := models.UserCertificateRevocation{}
+	if params.Body != nil {
+		request = *params.Body
+	}
+
+	kluster, err := d.Klusters.Klusters(d.Namespace).Get(qualifiedName(params.Name, principal.Account))
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return NewErrorResponse(&operations.RevokeUserCertificatesDefault{}, 404, "Kluster not found")
+		}
+		return NewErrorResponse(&operations.RevokeUserCertificatesDefault{}, 500, "%s", err)
+	}
+
+	users, err := util.UpdateUserCertificatesWithRetries(d.Kubernetes, kluster, func(users *util.UserCertificates) error {
+		users.RevokeAll(time.Now())
+		users.Deny(request.Deny...)
+		return nil
+	})
+	if err != nil {
+		return NewErrorResponse(&operations.RevokeUserCertificatesDefault{}, 500, "Failed to update user certificates: %s", err)
+	}
+
+	// the certs controller replaces the users CA once the revision changes
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		kluster, err := d.Kubernikus.KubernikusV1().Klusters(d.Namespace).Get(context.TODO(), kluster.GetName(), meta_v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		revision, _ := strconv.Atoi(kluster.Annotations[v1.UserCARevisionAnnotationKey])
+		if kluster.Annotations == nil {
+			kluster.Annotations = map[string]string{}
+		}
+		kluster.Annotations[v1.UserCARevisionAnnotationKey] = strconv.Itoa(revision + 1)
+		_, err = d.Kubernikus.KubernikusV1().Klusters(d.Namespace).Update(context.TODO(), kluster, meta_v1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return NewErrorResponse(&operations.RevokeUserCertificatesDefault{}, 500, "Failed to revoke user certificates: %s", err)
+	}
+
+	d.Logger.Log("msg", "revoked user certificates", "kluster", kluster.GetName(), "denied", fmt.Sprintf("%v", request.Deny), "user", principal.Name)
+	return operations.NewRevokeUserCertificatesOK().WithPayload(userCertificateList(users))
+}
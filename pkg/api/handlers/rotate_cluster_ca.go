@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sapcc/kubernikus/pkg/api"
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	"github.com/sapcc/kubernikus/pkg/api/rest/operations"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	"github.com/sapcc/kubernikus/pkg/util"
+)
+
+func NewRotateClusterCA(rt *api.Runtime) operations.RotateClusterCAHandler {
+	return &rotateClusterCA{Runtime: rt}
+}
+
+type rotateClusterCA struct {
+	*api.Runtime
+}
+
+// Handle starts a CA rotation or advances a manual one that waits for it
+func (d *rotateClusterCA) Handle(params operations.RotateClusterCAParams, principal *models.Principal) middleware.Responder {
+	request := models.CARotationRequest{}
+	if params.Body != nil {
+		request = *params.Body
+	}
+	if len(request.Authorities) == 0 {
+		request.Authorities = util.RotatableCAs()
+	}
+	if err := util.ValidateCARotation(request.Authorities); err != nil {
+		return NewErrorResponse(&operations.RotateClusterCADefault{}, 400, "%s", err)
+	}
+
+	//This is an admin-only api, the account is passed via parameters
+	client := d.Kubernikus.KubernikusV1().Klusters(d.Namespace)
+	kluster, err := client.Get(context.TODO(), qualifiedName(params.Name, params.Account), meta_v1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return NewErrorResponse(&operations.RotateClusterCADefault{}, 404, "Kluster not found")
+		}
+		return NewErrorResponse(&operations.RotateClusterCADefault{}, 500, "Failed to retrieve cluster: %s", err)
+	}
+
+	if kluster.Status.Phase != models.KlusterPhaseRunning {
+		return NewErrorResponse(&operations.RotateClusterCADefault{}, 409, "CAs can be rotated in state %s only", models.KlusterPhaseRunning)
+	}
+
+	if err := startOrAdvanceCARotation(kluster, request); err != nil {
+		return NewErrorResponse(&operations.RotateClusterCADefault{}, 409, "%s", err)
+	}
+
+	kluster, err = client.Update(context.TODO(), kluster, meta_v1.UpdateOptions{})
+	if err != nil {
+		if apierrors.IsConflict(err) {
+			return NewErrorResponse(&operations.RotateClusterCADefault{}, 409, "%s", err)
+		}
+		return NewErrorResponse(&operations.RotateClusterCADefault{}, 500, "Failed to update cluster: %s", err)
+	}
+
+	rotation := kluster.Status.CaRotation
+	d.Logger.Log("msg", "CA rotation requested", "kluster", kluster.GetName(), "phase", rotation.Phase, "authorities", fmt.Sprintf("%v", rotation.Authorities), "user", principal.Name)
+	return operations.NewRotateClusterCAOK().WithPayload(rotation)
+}
+
+func startOrAdvanceCARotation(kluster *v1.Kluster, request models.CARotationRequest) error {
+	now := time.Now().UTC().Format(time.RFC3339)
+	rotation := kluster.Status.CaRotation
+
+	if rotation == nil || rotation.Phase == models.CARotationPhaseCompleted {
+		kluster.Status.CaRotation = &models.CARotationStatus{
+			Phase:          models.CARotationPhaseTrustNew,
+			Authorities:    request.Authorities,
+			Manual:         request.Manual,
+			StartedAt:      now,
+			PhaseStartedAt: now,
+		}
+		return nil
+	}
+
+	if !rotation.Waiting {
+		return fmt.Errorf("CA rotation is in phase %s and can't be advanced yet", rotation.Phase)
+	}
+	rotation.Phase = util.NextCARotationPhase(rotation.Phase)
+	rotation.Waiting = false
+	rotation.PhaseStartedAt = now
+	return nil
+}
@@ -28,5 +28,5 @@ func (d *showCluster) Handle(params operations.ShowClusterParams, principal *mod
 		return NewErrorResponse(&operations.ShowClusterDefault{}, 500, "%s", err)
 	}
 
-	return operations.NewShowClusterOK().WithPayload(klusterFromCRD(kluster))
+	return operations.NewShowClusterOK().WithPayload(klusterFromCRD(kluster)).WithETag(klusterETag(kluster))
 }
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-openapi/runtime/middleware"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -11,8 +12,14 @@ import (
 	"github.com/sapcc/kubernikus/pkg/api/models"
 	"github.com/sapcc/kubernikus/pkg/api/rest/operations"
 	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	"github.com/sapcc/kubernikus/pkg/util"
+	etcd_util "github.com/sapcc/kubernikus/pkg/util/etcd"
 )
 
+// MaxTerminationGracePeriod limits how long a kluster can be kept around
+// scaled to zero before it is deleted
+const MaxTerminationGracePeriod = 30 * 24 * time.Hour
+
 func NewTerminateCluster(rt *api.Runtime) operations.TerminateClusterHandler {
 	return &terminateCluster{rt}
 }
@@ -32,30 +39,108 @@ func (d *terminateCluster) Handle(params operations.TerminateClusterParams, prin
 		return NewErrorResponse(&operations.TerminateClusterDefault{}, 500, "%s", err)
 	}
 	if kluster.TerminationProtection() {
-		return NewErrorResponse(&operations.TerminateClusterDefault{}, 403, "Termination protection enabled")
+		return NewErrorResponse(&operations.TerminateClusterDefault{}, 409, "Termination protection enabled, disable it first")
 	}
 
-	_, err = editCluster(klusterInterface, principal, params.Name, func(kluster *v1.Kluster) error {
-		kluster.Status.Phase = models.KlusterPhaseTerminating
-		return nil
-	})
-	if err != nil {
-		return NewErrorResponse(&operations.TerminateClusterDefault{}, 500, "%s", err)
+	var gracePeriod time.Duration
+	if params.GracePeriod != nil {
+		gracePeriod, err = time.ParseDuration(*params.GracePeriod)
+		if err != nil || gracePeriod <= 0 {
+			return NewErrorResponse(&operations.TerminateClusterDefault{}, 400, "Invalid grace period %q", *params.GracePeriod)
+		}
+		if gracePeriod > MaxTerminationGracePeriod {
+			return NewErrorResponse(&operations.TerminateClusterDefault{}, 400, "Grace period must not exceed %s", MaxTerminationGracePeriod)
+		}
+		if _, scheduled := kluster.ScheduledDeletion(); scheduled {
+			return NewErrorResponse(&operations.TerminateClusterDefault{}, 409, "Cluster is already scheduled for deletion")
+		}
+	}
+
+	if params.DryRun != nil && *params.DryRun {
+		return operations.NewTerminateClusterOK().WithPayload(d.terminationPlan(params, principal, kluster, gracePeriod))
 	}
 
-	// This issues a delete request for the Kluster CRD
-	//
-	// It actually adds a `metadata.DeletionTimestamp` to the Kluster. The Garbage-
-	// Controller will pick up on that and delete the resource. But only when the
-	// metadata.Finalizers array is empty. Until then the Kluster will keep on
-	// existing.
-	//
-	// Kubernikus Controllers are required to add/remove Finalizers if clean-up is
-	// required once a Kluster is deleted.
-	propagationPolicy := metav1.DeletePropagationBackground
-	if err := klusterInterface.Delete(context.TODO(), qualifiedName(params.Name, principal.Account), metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}); err != nil {
+	if gracePeriod > 0 {
+		_, err = editCluster(klusterInterface, principal, params.Name, func(kluster *v1.Kluster) error {
+			return kluster.ScheduleDeletion(time.Now().Add(gracePeriod))
+		})
+		if err != nil {
+			return NewErrorResponse(&operations.TerminateClusterDefault{}, 500, "%s", err)
+		}
+		return operations.NewTerminateClusterAccepted()
+	}
+
+	if err := util.TerminateKluster(d.Kubernikus.KubernikusV1(), kluster); err != nil {
 		return NewErrorResponse(&operations.TerminateClusterDefault{}, 500, "%s", err)
 	}
 
 	return operations.NewTerminateClusterAccepted()
 }
+
+// terminationPlan lists what deorbiting and terminating the kluster would
+// delete. OpenStack resources are looked up with the user's token, problems
+// doing so are reported as warnings instead of failing the request.
+func (d *terminateCluster) terminationPlan(params operations.TerminateClusterParams, principal *models.Principal, kluster *v1.Kluster, gracePeriod time.Duration) *models.TerminationPlan {
+	plan := &models.TerminationPlan{
+		Name:     kluster.Spec.Name,
+		Delete:   []models.DebrisResource{},
+		Retain:   []models.DebrisResource{},
+		Warnings: []string{},
+	}
+	if gracePeriod > 0 {
+		plan.ScheduledDeletion = time.Now().Add(gracePeriod).UTC().Format(time.RFC3339)
+	} else if deadline, scheduled := kluster.ScheduledDeletion(); scheduled {
+		plan.ScheduledDeletion = deadline.UTC().Format(time.RFC3339)
+	}
+
+	resources, err := d.FetchTerminationInventory(params.HTTPRequest, principal, kluster)
+	if err != nil {
+		plan.Warnings = append(plan.Warnings, err.Error())
+	}
+	for _, resource := range resources {
+		// Swift containers are kept for recovering backups and audit logs
+		if resource.Kind == models.DebrisResourceKindContainer {
+			plan.Retain = append(plan.Retain, resource)
+		} else {
+			plan.Delete = append(plan.Delete, resource)
+		}
+	}
+
+	if secret, err := util.KlusterSecret(d.Kubernetes, kluster); err != nil {
+		plan.Warnings = append(plan.Warnings, "Failed to get service user: "+err.Error())
+	} else if secret.Username != "" {
+		plan.Delete = append(plan.Delete, models.DebrisResource{Kind: models.DebrisResourceKindServiceuser, ID: secret.Username, Name: secret.Username})
+	}
+	plan.Delete = append(plan.Delete, models.DebrisResource{Kind: models.DebrisResourceKindHelmrelease, ID: kluster.GetName(), Name: kluster.GetName()})
+
+	// Containers are only listed by the inventory if the user can see them,
+	// mention the ones Kubernikus writes to in any case.
+	for _, name := range retainedContainers(kluster) {
+		if !containsResource(plan.Retain, models.DebrisResourceKindContainer, name) {
+			plan.Retain = append(plan.Retain, models.DebrisResource{Kind: models.DebrisResourceKindContainer, ID: name, Name: name})
+		}
+	}
+
+	return plan
+}
+
+func retainedContainers(kluster *v1.Kluster) []string {
+	containers := []string{}
+	if etcd_util.SwiftBackup(kluster) {
+		backup, _ := etcd_util.SwiftBackupContainer(kluster)
+		containers = append(containers, backup.Name)
+	}
+	if kluster.Spec.Audit != nil && *kluster.Spec.Audit == models.KlusterSpecAuditSwift {
+		containers = append(containers, kluster.GetName()+"-audit-log")
+	}
+	return containers
+}
+
+func containsResource(resources []models.DebrisResource, kind, id string) bool {
+	for _, r := range resources {
+		if r.Kind == kind && r.ID == id {
+			return true
+		}
+	}
+	return false
+}
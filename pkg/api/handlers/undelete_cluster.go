@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/sapcc/kubernikus/pkg/api"
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	"github.com/sapcc/kubernikus/pkg/api/rest/operations"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+)
+
+var errNotScheduledForDeletion = errors.New("Cluster is not scheduled for deletion")
+var errAlreadyTerminating = errors.New("Cluster is already terminating")
+
+func NewUndeleteCluster(rt *api.Runtime) operations.UndeleteClusterHandler {
+	return &undeleteCluster{rt}
+}
+
+type undeleteCluster struct {
+	*api.Runtime
+}
+
+func (d *undeleteCluster) Handle(params operations.UndeleteClusterParams, principal *models.Principal) middleware.Responder {
+	kluster, err := editCluster(d.Kubernikus.KubernikusV1().Klusters(d.Namespace), principal, params.Name, func(kluster *v1.Kluster) error {
+		if kluster.Status.Phase == models.KlusterPhaseTerminating || kluster.DeletionTimestamp != nil {
+			return errAlreadyTerminating
+		}
+		if _, scheduled := kluster.ScheduledDeletion(); !scheduled {
+			return errNotScheduledForDeletion
+		}
+		return kluster.CancelScheduledDeletion()
+	})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return NewErrorResponse(&operations.UndeleteClusterDefault{}, 404, "Not found")
+		}
+		if err == errAlreadyTerminating || err == errNotScheduledForDeletion {
+			return NewErrorResponse(&operations.UndeleteClusterDefault{}, 409, "%s", err)
+		}
+		return NewErrorResponse(&operations.UndeleteClusterDefault{}, 500, "%s", err)
+	}
+
+	return operations.NewUndeleteClusterOK().WithPayload(klusterFromCRD(kluster))
+}
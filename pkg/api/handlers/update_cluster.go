@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -13,8 +14,12 @@ import (
 	"github.com/sapcc/kubernikus/pkg/api/models"
 	"github.com/sapcc/kubernikus/pkg/api/rest/operations"
 	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	etcd_util "github.com/sapcc/kubernikus/pkg/util/etcd"
 )
 
+// errClusterChanged is returned when the If-Match precondition fails
+var errClusterChanged = errors.New("cluster was changed in the meantime")
+
 func NewUpdateCluster(rt *api.Runtime) operations.UpdateClusterHandler {
 	return &updateCluster{rt}
 }
@@ -30,7 +35,50 @@ func (d *updateCluster) Handle(params operations.UpdateClusterParams, principal
 		return NewErrorResponse(&operations.UpdateClusterDefault{}, 500, "spec.name needs to be removed, an empty string or the clusters name")
 	}
 
+	protectionChanged := false
 	kluster, err := editCluster(d.Kubernikus.KubernikusV1().Klusters(d.Namespace), principal, params.Name, func(kluster *v1.Kluster) error {
+		if params.IfMatch != nil && *params.IfMatch != klusterETag(kluster) {
+			return errClusterChanged
+		}
+
+		if _, scheduled := kluster.ScheduledDeletion(); scheduled {
+			return apierrors.NewConflict(v1.Resource("klusters"), params.Name, fmt.Errorf("cluster is scheduled for deletion, undelete it first"))
+		}
+
+		if protection := params.Body.Spec.TerminationProtection; protection != nil && *protection != kluster.TerminationProtection() {
+			if !mayChangeTerminationProtection(d.Runtime, principal) {
+				return apierrors.NewForbidden(v1.Resource("klusters"), params.Name, fmt.Errorf("changing termination protection is not allowed"))
+			}
+			kluster.Spec.TerminationProtection = protection
+			if !*protection {
+				delete(kluster.Annotations, v1.TerminationProtectionAnnotationKey)
+			}
+			protectionChanged = true
+		}
+
+		if params.Body.Spec.Hammertime != nil {
+			kluster.Spec.Hammertime = params.Body.Spec.Hammertime
+		}
+
+		// only affects keys created from now on
+		if params.Body.Spec.KeyAlgorithm != "" {
+			kluster.Spec.KeyAlgorithm = params.Body.Spec.KeyAlgorithm
+		}
+
+		// only the retention of the backup target can be changed
+		if target := params.Body.Spec.BackupTarget; target != nil && target.Retention != nil {
+			if _, swift := etcd_util.SwiftBackupContainer(kluster); !swift && etcd_util.S3Backup(kluster) == nil {
+				return apierrors.NewBadRequest("Backup retention can't be set while backup is not on")
+			}
+			if err := etcd_util.ValidateBackupRetention(target.Retention); err != nil {
+				return apierrors.NewBadRequest(err.Error())
+			}
+			if kluster.Spec.BackupTarget == nil {
+				kluster.Spec.BackupTarget = &models.BackupTarget{Type: conv.Pointer(models.BackupTargetTypeSwift)}
+			}
+			kluster.Spec.BackupTarget.Retention = target.Retention
+		}
+
 		// ensure audit value reaches the spec so it
 		// can be considered when upgrading the kluster
 		kluster.Spec.Audit = params.Body.Spec.Audit
@@ -184,6 +232,9 @@ func (d *updateCluster) Handle(params operations.UpdateClusterParams, principal
 
 	if err != nil {
 		d.Logger.Log("msg", "Failed to update cluster", "kluster", qualifiedName(params.Name, principal.Account), "err", err)
+		if err == errClusterChanged {
+			return NewErrorResponse(&operations.UpdateClusterDefault{}, 412, "%s", err)
+		}
 
 		switch e := err.(type) {
 		case apierrors.APIStatus:
@@ -194,5 +245,9 @@ func (d *updateCluster) Handle(params operations.UpdateClusterParams, principal
 
 	}
 
+	if protectionChanged {
+		auditTerminationProtection(d.Runtime, params.HTTPRequest, principal, kluster)
+	}
+
 	return operations.NewUpdateClusterOK().WithPayload(klusterFromCRD(kluster))
 }
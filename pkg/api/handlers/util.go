@@ -8,27 +8,39 @@ import (
 	"strings"
 
 	kitlog "github.com/go-kit/log"
+	"github.com/gophercloud/gophercloud"
+	gophercloud_openstack "github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
 	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
 
+	"github.com/sapcc/kubernikus/pkg/api"
 	"github.com/sapcc/kubernikus/pkg/api/auth"
 	"github.com/sapcc/kubernikus/pkg/api/models"
 	"github.com/sapcc/kubernikus/pkg/api/spec"
 	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
 	"github.com/sapcc/kubernikus/pkg/client/openstack"
+	"github.com/sapcc/kubernikus/pkg/client/openstack/inventory"
 	kubernikusv1 "github.com/sapcc/kubernikus/pkg/generated/clientset/typed/kubernikus/v1"
+	etcd_util "github.com/sapcc/kubernikus/pkg/util/etcd"
 )
 
 var (
-	DEFAULT_IMAGE              = spec.MustDefaultString("NodePool", "image")
-	FetchOpenstackMetadataFunc = fetchOpenstackMetadata
+	DEFAULT_IMAGE = spec.MustDefaultString("NodePool", "image")
 )
 
 func accountSelector(principal *models.Principal) labels.Selector {
 	return labels.SelectorFromSet(map[string]string{"account": principal.Account})
 }
 
+// klusterETag identifies the version of the kluster for If-Match preconditions
+func klusterETag(kluster *v1.Kluster) string {
+	return `"` + kluster.ResourceVersion + `"`
+}
+
 // qualifiedName returns <cluster_name>-<account_id>
 func qualifiedName(name string, accountId string) string {
 	if strings.Contains(name, accountId) {
@@ -56,6 +68,57 @@ func editCluster(client kubernikusv1.KlusterInterface, principal *models.Princip
 
 }
 
+// mayChangeTerminationProtection checks the SetTerminationProtection policy
+// rule. Without policy based authorization everyone with access may.
+func mayChangeTerminationProtection(rt *api.Runtime, principal *models.Principal) bool {
+	return rt.Policy == nil || rt.Policy.Enforce("SetTerminationProtection", principal)
+}
+
+// auditTerminationProtection logs the change and records it as an event on
+// the kluster, visible to users via the events endpoint
+func auditTerminationProtection(rt *api.Runtime, request *http.Request, principal *models.Principal, kluster *v1.Kluster) {
+	state := "disabled"
+	if kluster.TerminationProtection() {
+		state = "enabled"
+	}
+	getTracingLogger(request).Log(
+		"msg", "termination protection "+state,
+		"kluster", kluster.GetName(),
+		"project", kluster.Account(),
+		"user", principal.Name,
+		"user_id", principal.ID,
+		"domain", principal.Domain)
+
+	now := metav1.Now()
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%v.%x", kluster.Name, now.UnixNano()),
+			Namespace: kluster.Namespace,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion:      v1.SchemeGroupVersion.String(),
+			Kind:            "Kluster",
+			Name:            kluster.Name,
+			Namespace:       kluster.Namespace,
+			UID:             kluster.UID,
+			ResourceVersion: kluster.ResourceVersion,
+		},
+		Reason:         "TerminationProtection",
+		Message:        fmt.Sprintf("Termination protection %s by %s/%s", state, principal.Name, principal.Domain),
+		Type:           corev1.EventTypeNormal,
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+		Source:         corev1.EventSource{Component: "kubernikus-api"},
+	}
+	if _, err := rt.Kubernetes.CoreV1().Events(kluster.Namespace).Create(context.TODO(), event, metav1.CreateOptions{}); err != nil {
+		getTracingLogger(request).Log(
+			"msg", "failed to record termination protection event",
+			"kluster", kluster.GetName(),
+			"err", err)
+	}
+}
+
 func klusterFromCRD(k *v1.Kluster) *models.Kluster {
 	return &models.Kluster{
 		Name:   k.Spec.Name,
@@ -115,7 +178,8 @@ func nodePoolEqualsWithScaling(old, new models.NodePool) error {
 	return nil
 }
 
-func fetchOpenstackMetadata(request *http.Request, principal *models.Principal) (*models.OpenstackMetadata, error) {
+// FetchOpenstackMetadata reads the OpenStack metadata of the user's project
+func FetchOpenstackMetadata(request *http.Request, principal *models.Principal) (*models.OpenstackMetadata, error) {
 	tokenID := request.Header.Get("X-Auth-Token")
 
 	authOptions := &tokens.AuthOptions{
@@ -133,3 +197,101 @@ func fetchOpenstackMetadata(request *http.Request, principal *models.Principal)
 
 	return client.GetMetadata()
 }
+
+// FetchTerminationInventory lists the OpenStack resources of the kluster
+// that the user can see with their token.
+func FetchTerminationInventory(request *http.Request, principal *models.Principal, kluster *v1.Kluster) ([]models.DebrisResource, error) {
+	tokenID := request.Header.Get("X-Auth-Token")
+
+	authOptions := &tokens.AuthOptions{
+		IdentityEndpoint: auth.OpenStackAuthURL(),
+		TokenID:          tokenID,
+		Scope: tokens.Scope{
+			ProjectID: principal.Account,
+		},
+	}
+
+	provider, err := openstack.NewSharedOpenstackClientFactory(nil, nil, nil, getTracingLogger(request)).ProviderClientFor(authOptions, getTracingLogger(request))
+	if err != nil {
+		return nil, err
+	}
+	clients, err := inventory.NewClients(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	return inventory.Take(kluster, clients)
+}
+
+// FetchClusterServers lists the servers of the kluster that the user can see
+// with their token, the caller picks the nodes of the pools
+func FetchClusterServers(request *http.Request, principal *models.Principal, kluster *v1.Kluster) ([]servers.Server, error) {
+	authOptions := &tokens.AuthOptions{
+		IdentityEndpoint: auth.OpenStackAuthURL(),
+		TokenID:          request.Header.Get("X-Auth-Token"),
+		Scope: tokens.Scope{
+			ProjectID: principal.Account,
+		},
+	}
+
+	provider, err := openstack.NewSharedOpenstackClientFactory(nil, nil, nil, getTracingLogger(request)).ProviderClientFor(authOptions, getTracingLogger(request))
+	if err != nil {
+		return nil, err
+	}
+	return ListKlusterServers(provider, kluster)
+}
+
+// ListKlusterServers lists the servers whose name contains the kluster name
+// followed by a dash. Nova matches the name as a regular expression anywhere
+// in the server name, so this covers the old and the kks- node name prefixes
+// of all pools. It also matches the nodes of klusters whose names end in
+// -<name>, callers must still filter with util.IsKubernikusNode.
+func ListKlusterServers(provider *gophercloud.ProviderClient, kluster *v1.Kluster) ([]servers.Server, error) {
+	compute, err := gophercloud_openstack.NewComputeV2(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		return nil, err
+	}
+	allPages, err := servers.List(compute, servers.ListOpts{Name: kluster.Spec.Name + "-"}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return servers.ExtractServers(allPages)
+}
+
+// SnapshotStoreFor accesses the etcd snapshots of the kluster as its service user
+func SnapshotStoreFor(client kubernetes.Interface, request *http.Request, kluster *v1.Kluster) (etcd_util.SnapshotStore, error) {
+	logger := getTracingLogger(request)
+	provider, err := openstack.NewSharedOpenstackClientFactory(client, nil, nil, logger).ProviderClientForKluster(kluster, logger)
+	if err != nil {
+		return nil, err
+	}
+	return etcd_util.NewKlusterSnapshotStore(provider, kluster)
+}
+
+// VerifyBackupTarget checks with the user's token that the project of a Swift
+// backup target can be accessed and has an object store in the region
+func VerifyBackupTarget(request *http.Request, principal *models.Principal, target *models.BackupTarget) error {
+	if target.Swift == nil || (target.Swift.ProjectID == "" && target.Swift.Region == "") {
+		return nil
+	}
+	projectID := target.Swift.ProjectID
+	if projectID == "" {
+		projectID = principal.Account
+	}
+
+	authOptions := &tokens.AuthOptions{
+		IdentityEndpoint: auth.OpenStackAuthURL(),
+		TokenID:          request.Header.Get("X-Auth-Token"),
+		Scope: tokens.Scope{
+			ProjectID: projectID,
+		},
+	}
+	provider, err := openstack.NewSharedOpenstackClientFactory(nil, nil, nil, getTracingLogger(request)).ProviderClientFor(authOptions, getTracingLogger(request))
+	if err != nil {
+		return fmt.Errorf("no access to project %s: %s", projectID, err)
+	}
+	if err := etcd_util.VerifySwiftRegion(provider, target.Swift.Region); err != nil {
+		return fmt.Errorf("no object store in region %s of project %s: %s", target.Swift.Region, projectID, err)
+	}
+	return nil
+}
@@ -3,9 +3,14 @@ package handlers
 import (
 	"testing"
 
+	"github.com/go-kit/log"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/sapcc/kubernikus/pkg/api/models"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	openstack_fake "github.com/sapcc/kubernikus/pkg/client/openstack/fake"
 )
 
 func TestDetectNodePoolChanges(t *testing.T) {
@@ -44,3 +49,28 @@ func TestNodePoolEqualsWithScaling(t *testing.T) {
 	assert.NotNil(t, nodePoolEqualsWithScaling(np, npChanged))
 
 }
+
+func TestListKlusterServers(t *testing.T) {
+	cloud := openstack_fake.NewCloud()
+	defer cloud.Close()
+	cloud.AddProject("project", "project", openstack_fake.DefaultDomain)
+	cloud.AddServer(openstack_fake.Server{ProjectID: "project", Name: "kks-nase-default-aaaaa"})
+	cloud.AddServer(openstack_fake.Server{ProjectID: "project", Name: "nase-default-bbbbb"})
+	cloud.AddServer(openstack_fake.Server{ProjectID: "project", Name: "kks-other-default-ccccc"})
+	cloud.AddServer(openstack_fake.Server{ProjectID: "project", Name: "jumpserver"})
+
+	kluster := &v1.Kluster{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "nase-project", Labels: map[string]string{"account": "project"}},
+		Spec:       models.KlusterSpec{Name: "nase"},
+	}
+	provider, err := openstack_fake.NewFactory(cloud).ProviderClientForKluster(kluster, log.NewNopLogger())
+	require.NoError(t, err)
+
+	list, err := ListKlusterServers(provider, kluster)
+	require.NoError(t, err)
+	names := []string{}
+	for _, server := range list {
+		names = append(names, server.Name)
+	}
+	assert.ElementsMatch(t, []string{"kks-nase-default-aaaaa", "nase-default-bbbbb"}, names)
+}
@@ -0,0 +1,130 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BackupRetention backup retention
+//
+// swagger:model BackupRetention
+type BackupRetention struct {
+
+	// max backups
+	// Maximum: 1000
+	// Minimum: 1
+	MaxBackups int64 `json:"maxBackups"`
+
+	// Exponential keeps hourly, daily and weekly snapshots, LimitBased the latest maxBackups full snapshots. Defaults to Exponential.
+	// Enum: [Exponential LimitBased]
+	Policy string `json:"policy,omitempty"`
+}
+
+// Validate validates this backup retention
+func (m *BackupRetention) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMaxBackups(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BackupRetention) validateMaxBackups(formats strfmt.Registry) error {
+	if swag.IsZero(m.MaxBackups) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("maxBackups", "body", m.MaxBackups, 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("maxBackups", "body", m.MaxBackups, 1000, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var backupRetentionTypePolicyPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["Exponential","LimitBased"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		backupRetentionTypePolicyPropEnum = append(backupRetentionTypePolicyPropEnum, v)
+	}
+}
+
+const (
+
+	// BackupRetentionPolicyExponential captures enum value "Exponential"
+	BackupRetentionPolicyExponential string = "Exponential"
+
+	// BackupRetentionPolicyLimitBased captures enum value "LimitBased"
+	BackupRetentionPolicyLimitBased string = "LimitBased"
+)
+
+// prop value enum
+func (m *BackupRetention) validatePolicyEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, backupRetentionTypePolicyPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *BackupRetention) validatePolicy(formats strfmt.Registry) error {
+	if swag.IsZero(m.Policy) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validatePolicyEnum("policy", "body", m.Policy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this backup retention based on context it is used
+func (m *BackupRetention) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BackupRetention) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BackupRetention) UnmarshalBinary(b []byte) error {
+	var res BackupRetention
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
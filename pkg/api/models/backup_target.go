@@ -0,0 +1,250 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BackupTarget Where etcd backups are stored when backup is on. Defaults to Swift in the project and region of the cluster.
+//
+// swagger:model BackupTarget
+type BackupTarget struct {
+
+	// retention
+	Retention *BackupRetention `json:"retention,omitempty"`
+
+	// s3
+	S3 *BackupTargetS3 `json:"s3,omitempty"`
+
+	// swift
+	Swift *BackupTargetSwift `json:"swift,omitempty"`
+
+	// type
+	// Required: true
+	// Enum: [swift s3]
+	Type *string `json:"type"`
+}
+
+// Validate validates this backup target
+func (m *BackupTarget) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateRetention(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateS3(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSwift(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BackupTarget) validateRetention(formats strfmt.Registry) error {
+	if swag.IsZero(m.Retention) { // not required
+		return nil
+	}
+
+	if m.Retention != nil {
+		if err := m.Retention.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("retention")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("retention")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *BackupTarget) validateS3(formats strfmt.Registry) error {
+	if swag.IsZero(m.S3) { // not required
+		return nil
+	}
+
+	if m.S3 != nil {
+		if err := m.S3.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("s3")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("s3")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *BackupTarget) validateSwift(formats strfmt.Registry) error {
+	if swag.IsZero(m.Swift) { // not required
+		return nil
+	}
+
+	if m.Swift != nil {
+		if err := m.Swift.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("swift")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("swift")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+var backupTargetTypeTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["swift","s3"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		backupTargetTypeTypePropEnum = append(backupTargetTypeTypePropEnum, v)
+	}
+}
+
+const (
+
+	// BackupTargetTypeSwift captures enum value "swift"
+	BackupTargetTypeSwift string = "swift"
+
+	// BackupTargetTypeS3 captures enum value "s3"
+	BackupTargetTypeS3 string = "s3"
+)
+
+// prop value enum
+func (m *BackupTarget) validateTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, backupTargetTypeTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *BackupTarget) validateType(formats strfmt.Registry) error {
+
+	if err := validate.Required("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateTypeEnum("type", "body", *m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this backup target based on the context it is used
+func (m *BackupTarget) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateRetention(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateS3(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateSwift(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BackupTarget) contextValidateRetention(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Retention != nil {
+		if err := m.Retention.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("retention")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("retention")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *BackupTarget) contextValidateS3(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.S3 != nil {
+		if err := m.S3.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("s3")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("s3")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *BackupTarget) contextValidateSwift(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Swift != nil {
+		if err := m.Swift.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("swift")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("swift")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BackupTarget) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BackupTarget) UnmarshalBinary(b []byte) error {
+	var res BackupTarget
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
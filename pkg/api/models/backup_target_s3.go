@@ -0,0 +1,100 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BackupTargetS3 backup target s3
+//
+// swagger:model BackupTargetS3
+type BackupTargetS3 struct {
+
+	// Only accepted on creation, the credentials are stored in the cluster secret and never returned.
+	AccessKeyID string `json:"accessKeyID,omitempty"`
+
+	// bucket
+	// Required: true
+	Bucket *string `json:"bucket"`
+
+	// URL of the S3-compatible endpoint
+	// Required: true
+	Endpoint *string `json:"endpoint"`
+
+	// Address the bucket as part of the path instead of the host name
+	ForcePathStyle bool `json:"forcePathStyle,omitempty"`
+
+	// region
+	Region string `json:"region,omitempty"`
+
+	// Only accepted on creation, the credentials are stored in the cluster secret and never returned.
+	SecretAccessKey string `json:"secretAccessKey,omitempty"`
+}
+
+// Validate validates this backup target s3
+func (m *BackupTargetS3) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateBucket(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEndpoint(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BackupTargetS3) validateBucket(formats strfmt.Registry) error {
+
+	if err := validate.Required("bucket", "body", m.Bucket); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BackupTargetS3) validateEndpoint(formats strfmt.Registry) error {
+
+	if err := validate.Required("endpoint", "body", m.Endpoint); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this backup target s3 based on context it is used
+func (m *BackupTargetS3) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BackupTargetS3) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BackupTargetS3) UnmarshalBinary(b []byte) error {
+	var res BackupTargetS3
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
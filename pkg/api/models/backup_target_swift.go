@@ -0,0 +1,80 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BackupTargetSwift backup target swift
+//
+// swagger:model BackupTargetSwift
+type BackupTargetSwift struct {
+
+	// Name of the container. Defaults to kubernikus-etcd-backup-<name>-<uid>. Can not be updated.
+	// Pattern: ^[^/]{1,256}$
+	Container string `json:"container,omitempty"`
+
+	// Project of the container. Defaults to the project of the cluster. Can not be updated.
+	ProjectID string `json:"projectID,omitempty"`
+
+	// Region of the container. Defaults to the region of the cluster.
+	Region string `json:"region,omitempty"`
+}
+
+// Validate validates this backup target swift
+func (m *BackupTargetSwift) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateContainer(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BackupTargetSwift) validateContainer(formats strfmt.Registry) error {
+	if swag.IsZero(m.Container) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("container", "body", m.Container, `^[^/]{1,256}$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this backup target swift based on context it is used
+func (m *BackupTargetSwift) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BackupTargetSwift) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BackupTargetSwift) UnmarshalBinary(b []byte) error {
+	var res BackupTargetSwift
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
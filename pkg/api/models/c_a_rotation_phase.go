@@ -0,0 +1,84 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// CARotationPhase c a rotation phase
+//
+// swagger:model CARotationPhase
+type CARotationPhase string
+
+func NewCARotationPhase(value CARotationPhase) *CARotationPhase {
+	return &value
+}
+
+// Pointer returns a pointer to a freshly-allocated CARotationPhase.
+func (m CARotationPhase) Pointer() *CARotationPhase {
+	return &m
+}
+
+const (
+
+	// CARotationPhaseTrustNew captures enum value "TrustNew"
+	CARotationPhaseTrustNew CARotationPhase = "TrustNew"
+
+	// CARotationPhaseReissue captures enum value "Reissue"
+	CARotationPhaseReissue CARotationPhase = "Reissue"
+
+	// CARotationPhaseRemoveOld captures enum value "RemoveOld"
+	CARotationPhaseRemoveOld CARotationPhase = "RemoveOld"
+
+	// CARotationPhaseCompleted captures enum value "Completed"
+	CARotationPhaseCompleted CARotationPhase = "Completed"
+)
+
+// for schema
+var cARotationPhaseEnum []interface{}
+
+func init() {
+	var res []CARotationPhase
+	if err := json.Unmarshal([]byte(`["TrustNew","Reissue","RemoveOld","Completed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		cARotationPhaseEnum = append(cARotationPhaseEnum, v)
+	}
+}
+
+func (m CARotationPhase) validateCARotationPhaseEnum(path, location string, value CARotationPhase) error {
+	if err := validate.EnumCase(path, location, value, cARotationPhaseEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this c a rotation phase
+func (m CARotationPhase) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateCARotationPhaseEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this c a rotation phase based on context it is used
+func (m CARotationPhase) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
@@ -0,0 +1,53 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// CARotationRequest c a rotation request
+//
+// swagger:model CARotationRequest
+type CARotationRequest struct {
+
+	// Names of the CAs to rotate (e.g. tls, etcd-clients). Defaults to all rotatable CAs.
+	Authorities []string `json:"authorities"`
+
+	// Wait for the API to advance the rotation before the Reissue and RemoveOld phases
+	Manual bool `json:"manual,omitempty"`
+}
+
+// Validate validates this c a rotation request
+func (m *CARotationRequest) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this c a rotation request based on context it is used
+func (m *CARotationRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CARotationRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CARotationRequest) UnmarshalBinary(b []byte) error {
+	var res CARotationRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
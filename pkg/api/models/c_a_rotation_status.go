@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// CARotationStatus c a rotation status
+//
+// swagger:model CARotationStatus
+type CARotationStatus struct {
+
+	// authorities
+	Authorities []string `json:"authorities"`
+
+	// completed at
+	CompletedAt string `json:"completedAt,omitempty"`
+
+	// manual
+	Manual bool `json:"manual,omitempty"`
+
+	// phase
+	Phase CARotationPhase `json:"phase,omitempty"`
+
+	// phase started at
+	PhaseStartedAt string `json:"phaseStartedAt,omitempty"`
+
+	// started at
+	StartedAt string `json:"startedAt,omitempty"`
+
+	// The current phase is rolled out, the rotation waits to be advanced via the API
+	Waiting bool `json:"waiting,omitempty"`
+}
+
+// Validate validates this c a rotation status
+func (m *CARotationStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validatePhase(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CARotationStatus) validatePhase(formats strfmt.Registry) error {
+	if swag.IsZero(m.Phase) { // not required
+		return nil
+	}
+
+	if err := m.Phase.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("phase")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("phase")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this c a rotation status based on the context it is used
+func (m *CARotationStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidatePhase(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CARotationStatus) contextValidatePhase(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.Phase.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("phase")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("phase")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CARotationStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CARotationStatus) UnmarshalBinary(b []byte) error {
+	var res CARotationStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
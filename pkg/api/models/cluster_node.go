@@ -0,0 +1,74 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ClusterNode cluster node
+//
+// swagger:model ClusterNode
+type ClusterNode struct {
+
+	// ID of the OpenStack server, empty if there is no server for the node
+	ServerID string `json:"serverID,omitempty"`
+
+	// The servicing annotations of the node
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// kubelet version
+	KubeletVersion string `json:"kubeletVersion,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// os image
+	OsImage string `json:"osImage,omitempty"`
+
+	// pool
+	Pool string `json:"pool,omitempty"`
+
+	// Status of the Ready condition, empty if the server didn't register as node
+	Ready string `json:"ready,omitempty"`
+
+	// route broken
+	RouteBroken bool `json:"routeBroken,omitempty"`
+
+	// server status
+	ServerStatus string `json:"serverStatus,omitempty"`
+}
+
+// Validate validates this cluster node
+func (m *ClusterNode) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this cluster node based on context it is used
+func (m *ClusterNode) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterNode) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterNode) UnmarshalBinary(b []byte) error {
+	var res ClusterNode
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,91 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/sapcc/kubernikus/pkg/cmd/printers"
+)
+
+func (n ClusterNode) GetFormats() map[printers.PrintFormat]struct{} {
+	ret := map[printers.PrintFormat]struct{}{
+		printers.Table: {},
+	}
+	return ret
+}
+
+func (n ClusterNode) Print(format printers.PrintFormat, options printers.PrintOptions) error {
+	switch format {
+	case printers.Table:
+		n.printTable(options)
+	default:
+		return errors.Errorf("Unknown printformat models.ClusterNode is unable to print in format: %v", format)
+	}
+	return nil
+}
+
+func (n ClusterNode) ResourceName() string {
+	return "node/" + n.Name
+}
+
+func (n ClusterNode) printTable(options printers.PrintOptions) {
+	if options.WithHeaders {
+		fmt.Fprint(printers.Out, "NAME")
+		fmt.Fprint(printers.Out, "\t")
+		fmt.Fprint(printers.Out, "POOL")
+		fmt.Fprint(printers.Out, "\t")
+		fmt.Fprint(printers.Out, "SERVER")
+		fmt.Fprint(printers.Out, "\t")
+		fmt.Fprint(printers.Out, "READY")
+		fmt.Fprint(printers.Out, "\t")
+		fmt.Fprint(printers.Out, "ROUTEBROKEN")
+		fmt.Fprint(printers.Out, "\t")
+		if options.Wide {
+			fmt.Fprint(printers.Out, "VERSION")
+			fmt.Fprint(printers.Out, "\t")
+			fmt.Fprint(printers.Out, "OS-IMAGE")
+			fmt.Fprint(printers.Out, "\t")
+			fmt.Fprint(printers.Out, "SERVER-ID")
+			fmt.Fprint(printers.Out, "\t")
+			fmt.Fprintln(printers.Out, "ANNOTATIONS")
+		} else {
+			fmt.Fprintln(printers.Out, "VERSION")
+		}
+	}
+	fmt.Fprint(printers.Out, n.Name)
+	fmt.Fprint(printers.Out, "\t")
+	fmt.Fprint(printers.Out, orNone(n.Pool))
+	fmt.Fprint(printers.Out, "\t")
+	fmt.Fprint(printers.Out, orNone(n.ServerStatus))
+	fmt.Fprint(printers.Out, "\t")
+	fmt.Fprint(printers.Out, orNone(n.Ready))
+	fmt.Fprint(printers.Out, "\t")
+	fmt.Fprint(printers.Out, n.RouteBroken)
+	fmt.Fprint(printers.Out, "\t")
+	if options.Wide {
+		fmt.Fprint(printers.Out, orNone(n.KubeletVersion))
+		fmt.Fprint(printers.Out, "\t")
+		fmt.Fprint(printers.Out, orNone(n.OsImage))
+		fmt.Fprint(printers.Out, "\t")
+		fmt.Fprint(printers.Out, orNone(n.ServerID))
+		fmt.Fprint(printers.Out, "\t")
+		annotations := make([]string, 0, len(n.Annotations))
+		for key, value := range n.Annotations {
+			annotations = append(annotations, key+"="+value)
+		}
+		sort.Strings(annotations)
+		fmt.Fprintln(printers.Out, orNone(strings.Join(annotations, ",")))
+	} else {
+		fmt.Fprintln(printers.Out, orNone(n.KubeletVersion))
+	}
+}
+
+func orNone(value string) string {
+	if value == "" {
+		return "<none>"
+	}
+	return value
+}
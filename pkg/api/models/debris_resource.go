@@ -0,0 +1,138 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// DebrisResource debris resource
+//
+// swagger:model DebrisResource
+type DebrisResource struct {
+
+	// id
+	ID string `json:"id,omitempty"`
+
+	// kind
+	// Enum: [server volume snapshot loadbalancer floatingip port route servergroup container serviceuser helmrelease]
+	Kind string `json:"kind,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+}
+
+// Validate validates this debris resource
+func (m *DebrisResource) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateKind(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var debrisResourceTypeKindPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["server","volume","snapshot","loadbalancer","floatingip","port","route","servergroup","container","serviceuser","helmrelease"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		debrisResourceTypeKindPropEnum = append(debrisResourceTypeKindPropEnum, v)
+	}
+}
+
+const (
+
+	// DebrisResourceKindServer captures enum value "server"
+	DebrisResourceKindServer string = "server"
+
+	// DebrisResourceKindVolume captures enum value "volume"
+	DebrisResourceKindVolume string = "volume"
+
+	// DebrisResourceKindSnapshot captures enum value "snapshot"
+	DebrisResourceKindSnapshot string = "snapshot"
+
+	// DebrisResourceKindLoadbalancer captures enum value "loadbalancer"
+	DebrisResourceKindLoadbalancer string = "loadbalancer"
+
+	// DebrisResourceKindFloatingip captures enum value "floatingip"
+	DebrisResourceKindFloatingip string = "floatingip"
+
+	// DebrisResourceKindPort captures enum value "port"
+	DebrisResourceKindPort string = "port"
+
+	// DebrisResourceKindRoute captures enum value "route"
+	DebrisResourceKindRoute string = "route"
+
+	// DebrisResourceKindServergroup captures enum value "servergroup"
+	DebrisResourceKindServergroup string = "servergroup"
+
+	// DebrisResourceKindContainer captures enum value "container"
+	DebrisResourceKindContainer string = "container"
+
+	// DebrisResourceKindServiceuser captures enum value "serviceuser"
+	DebrisResourceKindServiceuser string = "serviceuser"
+
+	// DebrisResourceKindHelmrelease captures enum value "helmrelease"
+	DebrisResourceKindHelmrelease string = "helmrelease"
+)
+
+// prop value enum
+func (m *DebrisResource) validateKindEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, debrisResourceTypeKindPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *DebrisResource) validateKind(formats strfmt.Registry) error {
+	if swag.IsZero(m.Kind) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateKindEnum("kind", "body", m.Kind); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this debris resource based on context it is used
+func (m *DebrisResource) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DebrisResource) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DebrisResource) UnmarshalBinary(b []byte) error {
+	var res DebrisResource
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
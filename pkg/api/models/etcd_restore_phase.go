@@ -0,0 +1,87 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// EtcdRestorePhase etcd restore phase
+//
+// swagger:model EtcdRestorePhase
+type EtcdRestorePhase string
+
+func NewEtcdRestorePhase(value EtcdRestorePhase) *EtcdRestorePhase {
+	return &value
+}
+
+// Pointer returns a pointer to a freshly-allocated EtcdRestorePhase.
+func (m EtcdRestorePhase) Pointer() *EtcdRestorePhase {
+	return &m
+}
+
+const (
+
+	// EtcdRestorePhaseScalingDown captures enum value "ScalingDown"
+	EtcdRestorePhaseScalingDown EtcdRestorePhase = "ScalingDown"
+
+	// EtcdRestorePhaseRestoring captures enum value "Restoring"
+	EtcdRestorePhaseRestoring EtcdRestorePhase = "Restoring"
+
+	// EtcdRestorePhaseScalingUp captures enum value "ScalingUp"
+	EtcdRestorePhaseScalingUp EtcdRestorePhase = "ScalingUp"
+
+	// EtcdRestorePhaseCompleted captures enum value "Completed"
+	EtcdRestorePhaseCompleted EtcdRestorePhase = "Completed"
+
+	// EtcdRestorePhaseFailed captures enum value "Failed"
+	EtcdRestorePhaseFailed EtcdRestorePhase = "Failed"
+)
+
+// for schema
+var etcdRestorePhaseEnum []interface{}
+
+func init() {
+	var res []EtcdRestorePhase
+	if err := json.Unmarshal([]byte(`["ScalingDown","Restoring","ScalingUp","Completed","Failed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		etcdRestorePhaseEnum = append(etcdRestorePhaseEnum, v)
+	}
+}
+
+func (m EtcdRestorePhase) validateEtcdRestorePhaseEnum(path, location string, value EtcdRestorePhase) error {
+	if err := validate.EnumCase(path, location, value, etcdRestorePhaseEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this etcd restore phase
+func (m EtcdRestorePhase) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateEtcdRestorePhaseEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this etcd restore phase based on context it is used
+func (m EtcdRestorePhase) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// EtcdRestoreRequest etcd restore request
+//
+// swagger:model EtcdRestoreRequest
+type EtcdRestoreRequest struct {
+
+	// Name of the snapshot to restore, deltas are replayed up to it
+	// Required: true
+	Snapshot *string `json:"snapshot"`
+}
+
+// Validate validates this etcd restore request
+func (m *EtcdRestoreRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateSnapshot(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *EtcdRestoreRequest) validateSnapshot(formats strfmt.Registry) error {
+
+	if err := validate.Required("snapshot", "body", m.Snapshot); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this etcd restore request based on context it is used
+func (m *EtcdRestoreRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *EtcdRestoreRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *EtcdRestoreRequest) UnmarshalBinary(b []byte) error {
+	var res EtcdRestoreRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,120 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// EtcdSnapshot etcd snapshot
+//
+// swagger:model EtcdSnapshot
+type EtcdSnapshot struct {
+
+	// created at
+	CreatedAt string `json:"createdAt,omitempty"`
+
+	// kind
+	// Enum: [full delta]
+	Kind string `json:"kind,omitempty"`
+
+	// last revision
+	LastRevision int64 `json:"lastRevision"`
+
+	// Name of the snapshot object in the backup container
+	Name string `json:"name,omitempty"`
+
+	// Size in bytes
+	Size int64 `json:"size"`
+
+	// start revision
+	StartRevision int64 `json:"startRevision"`
+}
+
+// Validate validates this etcd snapshot
+func (m *EtcdSnapshot) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateKind(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var etcdSnapshotTypeKindPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["full","delta"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		etcdSnapshotTypeKindPropEnum = append(etcdSnapshotTypeKindPropEnum, v)
+	}
+}
+
+const (
+
+	// EtcdSnapshotKindFull captures enum value "full"
+	EtcdSnapshotKindFull string = "full"
+
+	// EtcdSnapshotKindDelta captures enum value "delta"
+	EtcdSnapshotKindDelta string = "delta"
+)
+
+// prop value enum
+func (m *EtcdSnapshot) validateKindEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, etcdSnapshotTypeKindPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *EtcdSnapshot) validateKind(formats strfmt.Registry) error {
+	if swag.IsZero(m.Kind) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateKindEnum("kind", "body", m.Kind); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this etcd snapshot based on context it is used
+func (m *EtcdSnapshot) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *EtcdSnapshot) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *EtcdSnapshot) UnmarshalBinary(b []byte) error {
+	var res EtcdSnapshot
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,59 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// EtcdSnapshotReference etcd snapshot reference
+//
+// swagger:model EtcdSnapshotReference
+type EtcdSnapshotReference struct {
+
+	// created at
+	CreatedAt string `json:"createdAt,omitempty"`
+
+	// Name of the snapshot object in the backup container
+	Name string `json:"name,omitempty"`
+
+	// Why the snapshot was taken
+	Reason string `json:"reason,omitempty"`
+
+	// revision
+	Revision int64 `json:"revision"`
+}
+
+// Validate validates this etcd snapshot reference
+func (m *EtcdSnapshotReference) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this etcd snapshot reference based on context it is used
+func (m *EtcdSnapshotReference) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *EtcdSnapshotReference) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *EtcdSnapshotReference) UnmarshalBinary(b []byte) error {
+	var res EtcdSnapshotReference
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/sapcc/kubernikus/pkg/cmd/printers"
+)
+
+func (e Event) GetFormats() map[printers.PrintFormat]struct{} {
+	ret := map[printers.PrintFormat]struct{}{
+		printers.Table: {},
+	}
+	return ret
+}
+
+func (e Event) Print(format printers.PrintFormat, options printers.PrintOptions) error {
+	switch format {
+	case printers.Table:
+		e.printTable(options)
+	default:
+		return errors.Errorf("Unknown printformat models.Event is unable to print in format: %v", format)
+	}
+	return nil
+}
+
+func (e Event) printTable(options printers.PrintOptions) {
+	if options.WithHeaders {
+		fmt.Fprint(printers.Out, "LAST SEEN")
+		fmt.Fprint(printers.Out, "\t")
+		if options.Wide {
+			fmt.Fprint(printers.Out, "FIRST SEEN")
+			fmt.Fprint(printers.Out, "\t")
+		}
+		fmt.Fprint(printers.Out, "TYPE")
+		fmt.Fprint(printers.Out, "\t")
+		fmt.Fprint(printers.Out, "REASON")
+		fmt.Fprint(printers.Out, "\t")
+		fmt.Fprint(printers.Out, "COUNT")
+		fmt.Fprint(printers.Out, "\t")
+		fmt.Fprintln(printers.Out, "MESSAGE")
+	}
+	fmt.Fprint(printers.Out, e.LastTimestamp)
+	fmt.Fprint(printers.Out, "\t")
+	if options.Wide {
+		fmt.Fprint(printers.Out, e.FirstTimestamp)
+		fmt.Fprint(printers.Out, "\t")
+	}
+	fmt.Fprint(printers.Out, e.Type)
+	fmt.Fprint(printers.Out, "\t")
+	fmt.Fprint(printers.Out, e.Reason)
+	fmt.Fprint(printers.Out, "\t")
+	fmt.Fprint(printers.Out, e.Count)
+	fmt.Fprint(printers.Out, "\t")
+	fmt.Fprintln(printers.Out, e.Message)
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// HammertimeActivation hammertime activation
+//
+// swagger:model HammertimeActivation
+type HammertimeActivation struct {
+
+	// The time the cluster recovered
+	End string `json:"end,omitempty"`
+
+	// mode
+	Mode string `json:"mode,omitempty"`
+
+	// The time hammertime intervened
+	Start string `json:"start,omitempty"`
+}
+
+// Validate validates this hammertime activation
+func (m *HammertimeActivation) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this hammertime activation based on context it is used
+func (m *HammertimeActivation) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HammertimeActivation) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HammertimeActivation) UnmarshalBinary(b []byte) error {
+	var res HammertimeActivation
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
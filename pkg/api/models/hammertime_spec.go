@@ -0,0 +1,165 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// HammertimeSpec Tunables for hammertime which intervenes when all nodes of the cluster stop sending heartbeats.
+//
+// swagger:model HammertimeSpec
+type HammertimeSpec struct {
+
+	// Seconds hammertime only warns before pausing node eviction in graded mode. Defaults to the timeout.
+	// Minimum: 0
+	GracePeriod *int64 `json:"gracePeriod"`
+
+	// Minimum number of nodes required for hammertime to be active.
+	// Minimum: 2
+	MinNodes int64 `json:"minNodes"`
+
+	// scale stops the controller-manager. graded only warns during the grace period and then pauses node eviction of the controller-manager.
+	// Enum: [scale graded]
+	Mode string `json:"mode,omitempty"`
+
+	// Seconds without any node heartbeat before hammertime intervenes.
+	// Minimum: 10
+	Timeout int64 `json:"timeout"`
+}
+
+// Validate validates this hammertime spec
+func (m *HammertimeSpec) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateGracePeriod(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMinNodes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTimeout(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HammertimeSpec) validateGracePeriod(formats strfmt.Registry) error {
+	if swag.IsZero(m.GracePeriod) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("gracePeriod", "body", *m.GracePeriod, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HammertimeSpec) validateMinNodes(formats strfmt.Registry) error {
+	if swag.IsZero(m.MinNodes) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("minNodes", "body", m.MinNodes, 2, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var hammertimeSpecTypeModePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["scale","graded"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		hammertimeSpecTypeModePropEnum = append(hammertimeSpecTypeModePropEnum, v)
+	}
+}
+
+const (
+
+	// HammertimeSpecModeScale captures enum value "scale"
+	HammertimeSpecModeScale string = "scale"
+
+	// HammertimeSpecModeGraded captures enum value "graded"
+	HammertimeSpecModeGraded string = "graded"
+)
+
+// prop value enum
+func (m *HammertimeSpec) validateModeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, hammertimeSpecTypeModePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *HammertimeSpec) validateMode(formats strfmt.Registry) error {
+	if swag.IsZero(m.Mode) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateModeEnum("mode", "body", m.Mode); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HammertimeSpec) validateTimeout(formats strfmt.Registry) error {
+	if swag.IsZero(m.Timeout) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("timeout", "body", m.Timeout, 10, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this hammertime spec based on context it is used
+func (m *HammertimeSpec) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HammertimeSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HammertimeSpec) UnmarshalBinary(b []byte) error {
+	var res HammertimeSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,112 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// HammertimeStatus hammertime status
+//
+// swagger:model HammertimeStatus
+type HammertimeStatus struct {
+
+	// The most recent activations of hammertime
+	Activations []HammertimeActivation `json:"activations"`
+
+	// active
+	Active bool `json:"active,omitempty"`
+}
+
+// Validate validates this hammertime status
+func (m *HammertimeStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateActivations(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HammertimeStatus) validateActivations(formats strfmt.Registry) error {
+	if swag.IsZero(m.Activations) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Activations); i++ {
+
+		if err := m.Activations[i].Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("activations" + "." + strconv.Itoa(i))
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("activations" + "." + strconv.Itoa(i))
+			}
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this hammertime status based on the context it is used
+func (m *HammertimeStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateActivations(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HammertimeStatus) contextValidateActivations(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Activations); i++ {
+
+		if err := m.Activations[i].ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("activations" + "." + strconv.Itoa(i))
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("activations" + "." + strconv.Itoa(i))
+			}
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HammertimeStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HammertimeStatus) UnmarshalBinary(b []byte) error {
+	var res HammertimeStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
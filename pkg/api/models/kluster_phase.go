@@ -42,6 +42,9 @@ const (
 	// KlusterPhaseUpgrading captures enum value "Upgrading"
 	KlusterPhaseUpgrading KlusterPhase = "Upgrading"
 
+	// KlusterPhaseRestoring captures enum value "Restoring"
+	KlusterPhaseRestoring KlusterPhase = "Restoring"
+
 	// KlusterPhaseTerminating captures enum value "Terminating"
 	KlusterPhaseTerminating KlusterPhase = "Terminating"
 )
@@ -51,7 +54,7 @@ var klusterPhaseEnum []interface{}
 
 func init() {
 	var res []KlusterPhase
-	if err := json.Unmarshal([]byte(`["Pending","Creating","Running","Upgrading","Terminating"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["Pending","Creating","Running","Upgrading","Restoring","Terminating"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -2,6 +2,7 @@ package models
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/pkg/errors"
 
@@ -29,18 +30,18 @@ func (k Kluster) Print(format printers.PrintFormat, options printers.PrintOption
 }
 
 func (k Kluster) printHuman(options printers.PrintOptions) {
-	fmt.Println("Cluster name: ", k.Name)
-	fmt.Println("Cluster state: ", k.Status.Phase)
+	fmt.Fprintln(printers.Out, "Cluster name: ", k.Name)
+	fmt.Fprintln(printers.Out, "Cluster state: ", k.Status.Phase)
 	if k.Spec.ClusterCIDR != nil {
-		fmt.Println("Cluster CIDR: ", *k.Spec.ClusterCIDR)
+		fmt.Fprintln(printers.Out, "Cluster CIDR: ", *k.Spec.ClusterCIDR)
 	}
 
-	fmt.Println("Service CIDR: ", k.Spec.ServiceCIDR)
-	fmt.Println("Cluster node pools: ", len(k.Spec.NodePools))
+	fmt.Fprintln(printers.Out, "Service CIDR: ", k.Spec.ServiceCIDR)
+	fmt.Fprintln(printers.Out, "Cluster node pools: ", len(k.Spec.NodePools))
 	for _, pool := range k.Spec.NodePools {
 		pool.printHuman(options)
 	}
-	fmt.Println("Cluster node pool status: ")
+	fmt.Fprintln(printers.Out, "Cluster node pool status: ")
 	for _, pool := range k.Status.NodePools {
 		pool.printHuman(options)
 	}
@@ -48,13 +49,37 @@ func (k Kluster) printHuman(options printers.PrintOptions) {
 
 func (k *Kluster) printTable(options printers.PrintOptions) {
 	if options.WithHeaders {
-		fmt.Print("NAME")
-		fmt.Print("\t")
-		fmt.Println("STATUS")
+		fmt.Fprint(printers.Out, "NAME")
+		fmt.Fprint(printers.Out, "\t")
+		if options.Wide {
+			fmt.Fprint(printers.Out, "STATUS")
+			fmt.Fprint(printers.Out, "\t")
+			fmt.Fprint(printers.Out, "VERSION")
+			fmt.Fprint(printers.Out, "\t")
+			fmt.Fprint(printers.Out, "NODEPOOLS")
+			fmt.Fprint(printers.Out, "\t")
+			fmt.Fprintln(printers.Out, "APISERVER")
+		} else {
+			fmt.Fprintln(printers.Out, "STATUS")
+		}
 	}
-	fmt.Print(k.Name)
-	fmt.Print("\t")
-	fmt.Println(k.Status.Phase)
+	fmt.Fprint(printers.Out, k.Name)
+	fmt.Fprint(printers.Out, "\t")
+	if options.Wide {
+		fmt.Fprint(printers.Out, k.Status.Phase)
+		fmt.Fprint(printers.Out, "\t")
+		fmt.Fprint(printers.Out, k.Status.ApiserverVersion)
+		fmt.Fprint(printers.Out, "\t")
+		fmt.Fprint(printers.Out, len(k.Spec.NodePools))
+		fmt.Fprint(printers.Out, "\t")
+		fmt.Fprintln(printers.Out, k.Status.Apiserver)
+	} else {
+		fmt.Fprintln(printers.Out, k.Status.Phase)
+	}
+}
+
+func (k Kluster) ResourceName() string {
+	return "cluster/" + k.Name
 }
 
 func (p NodePool) GetFormats() map[printers.PrintFormat]struct{} {
@@ -78,44 +103,68 @@ func (p NodePool) Print(format printers.PrintFormat, options printers.PrintOptio
 }
 
 func (p NodePool) printHuman(options printers.PrintOptions) {
-	fmt.Print("Name: ")
-	fmt.Println(p.Name)
-	fmt.Print("   Flavor: \t")
-	fmt.Println(p.Flavor)
-	fmt.Print("   Image:  \t")
-	fmt.Println(p.Image)
-	fmt.Print("   Size:   \t")
-	fmt.Println(p.Size)
+	fmt.Fprint(printers.Out, "Name: ")
+	fmt.Fprintln(printers.Out, p.Name)
+	fmt.Fprint(printers.Out, "   Flavor: \t")
+	fmt.Fprintln(printers.Out, p.Flavor)
+	fmt.Fprint(printers.Out, "   Image:  \t")
+	fmt.Fprintln(printers.Out, p.Image)
+	fmt.Fprint(printers.Out, "   Size:   \t")
+	fmt.Fprintln(printers.Out, p.Size)
 }
 
 func (p NodePool) printTable(options printers.PrintOptions) {
 	if options.WithHeaders {
-		fmt.Print("NAME")
-		fmt.Print("\t")
-		fmt.Print("FLAVOR")
-		fmt.Print("\t")
-		fmt.Print("IMAGE")
-		fmt.Print("\t")
-		fmt.Println("SIZE")
+		fmt.Fprint(printers.Out, "NAME")
+		fmt.Fprint(printers.Out, "\t")
+		fmt.Fprint(printers.Out, "FLAVOR")
+		fmt.Fprint(printers.Out, "\t")
+		fmt.Fprint(printers.Out, "IMAGE")
+		fmt.Fprint(printers.Out, "\t")
+		if options.Wide {
+			fmt.Fprint(printers.Out, "SIZE")
+			fmt.Fprint(printers.Out, "\t")
+			fmt.Fprint(printers.Out, "AVAILABILITYZONE")
+			fmt.Fprint(printers.Out, "\t")
+			fmt.Fprint(printers.Out, "LABELS")
+			fmt.Fprint(printers.Out, "\t")
+			fmt.Fprintln(printers.Out, "TAINTS")
+		} else {
+			fmt.Fprintln(printers.Out, "SIZE")
+		}
 	}
-	fmt.Print(p.Name)
-	fmt.Print("\t")
-	fmt.Print(p.Flavor)
-	fmt.Print("\t")
-	fmt.Print(p.Image)
-	fmt.Print("\t")
-	fmt.Println(p.Size)
+	fmt.Fprint(printers.Out, p.Name)
+	fmt.Fprint(printers.Out, "\t")
+	fmt.Fprint(printers.Out, p.Flavor)
+	fmt.Fprint(printers.Out, "\t")
+	fmt.Fprint(printers.Out, p.Image)
+	fmt.Fprint(printers.Out, "\t")
+	if options.Wide {
+		fmt.Fprint(printers.Out, p.Size)
+		fmt.Fprint(printers.Out, "\t")
+		fmt.Fprint(printers.Out, p.AvailabilityZone)
+		fmt.Fprint(printers.Out, "\t")
+		fmt.Fprint(printers.Out, strings.Join(p.Labels, ","))
+		fmt.Fprint(printers.Out, "\t")
+		fmt.Fprintln(printers.Out, strings.Join(p.Taints, ","))
+	} else {
+		fmt.Fprintln(printers.Out, p.Size)
+	}
+}
+
+func (p NodePool) ResourceName() string {
+	return "nodepool/" + p.Name
 }
 
 func (p NodePoolInfo) printHuman(options printers.PrintOptions) {
-	fmt.Print("Name: ")
-	fmt.Println(p.Name)
-	fmt.Print("   Size: \t")
-	fmt.Println(p.Size)
-	fmt.Print("   Running: \t")
-	fmt.Println(p.Running)
-	fmt.Print("   Schedulable: \t")
-	fmt.Println(p.Schedulable)
-	fmt.Print("   Healthy: \t")
-	fmt.Println(p.Healthy)
+	fmt.Fprint(printers.Out, "Name: ")
+	fmt.Fprintln(printers.Out, p.Name)
+	fmt.Fprint(printers.Out, "   Size: \t")
+	fmt.Fprintln(printers.Out, p.Size)
+	fmt.Fprint(printers.Out, "   Running: \t")
+	fmt.Fprintln(printers.Out, p.Running)
+	fmt.Fprint(printers.Out, "   Schedulable: \t")
+	fmt.Fprintln(printers.Out, p.Schedulable)
+	fmt.Fprint(printers.Out, "   Healthy: \t")
+	fmt.Fprintln(printers.Out, p.Healthy)
 }
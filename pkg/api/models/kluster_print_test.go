@@ -0,0 +1,26 @@
+package models
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sapcc/kubernikus/pkg/cmd/printers"
+)
+
+func TestKlusterPrintUsesOut(t *testing.T) {
+	var buf bytes.Buffer
+	printers.Out = &buf
+	defer func() { printers.Out = os.Stdout }()
+
+	kluster := Kluster{Name: "test", Status: KlusterStatus{Phase: KlusterPhaseRunning}}
+	require.NoError(t, printers.PrintTable([]printers.Printable{kluster}))
+	assert.Equal(t, "NAME\tSTATUS\ntest\tRunning\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, kluster.Print(printers.Human, printers.PrintOptions{}))
+	assert.Contains(t, buf.String(), "Cluster state:  Running\n")
+}
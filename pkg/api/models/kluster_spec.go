@@ -42,6 +42,9 @@ type KlusterSpec struct {
 	// Enum: [on off externalAWS]
 	Backup string `json:"backup,omitempty"`
 
+	// backup target
+	BackupTarget *BackupTarget `json:"backupTarget,omitempty"`
+
 	// CIDR Range for Pods in the cluster. Can not be updated.
 	// Pattern: ^((([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])(\/([0-9]|[1-2][0-9]|3[0-2])))?$
 	ClusterCIDR *string `json:"clusterCIDR,omitempty"`
@@ -62,6 +65,15 @@ type KlusterSpec struct {
 	// dns domain
 	DNSDomain string `json:"dnsDomain,omitempty"`
 
+	// hammertime
+	Hammertime *HammertimeSpec `json:"hammertime,omitempty"`
+
+	// Algorithm of newly created private keys. Existing keys are kept, certificates switch when they are
+	// renewed and CAs when they are rotated. Defaults to rsa.
+	//
+	// Enum: [rsa ecdsa-p256 ed25519]
+	KeyAlgorithm string `json:"keyAlgorithm,omitempty"`
+
 	// name
 	Name string `json:"name,omitempty"`
 
@@ -91,6 +103,9 @@ type KlusterSpec struct {
 	// Max Length: 10000
 	SSHPublicKey string `json:"sshPublicKey,omitempty"`
 
+	// Prevents the cluster from being terminated. Can only be changed by kubernetes admins.
+	TerminationProtection *bool `json:"terminationProtection,omitempty"`
+
 	// Kubernetes version
 	// Pattern: ^(?P<major>0|[1-9]\d*)\.(?P<minor>0|[1-9]\d*)\.(?P<patch>0|[1-9]\d*)(?:-(?P<prerelease>(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+(?P<buildmetadata>[0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$
 	Version string `json:"version,omitempty"`
@@ -112,6 +127,10 @@ func (m *KlusterSpec) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateBackupTarget(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateClusterCIDR(formats); err != nil {
 		res = append(res, err)
 	}
@@ -120,6 +139,14 @@ func (m *KlusterSpec) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateHammertime(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateKeyAlgorithm(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateNodePools(formats); err != nil {
 		res = append(res, err)
 	}
@@ -260,6 +287,25 @@ func (m *KlusterSpec) validateBackup(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *KlusterSpec) validateBackupTarget(formats strfmt.Registry) error {
+	if swag.IsZero(m.BackupTarget) { // not required
+		return nil
+	}
+
+	if m.BackupTarget != nil {
+		if err := m.BackupTarget.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("backupTarget")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("backupTarget")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *KlusterSpec) validateClusterCIDR(formats strfmt.Registry) error {
 	if swag.IsZero(m.ClusterCIDR) { // not required
 		return nil
@@ -284,6 +330,70 @@ func (m *KlusterSpec) validateDNSAddress(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *KlusterSpec) validateHammertime(formats strfmt.Registry) error {
+	if swag.IsZero(m.Hammertime) { // not required
+		return nil
+	}
+
+	if m.Hammertime != nil {
+		if err := m.Hammertime.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("hammertime")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("hammertime")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+var klusterSpecTypeKeyAlgorithmPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["rsa","ecdsa-p256","ed25519"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		klusterSpecTypeKeyAlgorithmPropEnum = append(klusterSpecTypeKeyAlgorithmPropEnum, v)
+	}
+}
+
+const (
+
+	// KlusterSpecKeyAlgorithmRsa captures enum value "rsa"
+	KlusterSpecKeyAlgorithmRsa string = "rsa"
+
+	// KlusterSpecKeyAlgorithmEcdsaDashP256 captures enum value "ecdsa-p256"
+	KlusterSpecKeyAlgorithmEcdsaDashP256 string = "ecdsa-p256"
+
+	// KlusterSpecKeyAlgorithmEd25519 captures enum value "ed25519"
+	KlusterSpecKeyAlgorithmEd25519 string = "ed25519"
+)
+
+// prop value enum
+func (m *KlusterSpec) validateKeyAlgorithmEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, klusterSpecTypeKeyAlgorithmPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *KlusterSpec) validateKeyAlgorithm(formats strfmt.Registry) error {
+	if swag.IsZero(m.KeyAlgorithm) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateKeyAlgorithmEnum("keyAlgorithm", "body", m.KeyAlgorithm); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *KlusterSpec) validateNodePools(formats strfmt.Registry) error {
 	if swag.IsZero(m.NodePools) { // not required
 		return nil
@@ -381,6 +491,14 @@ func (m *KlusterSpec) validateVersion(formats strfmt.Registry) error {
 func (m *KlusterSpec) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateBackupTarget(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateHammertime(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateNodePools(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -399,6 +517,38 @@ func (m *KlusterSpec) ContextValidate(ctx context.Context, formats strfmt.Regist
 	return nil
 }
 
+func (m *KlusterSpec) contextValidateBackupTarget(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.BackupTarget != nil {
+		if err := m.BackupTarget.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("backupTarget")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("backupTarget")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *KlusterSpec) contextValidateHammertime(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Hammertime != nil {
+		if err := m.Hammertime.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("hammertime")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("hammertime")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *KlusterSpec) contextValidateNodePools(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.NodePools); i++ {
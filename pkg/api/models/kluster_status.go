@@ -25,6 +25,9 @@ type KlusterStatus struct {
 	// apiserver version
 	ApiserverVersion string `json:"apiserverVersion,omitempty"`
 
+	// ca rotation
+	CaRotation *CARotationStatus `json:"caRotation,omitempty"`
+
 	// chart name
 	ChartName string `json:"chartName,omitempty"`
 
@@ -34,6 +37,15 @@ type KlusterStatus struct {
 	// dashboard
 	Dashboard string `json:"dashboard,omitempty"`
 
+	// etcd restore
+	EtcdRestore *EtcdRestoreStatus `json:"etcdRestore,omitempty"`
+
+	// Full etcd snapshots taken on demand, e.g. before upgrades. The latest is last.
+	EtcdSnapshots []EtcdSnapshotReference `json:"etcdSnapshots"`
+
+	// hammertime
+	Hammertime *HammertimeStatus `json:"hammertime,omitempty"`
+
 	// migrations pending
 	MigrationsPending bool `json:"migrationsPending,omitempty"`
 
@@ -57,6 +69,22 @@ type KlusterStatus struct {
 func (m *KlusterStatus) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateCaRotation(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEtcdRestore(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEtcdSnapshots(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHammertime(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateNodePools(formats); err != nil {
 		res = append(res, err)
 	}
@@ -71,6 +99,84 @@ func (m *KlusterStatus) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *KlusterStatus) validateCaRotation(formats strfmt.Registry) error {
+	if swag.IsZero(m.CaRotation) { // not required
+		return nil
+	}
+
+	if m.CaRotation != nil {
+		if err := m.CaRotation.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("caRotation")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("caRotation")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *KlusterStatus) validateEtcdRestore(formats strfmt.Registry) error {
+	if swag.IsZero(m.EtcdRestore) { // not required
+		return nil
+	}
+
+	if m.EtcdRestore != nil {
+		if err := m.EtcdRestore.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("etcdRestore")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("etcdRestore")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *KlusterStatus) validateEtcdSnapshots(formats strfmt.Registry) error {
+	if swag.IsZero(m.EtcdSnapshots) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.EtcdSnapshots); i++ {
+
+		if err := m.EtcdSnapshots[i].Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("etcdSnapshots" + "." + strconv.Itoa(i))
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("etcdSnapshots" + "." + strconv.Itoa(i))
+			}
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *KlusterStatus) validateHammertime(formats strfmt.Registry) error {
+	if swag.IsZero(m.Hammertime) { // not required
+		return nil
+	}
+
+	if m.Hammertime != nil {
+		if err := m.Hammertime.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("hammertime")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("hammertime")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *KlusterStatus) validateNodePools(formats strfmt.Registry) error {
 	if swag.IsZero(m.NodePools) { // not required
 		return nil
@@ -113,6 +219,22 @@ func (m *KlusterStatus) validatePhase(formats strfmt.Registry) error {
 func (m *KlusterStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateCaRotation(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateEtcdRestore(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateEtcdSnapshots(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateHammertime(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateNodePools(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -127,6 +249,72 @@ func (m *KlusterStatus) ContextValidate(ctx context.Context, formats strfmt.Regi
 	return nil
 }
 
+func (m *KlusterStatus) contextValidateCaRotation(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.CaRotation != nil {
+		if err := m.CaRotation.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("caRotation")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("caRotation")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *KlusterStatus) contextValidateEtcdRestore(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.EtcdRestore != nil {
+		if err := m.EtcdRestore.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("etcdRestore")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("etcdRestore")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *KlusterStatus) contextValidateEtcdSnapshots(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.EtcdSnapshots); i++ {
+
+		if err := m.EtcdSnapshots[i].ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("etcdSnapshots" + "." + strconv.Itoa(i))
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("etcdSnapshots" + "." + strconv.Itoa(i))
+			}
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *KlusterStatus) contextValidateHammertime(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Hammertime != nil {
+		if err := m.Hammertime.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("hammertime")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("hammertime")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *KlusterStatus) contextValidateNodePools(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.NodePools); i++ {
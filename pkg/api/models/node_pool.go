@@ -39,7 +39,7 @@ type NodePool struct {
 	// image
 	Image string `json:"image,omitempty"`
 
-	// The specified labels will be added to members of this pool once during initial registration of the node
+	// The specified labels are applied to members of this pool and kept in sync when the pool is updated
 	Labels []string `json:"labels"`
 
 	// name
@@ -53,7 +53,7 @@ type NodePool struct {
 	// Minimum: 0
 	Size int64 `json:"size"`
 
-	// The specified taints will be added to members of this pool once during initial registration of the node
+	// The specified taints are applied to members of this pool and kept in sync when the pool is updated
 	Taints []string `json:"taints"`
 }
 
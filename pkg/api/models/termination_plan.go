@@ -0,0 +1,168 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// TerminationPlan termination plan
+//
+// swagger:model TerminationPlan
+type TerminationPlan struct {
+
+	// Resources deleted when terminating the cluster
+	Delete []DebrisResource `json:"delete"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// Resources kept after terminating the cluster
+	Retain []DebrisResource `json:"retain"`
+
+	// The time at which the cluster is going to be deleted, if scheduled
+	ScheduledDeletion string `json:"scheduledDeletion,omitempty"`
+
+	// Problems encountered while listing resources, the plan might be incomplete
+	Warnings []string `json:"warnings"`
+}
+
+// Validate validates this termination plan
+func (m *TerminationPlan) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDelete(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRetain(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TerminationPlan) validateDelete(formats strfmt.Registry) error {
+	if swag.IsZero(m.Delete) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Delete); i++ {
+
+		if err := m.Delete[i].Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("delete" + "." + strconv.Itoa(i))
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("delete" + "." + strconv.Itoa(i))
+			}
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *TerminationPlan) validateRetain(formats strfmt.Registry) error {
+	if swag.IsZero(m.Retain) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Retain); i++ {
+
+		if err := m.Retain[i].Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("retain" + "." + strconv.Itoa(i))
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("retain" + "." + strconv.Itoa(i))
+			}
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this termination plan based on the context it is used
+func (m *TerminationPlan) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateDelete(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateRetain(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TerminationPlan) contextValidateDelete(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Delete); i++ {
+
+		if err := m.Delete[i].ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("delete" + "." + strconv.Itoa(i))
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("delete" + "." + strconv.Itoa(i))
+			}
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *TerminationPlan) contextValidateRetain(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Retain); i++ {
+
+		if err := m.Retain[i].ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("retain" + "." + strconv.Itoa(i))
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("retain" + "." + strconv.Itoa(i))
+			}
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TerminationPlan) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TerminationPlan) UnmarshalBinary(b []byte) error {
+	var res TerminationPlan
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
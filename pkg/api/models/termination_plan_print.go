@@ -0,0 +1,57 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/sapcc/kubernikus/pkg/cmd/printers"
+)
+
+func (p TerminationPlan) GetFormats() map[printers.PrintFormat]struct{} {
+	ret := map[printers.PrintFormat]struct{}{
+		printers.Human: {},
+	}
+	return ret
+}
+
+func (p TerminationPlan) Print(format printers.PrintFormat, options printers.PrintOptions) error {
+	switch format {
+	case printers.Human:
+		p.printHuman(options)
+	default:
+		return errors.Errorf("Unknown printformat models.TerminationPlan is unable to print in format: %v", format)
+	}
+	return nil
+}
+
+func (p TerminationPlan) printHuman(options printers.PrintOptions) {
+	fmt.Fprintln(printers.Out, "Cluster name: ", p.Name)
+	if p.ScheduledDeletion != "" {
+		fmt.Fprintln(printers.Out, "Scheduled deletion: ", p.ScheduledDeletion)
+	}
+	fmt.Fprintln(printers.Out, "Resources to be deleted: ", len(p.Delete))
+	for _, r := range p.Delete {
+		r.printHuman(options)
+	}
+	fmt.Fprintln(printers.Out, "Resources to be retained: ", len(p.Retain))
+	for _, r := range p.Retain {
+		r.printHuman(options)
+	}
+	for _, warning := range p.Warnings {
+		fmt.Fprintln(printers.Out, "Warning: ", warning)
+	}
+}
+
+func (r DebrisResource) printHuman(options printers.PrintOptions) {
+	fmt.Fprint(printers.Out, "   ")
+	fmt.Fprint(printers.Out, r.Kind)
+	fmt.Fprint(printers.Out, "\t")
+	fmt.Fprint(printers.Out, r.ID)
+	if r.Name != "" && r.Name != r.ID {
+		fmt.Fprint(printers.Out, " (")
+		fmt.Fprint(printers.Out, r.Name)
+		fmt.Fprint(printers.Out, ")")
+	}
+	fmt.Fprintln(printers.Out)
+}
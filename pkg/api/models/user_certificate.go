@@ -0,0 +1,62 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// UserCertificate user certificate
+//
+// swagger:model UserCertificate
+type UserCertificate struct {
+
+	// expires at
+	ExpiresAt string `json:"expiresAt,omitempty"`
+
+	// issued at
+	IssuedAt string `json:"issuedAt,omitempty"`
+
+	// revoked
+	Revoked bool `json:"revoked,omitempty"`
+
+	// serial
+	Serial string `json:"serial,omitempty"`
+
+	// user
+	User string `json:"user,omitempty"`
+}
+
+// Validate validates this user certificate
+func (m *UserCertificate) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this user certificate based on context it is used
+func (m *UserCertificate) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *UserCertificate) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *UserCertificate) UnmarshalBinary(b []byte) error {
+	var res UserCertificate
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
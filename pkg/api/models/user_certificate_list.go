@@ -0,0 +1,112 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// UserCertificateList user certificate list
+//
+// swagger:model UserCertificateList
+type UserCertificateList struct {
+
+	// certificates
+	Certificates []UserCertificate `json:"certificates"`
+
+	// Users which can't get new certificates
+	DeniedUsers []string `json:"deniedUsers"`
+}
+
+// Validate validates this user certificate list
+func (m *UserCertificateList) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCertificates(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *UserCertificateList) validateCertificates(formats strfmt.Registry) error {
+	if swag.IsZero(m.Certificates) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Certificates); i++ {
+
+		if err := m.Certificates[i].Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("certificates" + "." + strconv.Itoa(i))
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("certificates" + "." + strconv.Itoa(i))
+			}
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this user certificate list based on the context it is used
+func (m *UserCertificateList) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCertificates(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *UserCertificateList) contextValidateCertificates(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Certificates); i++ {
+
+		if err := m.Certificates[i].ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("certificates" + "." + strconv.Itoa(i))
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("certificates" + "." + strconv.Itoa(i))
+			}
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *UserCertificateList) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *UserCertificateList) UnmarshalBinary(b []byte) error {
+	var res UserCertificateList
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,51 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// UserCertificateRevocation Revocation replaces the CA signing user certificates, all certificates issued before become invalid.
+// Single certificates can't be revoked.
+//
+// swagger:model UserCertificateRevocation
+type UserCertificateRevocation struct {
+
+	// Users to add to the deny list
+	Deny []string `json:"deny"`
+}
+
+// Validate validates this user certificate revocation
+func (m *UserCertificateRevocation) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this user certificate revocation based on context it is used
+func (m *UserCertificateRevocation) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *UserCertificateRevocation) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *UserCertificateRevocation) UnmarshalBinary(b []byte) error {
+	var res UserCertificateRevocation
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -42,6 +42,100 @@ func (in *AvailabilityZone) DeepCopy() *AvailabilityZone {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRetention) DeepCopyInto(out *BackupRetention) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRetention.
+func (in *BackupRetention) DeepCopy() *BackupRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupTarget) DeepCopyInto(out *BackupTarget) {
+	*out = *in
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(BackupRetention)
+		**out = **in
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(BackupTargetS3)
+		**out = **in
+	}
+	if in.Swift != nil {
+		in, out := &in.Swift, &out.Swift
+		*out = new(BackupTargetSwift)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupTarget.
+func (in *BackupTarget) DeepCopy() *BackupTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupTargetS3) DeepCopyInto(out *BackupTargetS3) {
+	*out = *in
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupTargetS3.
+func (in *BackupTargetS3) DeepCopy() *BackupTargetS3 {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupTargetS3)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupTargetSwift) DeepCopyInto(out *BackupTargetSwift) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupTargetSwift.
+func (in *BackupTargetSwift) DeepCopy() *BackupTargetSwift {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupTargetSwift)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Binaries) DeepCopyInto(out *Binaries) {
 	*out = *in
@@ -79,6 +173,27 @@ func (in *BootstrapConfig) DeepCopy() *BootstrapConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CARotationStatus) DeepCopyInto(out *CARotationStatus) {
+	*out = *in
+	if in.Authorities != nil {
+		in, out := &in.Authorities, &out.Authorities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CARotationStatus.
+func (in *CARotationStatus) DeepCopy() *CARotationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CARotationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Credentials) DeepCopyInto(out *Credentials) {
 	*out = *in
@@ -112,6 +227,38 @@ func (in *Error) DeepCopy() *Error {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdRestoreStatus) DeepCopyInto(out *EtcdRestoreStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdRestoreStatus.
+func (in *EtcdRestoreStatus) DeepCopy() *EtcdRestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdRestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdSnapshotReference) DeepCopyInto(out *EtcdSnapshotReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdSnapshotReference.
+func (in *EtcdSnapshotReference) DeepCopy() *EtcdSnapshotReference {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdSnapshotReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Event) DeepCopyInto(out *Event) {
 	*out = *in
@@ -176,6 +323,64 @@ func (in *GetClusterValuesOKBody) DeepCopy() *GetClusterValuesOKBody {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HammertimeActivation) DeepCopyInto(out *HammertimeActivation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HammertimeActivation.
+func (in *HammertimeActivation) DeepCopy() *HammertimeActivation {
+	if in == nil {
+		return nil
+	}
+	out := new(HammertimeActivation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HammertimeSpec) DeepCopyInto(out *HammertimeSpec) {
+	*out = *in
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HammertimeSpec.
+func (in *HammertimeSpec) DeepCopy() *HammertimeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HammertimeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HammertimeStatus) DeepCopyInto(out *HammertimeStatus) {
+	*out = *in
+	if in.Activations != nil {
+		in, out := &in.Activations, &out.Activations
+		*out = make([]HammertimeActivation, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HammertimeStatus.
+func (in *HammertimeStatus) DeepCopy() *HammertimeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HammertimeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Info) DeepCopyInto(out *Info) {
 	*out = *in
@@ -267,6 +472,11 @@ func (in *KlusterSpec) DeepCopyInto(out *KlusterSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.BackupTarget != nil {
+		in, out := &in.BackupTarget, &out.BackupTarget
+		*out = new(BackupTarget)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ClusterCIDR != nil {
 		in, out := &in.ClusterCIDR, &out.ClusterCIDR
 		*out = new(string)
@@ -289,7 +499,17 @@ func (in *KlusterSpec) DeepCopyInto(out *KlusterSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Hammertime != nil {
+		in, out := &in.Hammertime, &out.Hammertime
+		*out = new(HammertimeSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	out.Openstack = in.Openstack
+	if in.TerminationProtection != nil {
+		in, out := &in.TerminationProtection, &out.TerminationProtection
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -306,6 +526,26 @@ func (in *KlusterSpec) DeepCopy() *KlusterSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KlusterStatus) DeepCopyInto(out *KlusterStatus) {
 	*out = *in
+	if in.CaRotation != nil {
+		in, out := &in.CaRotation, &out.CaRotation
+		*out = new(CARotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EtcdRestore != nil {
+		in, out := &in.EtcdRestore, &out.EtcdRestore
+		*out = new(EtcdRestoreStatus)
+		**out = **in
+	}
+	if in.EtcdSnapshots != nil {
+		in, out := &in.EtcdSnapshots, &out.EtcdSnapshots
+		*out = make([]EtcdSnapshotReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Hammertime != nil {
+		in, out := &in.Hammertime, &out.Hammertime
+		*out = new(HammertimeStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.NodePools != nil {
 		in, out := &in.NodePools, &out.NodePools
 		*out = make([]NodePoolInfo, len(*in))
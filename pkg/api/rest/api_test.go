@@ -9,16 +9,19 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	kitlog "github.com/go-kit/log"
 	errors "github.com/go-openapi/errors"
 	"github.com/go-openapi/swag/conv"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	yaml "gopkg.in/yaml.v2"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	k8s "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/cache"
 
@@ -32,12 +35,15 @@ import (
 	"github.com/sapcc/kubernikus/pkg/client/kubernetes"
 	kubernikusfake "github.com/sapcc/kubernikus/pkg/generated/clientset/fake"
 	"github.com/sapcc/kubernikus/pkg/util"
+	etcd_util "github.com/sapcc/kubernikus/pkg/util/etcd"
 )
 
 const (
 	NAMESPACE = "test"
 	TOKEN     = "abc123"
 	ACCOUNT   = "testaccount"
+
+	CLOUD_ADMIN_TOKEN = "cloudadmin"
 )
 
 func init() {
@@ -45,6 +51,16 @@ func init() {
 }
 
 func mockAuth(token string) (*models.Principal, error) {
+	if token == CLOUD_ADMIN_TOKEN {
+		return &models.Principal{
+			ID:          "admin",
+			Name:        "Cloud Admin",
+			Domain:      "ccadmin",
+			Account:     ACCOUNT,
+			AccountName: "cloud_admin",
+			Roles:       []string{"member", "kubernetes_admin"},
+		}, nil
+	}
 	if token != TOKEN {
 		return nil, errors.New(401, "auth failed")
 	}
@@ -180,6 +196,57 @@ func TestCreateCluster(t *testing.T) {
 
 }
 
+func TestCreateClusterBackupTarget(t *testing.T) {
+	handler, rt, cancel := createTestHandler(t)
+	defer cancel()
+
+	verifyErr := fmt.Errorf("no access")
+	rt.VerifyBackupTarget = func(_ *http.Request, _ *models.Principal, target *models.BackupTarget) error {
+		if target.Swift != nil && target.Swift.ProjectID == "forbidden" {
+			return verifyErr
+		}
+		return nil
+	}
+
+	for _, target := range []string{
+		`{"type": "s3", "s3": {"endpoint": "https://s3.example.com", "bucket": "backup"}}`,
+		`{"type": "s3", "s3": {"endpoint": "s3.example.com", "bucket": "backup", "accessKeyID": "id", "secretAccessKey": "secret"}}`,
+		`{"type": "s3", "swift": {}, "s3": {"endpoint": "https://s3.example.com", "bucket": "backup", "accessKeyID": "id", "secretAccessKey": "secret"}}`,
+		`{"type": "swift", "retention": {"policy": "LimitBased"}}`,
+		`{"type": "swift", "retention": {"maxBackups": 10}}`,
+		`{"type": "swift", "swift": {"projectID": "forbidden"}}`,
+	} {
+		req := createRequest("POST", "/api/v1/clusters", `{"name": "nase", "spec": {"openstack": {"routerID": "routerA"}, "backupTarget": `+target+`}}`)
+		code, _, body := result(handler, req)
+		assert.Equal(t, 400, code, "target %s: %s", target, string(body))
+	}
+	req := createRequest("POST", "/api/v1/clusters", `{"name": "nase", "spec": {"openstack": {"routerID": "routerA"}, "backup": "off", "backupTarget": {"type": "swift"}}}`)
+	code, _, body := result(handler, req)
+	assert.Equal(t, 400, code, string(body))
+
+	req = createRequest("POST", "/api/v1/clusters", `{"name": "nase", "spec": {"openstack": {"routerID": "routerA"}, "backupTarget": {"type": "s3", "s3": {"endpoint": "https://s3.example.com", "bucket": "backup", "accessKeyID": "id", "secretAccessKey": "secret"}, "retention": {"policy": "LimitBased", "maxBackups": 24}}}}`)
+	code, _, body = result(handler, req)
+	require.Equal(t, 201, code, string(body))
+	assert.NotContains(t, string(body), "secret")
+
+	crd, err := rt.Kubernikus.KubernikusV1().Klusters(rt.Namespace).Get(context.Background(), fmt.Sprintf("%s-%s", "nase", ACCOUNT), metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, crd.Spec.BackupTarget)
+	assert.Equal(t, "backup", *crd.Spec.BackupTarget.S3.Bucket)
+	assert.Empty(t, crd.Spec.BackupTarget.S3.AccessKeyID, "credentials are not stored in the spec")
+	assert.Empty(t, crd.Spec.BackupTarget.S3.SecretAccessKey, "credentials are not stored in the spec")
+	assert.EqualValues(t, 24, crd.Spec.BackupTarget.Retention.MaxBackups)
+
+	secret, err := util.KlusterSecret(rt.Kubernetes, crd)
+	require.NoError(t, err)
+	assert.Equal(t, "id", secret.Backup.S3AccessKeyID)
+	assert.Equal(t, "secret", secret.Backup.S3SecretAccessKey)
+
+	req = createRequest("POST", "/api/v1/clusters", `{"name": "hase", "spec": {"openstack": {"routerID": "routerB"}, "backupTarget": {"type": "swift", "swift": {"projectID": "dr-project", "region": "eu-de-2"}}}}`)
+	code, _, body = result(handler, req)
+	require.Equal(t, 201, code, string(body))
+}
+
 func TestAuthenticationConfigurationValidation(t *testing.T) {
 	handler, _, cancel := createTestHandler(t)
 	defer cancel()
@@ -324,6 +391,154 @@ func TestClusterList(t *testing.T) {
 
 }
 
+func TestClusterScheduledDeletion(t *testing.T) {
+	kluster := &kubernikusv1.Kluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s", "nase", ACCOUNT),
+			Namespace: NAMESPACE,
+			Labels:    map[string]string{"account": ACCOUNT},
+			UID:       "uid",
+		},
+		Spec: models.KlusterSpec{
+			Name:      "nase",
+			Backup:    "on",
+			NodePools: []models.NodePool{{Name: "poolname", Flavor: "flavor", Image: "image", AvailabilityZone: "us-west-1a", Size: 2}},
+		},
+		Status: models.KlusterStatus{Phase: models.KlusterPhaseRunning},
+	}
+	handler, rt, cancel := createTestHandler(t, kluster)
+	defer cancel()
+
+	rt.FetchTerminationInventory = func(_ *http.Request, _ *models.Principal, _ *kubernikusv1.Kluster) ([]models.DebrisResource, error) {
+		return []models.DebrisResource{{Kind: models.DebrisResourceKindVolume, ID: "volume-id", Name: "pvc-1"}}, nil
+	}
+
+	//Dry run doesn't change anything
+	code, _, body := result(handler, createRequest("DELETE", "/api/v1/clusters/nase?dryRun=true&gracePeriod=72h", ""))
+	require.Equal(t, 200, code, string(body))
+	var plan models.TerminationPlan
+	require.NoError(t, plan.UnmarshalBinary(body))
+	assert.NotEmpty(t, plan.ScheduledDeletion)
+	assert.Contains(t, plan.Delete, models.DebrisResource{Kind: models.DebrisResourceKindVolume, ID: "volume-id", Name: "pvc-1"})
+	assert.Contains(t, plan.Delete, models.DebrisResource{Kind: models.DebrisResourceKindHelmrelease, ID: kluster.Name, Name: kluster.Name})
+	assert.Equal(t, []models.DebrisResource{{Kind: models.DebrisResourceKindContainer, ID: "kubernikus-etcd-backup-nase-uid", Name: "kubernikus-etcd-backup-nase-uid"}}, plan.Retain)
+	assert.NotEmpty(t, plan.Warnings, "missing kluster secret should be reported")
+
+	//Invalid grace period
+	code, _, _ = result(handler, createRequest("DELETE", "/api/v1/clusters/nase?gracePeriod=forever", ""))
+	assert.Equal(t, 400, code)
+
+	//Schedule deletion
+	code, _, body = result(handler, createRequest("DELETE", "/api/v1/clusters/nase?gracePeriod=72h", ""))
+	require.Equal(t, 202, code, string(body))
+	crd, err := rt.Kubernikus.KubernikusV1().Klusters(NAMESPACE).Get(context.Background(), kluster.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	deadline, scheduled := crd.ScheduledDeletion()
+	assert.True(t, scheduled)
+	assert.WithinDuration(t, time.Now().Add(72*time.Hour), deadline, time.Minute)
+	assert.Equal(t, int64(0), crd.Spec.NodePools[0].Size)
+	assert.NotEqual(t, models.KlusterPhaseTerminating, crd.Status.Phase)
+
+	//No second schedule and no updates while scheduled
+	code, _, _ = result(handler, createRequest("DELETE", "/api/v1/clusters/nase?gracePeriod=1h", ""))
+	assert.Equal(t, 409, code)
+	code, _, body = result(handler, createRequest("PUT", "/api/v1/clusters/nase", `{"name": "nase", "spec": {"nodePools": [{"name": "poolname", "flavor": "flavor", "image": "image", "availabilityZone": "us-west-1a", "size": 5}]}}`))
+	assert.Equal(t, 409, code, string(body))
+
+	//Undelete restores the node pools
+	code, _, body = result(handler, createRequest("POST", "/api/v1/clusters/nase/undelete", ""))
+	require.Equal(t, 200, code, string(body))
+	var apiKluster models.Kluster
+	require.NoError(t, apiKluster.UnmarshalBinary(body))
+	assert.Equal(t, int64(2), apiKluster.Spec.NodePools[0].Size)
+	crd, err = rt.Kubernikus.KubernikusV1().Klusters(NAMESPACE).Get(context.Background(), kluster.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	_, scheduled = crd.ScheduledDeletion()
+	assert.False(t, scheduled)
+
+	//Nothing left to undelete
+	code, _, _ = result(handler, createRequest("POST", "/api/v1/clusters/nase/undelete", ""))
+	assert.Equal(t, 409, code)
+}
+
+func TestTerminationProtection(t *testing.T) {
+	protected := true
+	kluster := &kubernikusv1.Kluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s", "nase", ACCOUNT),
+			Namespace: NAMESPACE,
+			Labels:    map[string]string{"account": ACCOUNT},
+		},
+		Spec: models.KlusterSpec{
+			Name:                  "nase",
+			TerminationProtection: &protected,
+			NodePools:             []models.NodePool{{Name: "poolname", Flavor: "flavor", Image: "image", AvailabilityZone: "us-west-1a", Size: 2}},
+		},
+		Status: models.KlusterStatus{Phase: models.KlusterPhaseRunning},
+	}
+	handler, rt, cancel := createTestHandler(t, kluster)
+	defer cancel()
+
+	code, _, body := result(handler, createRequest("DELETE", "/api/v1/clusters/nase", ""))
+	assert.Equal(t, 409, code, string(body))
+
+	//Updates without the field leave it alone
+	code, _, body = result(handler, createRequest("PUT", "/api/v1/clusters/nase", `{"name": "nase", "spec": {"nodePools": [{"name": "poolname", "flavor": "flavor", "image": "image", "availabilityZone": "us-west-1a", "size": 3}]}}`))
+	require.Equal(t, 200, code, string(body))
+	var apiKluster models.Kluster
+	require.NoError(t, apiKluster.UnmarshalBinary(body))
+	require.NotNil(t, apiKluster.Spec.TerminationProtection)
+	assert.True(t, *apiKluster.Spec.TerminationProtection)
+	events, err := rt.Kubernetes.CoreV1().Events(NAMESPACE).List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, events.Items)
+
+	//Project admins may change it, disabling is audited
+	disable := `{"name": "nase", "spec": {"terminationProtection": false, "nodePools": [{"name": "poolname", "flavor": "flavor", "image": "image", "availabilityZone": "us-west-1a", "size": 3}]}}`
+	code, _, body = result(handler, createRequest("PUT", "/api/v1/clusters/nase", disable))
+	require.Equal(t, 200, code, string(body))
+	require.NoError(t, apiKluster.UnmarshalBinary(body))
+	assert.False(t, *apiKluster.Spec.TerminationProtection)
+	events, err = rt.Kubernetes.CoreV1().Events(NAMESPACE).List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	if assert.Len(t, events.Items, 1) {
+		assert.Equal(t, "TerminationProtection", events.Items[0].Reason)
+		assert.Equal(t, "Termination protection disabled by Test Mc Dougle/TestDomain", events.Items[0].Message)
+	}
+
+	code, _, body = result(handler, createRequest("DELETE", "/api/v1/clusters/nase", ""))
+	assert.Equal(t, 202, code, string(body))
+}
+
+func TestClusterUpdateIfMatch(t *testing.T) {
+	kluster := kubernikusv1.Kluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            fmt.Sprintf("%s-%s", "nase", ACCOUNT),
+			Namespace:       NAMESPACE,
+			Labels:          map[string]string{"account": ACCOUNT},
+			ResourceVersion: "1",
+		},
+		Spec: models.KlusterSpec{Name: "nase", Version: "1.31.2"},
+	}
+	handler, _, cancel := createTestHandler(t, &kluster)
+	defer cancel()
+
+	code, header, body := result(handler, createRequest("GET", "/api/v1/clusters/nase", ""))
+	require.Equal(t, 200, code, string(body))
+	assert.Equal(t, `"1"`, header.Get("ETag"))
+
+	update := `{"name": "nase", "spec": {"nodePools": [{"name": "poolname", "flavor": "flavor", "image": "image", "size": 1, "availabilityZone": "us-west-1a"}]}}`
+	req := createRequest("PUT", "/api/v1/clusters/nase", update)
+	req.Header.Set("If-Match", `"0"`)
+	code, _, body = result(handler, req)
+	assert.Equal(t, 412, code, string(body))
+
+	req = createRequest("PUT", "/api/v1/clusters/nase", update)
+	req.Header.Set("If-Match", header.Get("ETag"))
+	code, _, body = result(handler, req)
+	assert.Equal(t, 200, code, string(body))
+}
+
 func TestClusterUpdate(t *testing.T) {
 
 	on := true
@@ -552,3 +767,184 @@ func TestClusterBootstrapConfig(t *testing.T) {
 		RotateCertificates: true,
 	}, config)
 }
+
+func TestListClusterNodes(t *testing.T) {
+	kluster := &kubernikusv1.Kluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s", "nase", ACCOUNT),
+			Namespace: NAMESPACE,
+			Labels:    map[string]string{"account": ACCOUNT},
+		},
+		Spec: models.KlusterSpec{
+			Name:      "nase",
+			NodePools: []models.NodePool{{Name: "default"}},
+		},
+	}
+
+	handler, rt, cancel := createTestHandler(t, kluster)
+	defer cancel()
+	rt.FetchClusterServers = func(_ *http.Request, _ *models.Principal, _ *kubernikusv1.Kluster) ([]servers.Server, error) {
+		return []servers.Server{
+			{ID: "1", Name: "kks-nase-default-aaaaa", Status: "ACTIVE"},
+			{ID: "2", Name: "kks-nase-default-bbbbb", Status: "BUILD"},
+			{ID: "3", Name: "kks-other-default-ccccc", Status: "ACTIVE"},
+		}, nil
+	}
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "kks-nase-default-aaaaa",
+			Labels:      map[string]string{"ccloud.sap.com/nodepool": "default"},
+			Annotations: map[string]string{"kubernikus.cloud.sap/updateTimestamp": "2026-10-19T10:00:00Z", "other": "x"},
+		},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}, {Type: "RouteBroken", Status: corev1.ConditionTrue}},
+			NodeInfo:   corev1.NodeSystemInfo{KubeletVersion: "v1.31.2", OSImage: "Flatcar Container Linux"},
+		},
+	}
+	_, err := rt.KlusterClientFactory.(*kubernetes.MockSharedClientFactory).Clientset.CoreV1().Nodes().Create(context.Background(), node, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	req := createRequest("GET", "/api/v1/clusters/nase/nodes", "")
+	code, _, body := result(handler, req)
+	require.Equal(t, 200, code, string(body))
+
+	var nodes []models.ClusterNode
+	require.NoError(t, json.Unmarshal(body, &nodes))
+	assert.Equal(t, []models.ClusterNode{
+		{
+			Name:           "kks-nase-default-aaaaa",
+			Pool:           "default",
+			ServerID:       "1",
+			ServerStatus:   "ACTIVE",
+			Ready:          "True",
+			RouteBroken:    true,
+			KubeletVersion: "v1.31.2",
+			OsImage:        "Flatcar Container Linux",
+			Annotations:    map[string]string{"kubernikus.cloud.sap/updateTimestamp": "2026-10-19T10:00:00Z"},
+		},
+		{Name: "kks-nase-default-bbbbb", Pool: "default", ServerID: "2", ServerStatus: "BUILD"},
+	}, nodes)
+}
+
+func TestUserCertificates(t *testing.T) {
+	kluster := &kubernikusv1.Kluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s", "nase", ACCOUNT),
+			Namespace: NAMESPACE,
+			Labels:    map[string]string{"account": ACCOUNT},
+		},
+		Spec:   models.KlusterSpec{AdvertiseAddress: "1.1.1.1", ServiceCIDR: "192.168.0.0/24"},
+		Status: models.KlusterStatus{Apiserver: "https://nase.example.com"},
+	}
+
+	handler, rt, cancel := createTestHandler(t, kluster)
+	defer cancel()
+	secret, err := util.EnsureKlusterSecret(rt.Kubernetes, kluster)
+	require.NoError(t, err)
+	_, err = util.NewCertificateFactory(kluster, &secret.Certificates, "example.com").Ensure()
+	require.NoError(t, err)
+	require.NoError(t, util.UpdateKlusterSecret(rt.Kubernetes, kluster, secret))
+
+	user := "Test Mc Dougle@TestDomain"
+
+	code, _, body := result(handler, createRequest("GET", "/api/v1/clusters/nase/credentials?ttl=600", ""))
+	require.Equal(t, 200, code, string(body))
+
+	code, _, body = result(handler, createRequest("GET", "/api/v1/clusters/nase/usercertificates", ""))
+	require.Equal(t, 200, code, string(body))
+	var list models.UserCertificateList
+	require.NoError(t, list.UnmarshalBinary(body))
+	require.Len(t, list.Certificates, 1)
+	assert.Equal(t, user, list.Certificates[0].User)
+	assert.False(t, list.Certificates[0].Revoked)
+	expires, err := time.Parse(time.RFC3339, list.Certificates[0].ExpiresAt)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(10*time.Minute), expires, time.Minute)
+
+	code, _, body = result(handler, createRequest("POST", "/api/v1/clusters/nase/usercertificates/revoke", fmt.Sprintf(`{"deny": ["%s"]}`, user)))
+	require.Equal(t, 200, code, string(body))
+	require.NoError(t, list.UnmarshalBinary(body))
+	assert.True(t, list.Certificates[0].Revoked)
+	assert.Equal(t, []string{user}, list.DeniedUsers)
+	updated, err := rt.Kubernikus.KubernikusV1().Klusters(NAMESPACE).Get(context.Background(), kluster.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "1", updated.Annotations[kubernikusv1.UserCARevisionAnnotationKey])
+
+	code, _, body = result(handler, createRequest("GET", "/api/v1/clusters/nase/credentials", ""))
+	assert.Equal(t, 403, code, string(body))
+
+	code, _, body = result(handler, createRequest("DELETE", "/api/v1/clusters/nase/usercertificates/denied/Test%20Mc%20Dougle@TestDomain", ""))
+	require.Equal(t, 200, code, string(body))
+	require.NoError(t, list.UnmarshalBinary(body))
+	assert.Empty(t, list.DeniedUsers)
+
+	code, _, body = result(handler, createRequest("GET", "/api/v1/clusters/nase/credentials", ""))
+	assert.Equal(t, 200, code, string(body))
+}
+
+func TestClusterBackups(t *testing.T) {
+	kluster := &kubernikusv1.Kluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s", "nase", ACCOUNT),
+			Namespace: NAMESPACE,
+			Labels:    map[string]string{"account": ACCOUNT},
+		},
+		Spec:   models.KlusterSpec{Name: "nase"},
+		Status: models.KlusterStatus{Phase: models.KlusterPhaseRunning},
+	}
+	handler, rt, cancel := createTestHandler(t, kluster)
+	defer cancel()
+
+	full := models.EtcdSnapshot{Name: "v1/Full-00000000-00000100-1700000000.gz", Kind: models.EtcdSnapshotKindFull, LastRevision: 100}
+	delta := models.EtcdSnapshot{Name: "v1/Incr-00000101-00000200-1700000600.gz", Kind: models.EtcdSnapshotKindDelta, StartRevision: 101, LastRevision: 200}
+	store := &etcd_util.FakeSnapshotStore{Snapshots: []models.EtcdSnapshot{delta, full}}
+	rt.SnapshotStore = func(_ k8s.Interface, _ *http.Request, _ *kubernikusv1.Kluster) (etcd_util.SnapshotStore, error) {
+		return store, nil
+	}
+	rt.TakeFullSnapshot = func(_ k8s.Interface, _ *kubernikusv1.Kluster, reason string) (models.EtcdSnapshotReference, error) {
+		return models.EtcdSnapshotReference{Name: "v1/Full-00000000-00000300-1700001200.gz", Revision: 300, Reason: reason}, nil
+	}
+	adminRequest := func(method, path, body string) *http.Request {
+		req := createRequest(method, path, body)
+		req.Header.Set("X-Auth-Token", CLOUD_ADMIN_TOKEN)
+		return req
+	}
+
+	code, _, body := result(handler, createRequest("GET", "/api/v1/"+ACCOUNT+"/clusters/nase/backups", ""))
+	assert.Equal(t, 403, code, string(body))
+
+	code, _, body = result(handler, adminRequest("GET", "/api/v1/"+ACCOUNT+"/clusters/nase/backups", ""))
+	require.Equal(t, 200, code, string(body))
+	var snapshots []models.EtcdSnapshot
+	require.NoError(t, json.Unmarshal(body, &snapshots))
+	assert.Equal(t, []models.EtcdSnapshot{full, delta}, snapshots)
+
+	code, _, body = result(handler, adminRequest("GET", "/api/v1/"+ACCOUNT+"/clusters/hase/backups", ""))
+	assert.Equal(t, 404, code, string(body))
+
+	code, _, body = result(handler, adminRequest("POST", "/api/v1/"+ACCOUNT+"/clusters/nase/backups", ""))
+	require.Equal(t, 200, code, string(body))
+	current, err := rt.Kubernikus.KubernikusV1().Klusters(NAMESPACE).Get(context.Background(), kluster.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Len(t, current.Status.EtcdSnapshots, 1)
+	assert.Equal(t, int64(300), current.Status.EtcdSnapshots[0].Revision)
+	assert.Equal(t, "requested by Cloud Admin", current.Status.EtcdSnapshots[0].Reason)
+
+	code, _, body = result(handler, adminRequest("POST", "/api/v1/"+ACCOUNT+"/clusters/nase/restore", `{"snapshot": "v1/Full-00000000-00000050-1600000000.gz"}`))
+	assert.Equal(t, 404, code, string(body))
+
+	code, _, body = result(handler, adminRequest("POST", "/api/v1/"+ACCOUNT+"/clusters/nase/restore", `{"snapshot": "`+full.Name+`"}`))
+	require.Equal(t, 200, code, string(body))
+	var restore models.EtcdRestoreStatus
+	require.NoError(t, restore.UnmarshalBinary(body))
+	assert.Equal(t, models.EtcdRestorePhaseScalingDown, restore.Phase)
+	assert.Equal(t, full.Name, restore.Snapshot)
+	current, err = rt.Kubernikus.KubernikusV1().Klusters(NAMESPACE).Get(context.Background(), kluster.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, models.KlusterPhaseRestoring, current.Status.Phase)
+
+	code, _, body = result(handler, adminRequest("POST", "/api/v1/"+ACCOUNT+"/clusters/nase/restore", `{"snapshot": "`+full.Name+`"}`))
+	assert.Equal(t, 409, code, string(body), "a restore is running")
+	code, _, body = result(handler, adminRequest("POST", "/api/v1/"+ACCOUNT+"/clusters/nase/backups", ""))
+	assert.Equal(t, 409, code, string(body), "no backups while restoring")
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/sapcc/kubernikus/pkg/api/handlers"
 	"github.com/sapcc/kubernikus/pkg/api/rest/operations"
 	"github.com/sapcc/kubernikus/pkg/api/spec"
+	etcd_util "github.com/sapcc/kubernikus/pkg/util/etcd"
 	logutil "github.com/sapcc/kubernikus/pkg/util/log"
 )
 
@@ -61,22 +62,43 @@ func Configure(api *operations.KubernikusAPI, rt *apipkg.Runtime) error {
 			return err
 		}
 		api.APIAuthorizer = authorizer
+		if enforcer, ok := authorizer.(apipkg.PolicyEnforcer); ok {
+			rt.Policy = enforcer
+		}
 	}
 
+	rt.FetchOpenstackMetadata = handlers.FetchOpenstackMetadata
+	rt.FetchTerminationInventory = handlers.FetchTerminationInventory
+	rt.FetchClusterServers = handlers.FetchClusterServers
+	rt.SnapshotStore = handlers.SnapshotStoreFor
+	rt.TakeFullSnapshot = etcd_util.TakeFullSnapshot
+	rt.VerifyBackupTarget = handlers.VerifyBackupTarget
+
 	api.InfoHandler = handlers.NewInfo(rt)
 	api.ListAPIVersionsHandler = handlers.NewListAPIVersions(rt)
 	api.ListClustersHandler = handlers.NewListClusters(rt)
 	api.CreateClusterHandler = handlers.NewCreateCluster(rt)
 	api.ShowClusterHandler = handlers.NewShowCluster(rt)
 	api.TerminateClusterHandler = handlers.NewTerminateCluster(rt)
+	api.UndeleteClusterHandler = handlers.NewUndeleteCluster(rt)
 	api.UpdateClusterHandler = handlers.NewUpdateCluster(rt)
 	api.GetClusterCredentialsHandler = handlers.NewGetClusterCredentials(rt)
+	api.ListUserCertificatesHandler = handlers.NewListUserCertificates(rt)
+	api.RevokeUserCertificatesHandler = handlers.NewRevokeUserCertificates(rt)
+	api.AllowUserCertificatesHandler = handlers.NewAllowUserCertificates(rt)
 	api.GetClusterCredentialsOIDCHandler = handlers.NewGetClusterCredentialsOIDC(rt)
 	api.GetClusterInfoHandler = handlers.NewGetClusterInfo(rt)
 	api.GetBootstrapConfigHandler = handlers.NewGetBootstrapConfig(rt)
 	api.GetOpenstackMetadataHandler = handlers.NewGetOpenstackMetadata(rt)
 	api.GetClusterEventsHandler = handlers.NewGetClusterEvents(rt)
+	api.ListClusterNodesHandler = handlers.NewListClusterNodes(rt)
 	api.GetClusterValuesHandler = handlers.NewGetClusterValues(rt)
+	api.GetClusterTerminationReportHandler = handlers.NewGetClusterTerminationReport(rt)
+	api.GetClusterCertificatesHandler = handlers.NewGetClusterCertificates(rt)
+	api.RotateClusterCAHandler = handlers.NewRotateClusterCA(rt)
+	api.ListClusterBackupsHandler = handlers.NewListClusterBackups(rt)
+	api.CreateClusterBackupHandler = handlers.NewCreateClusterBackup(rt)
+	api.RestoreClusterBackupHandler = handlers.NewRestoreClusterBackup(rt)
 	api.GetClusterKubeadmSecretHandler = handlers.NewGetClusterKubeadmSecret(rt)
 
 	api.ServerShutdown = func() {}
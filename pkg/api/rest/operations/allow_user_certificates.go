@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// AllowUserCertificatesHandlerFunc turns a function with the right signature into a allow user certificates handler
+type AllowUserCertificatesHandlerFunc func(AllowUserCertificatesParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn AllowUserCertificatesHandlerFunc) Handle(params AllowUserCertificatesParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// AllowUserCertificatesHandler interface for that can handle valid allow user certificates params
+type AllowUserCertificatesHandler interface {
+	Handle(AllowUserCertificatesParams, *models.Principal) middleware.Responder
+}
+
+// NewAllowUserCertificates creates a new http.Handler for the allow user certificates operation
+func NewAllowUserCertificates(ctx *middleware.Context, handler AllowUserCertificatesHandler) *AllowUserCertificates {
+	return &AllowUserCertificates{Context: ctx, Handler: handler}
+}
+
+/*
+	AllowUserCertificates swagger:route DELETE /api/v1/clusters/{name}/usercertificates/denied/{user} allowUserCertificates
+
+Remove a user from the deny list
+*/
+type AllowUserCertificates struct {
+	Context *middleware.Context
+	Handler AllowUserCertificatesHandler
+}
+
+func (o *AllowUserCertificates) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewAllowUserCertificatesParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,95 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewAllowUserCertificatesParams creates a new AllowUserCertificatesParams object
+//
+// There are no default values defined in the spec.
+func NewAllowUserCertificatesParams() AllowUserCertificatesParams {
+
+	return AllowUserCertificatesParams{}
+}
+
+// AllowUserCertificatesParams contains all the bound params for the allow user certificates operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters AllowUserCertificates
+type AllowUserCertificatesParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  Required: true
+	  In: path
+	*/
+	Name string
+	/*
+	  Required: true
+	  In: path
+	*/
+	User string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewAllowUserCertificatesParams() beforehand.
+func (o *AllowUserCertificatesParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rName, rhkName, _ := route.Params.GetOK("name")
+	if err := o.bindName(rName, rhkName, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rUser, rhkUser, _ := route.Params.GetOK("user")
+	if err := o.bindUser(rUser, rhkUser, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindName binds and validates parameter Name from path.
+func (o *AllowUserCertificatesParams) bindName(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.Name = raw
+
+	return nil
+}
+
+// bindUser binds and validates parameter User from path.
+func (o *AllowUserCertificatesParams) bindUser(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.User = raw
+
+	return nil
+}
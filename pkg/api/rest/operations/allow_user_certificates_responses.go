@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// AllowUserCertificatesOKCode is the HTTP code returned for type AllowUserCertificatesOK
+const AllowUserCertificatesOKCode int = 200
+
+/*
+AllowUserCertificatesOK OK
+
+swagger:response allowUserCertificatesOK
+*/
+type AllowUserCertificatesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.UserCertificateList `json:"body,omitempty"`
+}
+
+// NewAllowUserCertificatesOK creates AllowUserCertificatesOK with default headers values
+func NewAllowUserCertificatesOK() *AllowUserCertificatesOK {
+
+	return &AllowUserCertificatesOK{}
+}
+
+// WithPayload adds the payload to the allow user certificates o k response
+func (o *AllowUserCertificatesOK) WithPayload(payload *models.UserCertificateList) *AllowUserCertificatesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the allow user certificates o k response
+func (o *AllowUserCertificatesOK) SetPayload(payload *models.UserCertificateList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AllowUserCertificatesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+AllowUserCertificatesDefault Error
+
+swagger:response allowUserCertificatesDefault
+*/
+type AllowUserCertificatesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewAllowUserCertificatesDefault creates AllowUserCertificatesDefault with default headers values
+func NewAllowUserCertificatesDefault(code int) *AllowUserCertificatesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &AllowUserCertificatesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the allow user certificates default response
+func (o *AllowUserCertificatesDefault) WithStatusCode(code int) *AllowUserCertificatesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the allow user certificates default response
+func (o *AllowUserCertificatesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the allow user certificates default response
+func (o *AllowUserCertificatesDefault) WithPayload(payload *models.Error) *AllowUserCertificatesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the allow user certificates default response
+func (o *AllowUserCertificatesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AllowUserCertificatesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
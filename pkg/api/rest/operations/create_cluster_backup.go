@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// CreateClusterBackupHandlerFunc turns a function with the right signature into a create cluster backup handler
+type CreateClusterBackupHandlerFunc func(CreateClusterBackupParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreateClusterBackupHandlerFunc) Handle(params CreateClusterBackupParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// CreateClusterBackupHandler interface for that can handle valid create cluster backup params
+type CreateClusterBackupHandler interface {
+	Handle(CreateClusterBackupParams, *models.Principal) middleware.Responder
+}
+
+// NewCreateClusterBackup creates a new http.Handler for the create cluster backup operation
+func NewCreateClusterBackup(ctx *middleware.Context, handler CreateClusterBackupHandler) *CreateClusterBackup {
+	return &CreateClusterBackup{Context: ctx, Handler: handler}
+}
+
+/*
+	CreateClusterBackup swagger:route POST /api/v1/{account}/clusters/{name}/backups createClusterBackup
+
+Take a full etcd snapshot of the cluster and wait until it is stored (admin-only)
+*/
+type CreateClusterBackup struct {
+	Context *middleware.Context
+	Handler CreateClusterBackupHandler
+}
+
+func (o *CreateClusterBackup) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewCreateClusterBackupParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,95 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewCreateClusterBackupParams creates a new CreateClusterBackupParams object
+//
+// There are no default values defined in the spec.
+func NewCreateClusterBackupParams() CreateClusterBackupParams {
+
+	return CreateClusterBackupParams{}
+}
+
+// CreateClusterBackupParams contains all the bound params for the create cluster backup operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters CreateClusterBackup
+type CreateClusterBackupParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  Required: true
+	  In: path
+	*/
+	Account string
+	/*
+	  Required: true
+	  In: path
+	*/
+	Name string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewCreateClusterBackupParams() beforehand.
+func (o *CreateClusterBackupParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rAccount, rhkAccount, _ := route.Params.GetOK("account")
+	if err := o.bindAccount(rAccount, rhkAccount, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rName, rhkName, _ := route.Params.GetOK("name")
+	if err := o.bindName(rName, rhkName, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindAccount binds and validates parameter Account from path.
+func (o *CreateClusterBackupParams) bindAccount(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.Account = raw
+
+	return nil
+}
+
+// bindName binds and validates parameter Name from path.
+func (o *CreateClusterBackupParams) bindName(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.Name = raw
+
+	return nil
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// CreateClusterBackupOKCode is the HTTP code returned for type CreateClusterBackupOK
+const CreateClusterBackupOKCode int = 200
+
+/*
+CreateClusterBackupOK OK
+
+swagger:response createClusterBackupOK
+*/
+type CreateClusterBackupOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.EtcdSnapshotReference `json:"body,omitempty"`
+}
+
+// NewCreateClusterBackupOK creates CreateClusterBackupOK with default headers values
+func NewCreateClusterBackupOK() *CreateClusterBackupOK {
+
+	return &CreateClusterBackupOK{}
+}
+
+// WithPayload adds the payload to the create cluster backup o k response
+func (o *CreateClusterBackupOK) WithPayload(payload *models.EtcdSnapshotReference) *CreateClusterBackupOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create cluster backup o k response
+func (o *CreateClusterBackupOK) SetPayload(payload *models.EtcdSnapshotReference) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateClusterBackupOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+CreateClusterBackupDefault Error
+
+swagger:response createClusterBackupDefault
+*/
+type CreateClusterBackupDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateClusterBackupDefault creates CreateClusterBackupDefault with default headers values
+func NewCreateClusterBackupDefault(code int) *CreateClusterBackupDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreateClusterBackupDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create cluster backup default response
+func (o *CreateClusterBackupDefault) WithStatusCode(code int) *CreateClusterBackupDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create cluster backup default response
+func (o *CreateClusterBackupDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create cluster backup default response
+func (o *CreateClusterBackupDefault) WithPayload(payload *models.Error) *CreateClusterBackupDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create cluster backup default response
+func (o *CreateClusterBackupDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateClusterBackupDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// GetClusterCertificatesHandlerFunc turns a function with the right signature into a get cluster certificates handler
+type GetClusterCertificatesHandlerFunc func(GetClusterCertificatesParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetClusterCertificatesHandlerFunc) Handle(params GetClusterCertificatesParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetClusterCertificatesHandler interface for that can handle valid get cluster certificates params
+type GetClusterCertificatesHandler interface {
+	Handle(GetClusterCertificatesParams, *models.Principal) middleware.Responder
+}
+
+// NewGetClusterCertificates creates a new http.Handler for the get cluster certificates operation
+func NewGetClusterCertificates(ctx *middleware.Context, handler GetClusterCertificatesHandler) *GetClusterCertificates {
+	return &GetClusterCertificates{Context: ctx, Handler: handler}
+}
+
+/*
+	GetClusterCertificates swagger:route GET /api/v1/{account}/clusters/{name}/certificates getClusterCertificates
+
+List the certificates and CAs of the cluster with their expiry (admin-only)
+*/
+type GetClusterCertificates struct {
+	Context *middleware.Context
+	Handler GetClusterCertificatesHandler
+}
+
+func (o *GetClusterCertificates) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetClusterCertificatesParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,121 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// GetClusterCertificatesOKCode is the HTTP code returned for type GetClusterCertificatesOK
+const GetClusterCertificatesOKCode int = 200
+
+/*
+GetClusterCertificatesOK OK
+
+swagger:response getClusterCertificatesOK
+*/
+type GetClusterCertificatesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []models.CertificateInfo `json:"body,omitempty"`
+}
+
+// NewGetClusterCertificatesOK creates GetClusterCertificatesOK with default headers values
+func NewGetClusterCertificatesOK() *GetClusterCertificatesOK {
+
+	return &GetClusterCertificatesOK{}
+}
+
+// WithPayload adds the payload to the get cluster certificates o k response
+func (o *GetClusterCertificatesOK) WithPayload(payload []models.CertificateInfo) *GetClusterCertificatesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get cluster certificates o k response
+func (o *GetClusterCertificatesOK) SetPayload(payload []models.CertificateInfo) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetClusterCertificatesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]models.CertificateInfo, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*
+GetClusterCertificatesDefault Error
+
+swagger:response getClusterCertificatesDefault
+*/
+type GetClusterCertificatesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetClusterCertificatesDefault creates GetClusterCertificatesDefault with default headers values
+func NewGetClusterCertificatesDefault(code int) *GetClusterCertificatesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetClusterCertificatesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get cluster certificates default response
+func (o *GetClusterCertificatesDefault) WithStatusCode(code int) *GetClusterCertificatesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get cluster certificates default response
+func (o *GetClusterCertificatesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get cluster certificates default response
+func (o *GetClusterCertificatesDefault) WithPayload(payload *models.Error) *GetClusterCertificatesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get cluster certificates default response
+func (o *GetClusterCertificatesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetClusterCertificatesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
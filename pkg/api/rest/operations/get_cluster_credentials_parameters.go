@@ -9,8 +9,11 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // NewGetClusterCredentialsParams creates a new GetClusterCredentialsParams object
@@ -35,6 +38,11 @@ type GetClusterCredentialsParams struct {
 	  In: path
 	*/
 	Name string
+	/*Lifetime of the client certificate in seconds. Limited by the API, defaults to the maximum.
+	  Minimum: 60
+	  In: query
+	*/
+	TTL *int64
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -46,10 +54,17 @@ func (o *GetClusterCredentialsParams) BindRequest(r *http.Request, route *middle
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
 	rName, rhkName, _ := route.Params.GetOK("name")
 	if err := o.bindName(rName, rhkName, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qTTL, qhkTTL, _ := qs.GetOK("ttl")
+	if err := o.bindTTL(qTTL, qhkTTL, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -69,3 +84,40 @@ func (o *GetClusterCredentialsParams) bindName(rawData []string, hasKey bool, fo
 
 	return nil
 }
+
+// bindTTL binds and validates parameter TTL from query.
+func (o *GetClusterCredentialsParams) bindTTL(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("ttl", "query", "int64", raw)
+	}
+	o.TTL = &value
+
+	if err := o.validateTTL(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateTTL carries on validations for parameter TTL
+func (o *GetClusterCredentialsParams) validateTTL(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("ttl", "query", *o.TTL, 60, false); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -10,12 +10,16 @@ import (
 	"net/url"
 	golangswaggerpaths "path"
 	"strings"
+
+	"github.com/go-openapi/swag"
 )
 
 // GetClusterCredentialsURL generates an URL for the get cluster credentials operation
 type GetClusterCredentialsURL struct {
 	Name string
 
+	TTL *int64
+
 	_basePath string
 	// avoid unkeyed usage
 	_ struct{}
@@ -52,6 +56,18 @@ func (o *GetClusterCredentialsURL) Build() (*url.URL, error) {
 	_basePath := o._basePath
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var ttlQ string
+	if o.TTL != nil {
+		ttlQ = swag.FormatInt64(*o.TTL)
+	}
+	if ttlQ != "" {
+		qs.Set("ttl", ttlQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
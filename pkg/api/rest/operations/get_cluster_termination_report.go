@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// GetClusterTerminationReportHandlerFunc turns a function with the right signature into a get cluster termination report handler
+type GetClusterTerminationReportHandlerFunc func(GetClusterTerminationReportParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetClusterTerminationReportHandlerFunc) Handle(params GetClusterTerminationReportParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetClusterTerminationReportHandler interface for that can handle valid get cluster termination report params
+type GetClusterTerminationReportHandler interface {
+	Handle(GetClusterTerminationReportParams, *models.Principal) middleware.Responder
+}
+
+// NewGetClusterTerminationReport creates a new http.Handler for the get cluster termination report operation
+func NewGetClusterTerminationReport(ctx *middleware.Context, handler GetClusterTerminationReportHandler) *GetClusterTerminationReport {
+	return &GetClusterTerminationReport{Context: ctx, Handler: handler}
+}
+
+/*
+	GetClusterTerminationReport swagger:route GET /api/v1/{account}/clusters/{name}/terminationreport getClusterTerminationReport
+
+Get OpenStack resources left behind by a terminated cluster (admin-only)
+*/
+type GetClusterTerminationReport struct {
+	Context *middleware.Context
+	Handler GetClusterTerminationReportHandler
+}
+
+func (o *GetClusterTerminationReport) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetClusterTerminationReportParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
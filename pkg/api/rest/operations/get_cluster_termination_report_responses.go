@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// GetClusterTerminationReportOKCode is the HTTP code returned for type GetClusterTerminationReportOK
+const GetClusterTerminationReportOKCode int = 200
+
+/*
+GetClusterTerminationReportOK OK
+
+swagger:response getClusterTerminationReportOK
+*/
+type GetClusterTerminationReportOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.TerminationReport `json:"body,omitempty"`
+}
+
+// NewGetClusterTerminationReportOK creates GetClusterTerminationReportOK with default headers values
+func NewGetClusterTerminationReportOK() *GetClusterTerminationReportOK {
+
+	return &GetClusterTerminationReportOK{}
+}
+
+// WithPayload adds the payload to the get cluster termination report o k response
+func (o *GetClusterTerminationReportOK) WithPayload(payload *models.TerminationReport) *GetClusterTerminationReportOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get cluster termination report o k response
+func (o *GetClusterTerminationReportOK) SetPayload(payload *models.TerminationReport) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetClusterTerminationReportOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+GetClusterTerminationReportDefault Error
+
+swagger:response getClusterTerminationReportDefault
+*/
+type GetClusterTerminationReportDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetClusterTerminationReportDefault creates GetClusterTerminationReportDefault with default headers values
+func NewGetClusterTerminationReportDefault(code int) *GetClusterTerminationReportDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetClusterTerminationReportDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get cluster termination report default response
+func (o *GetClusterTerminationReportDefault) WithStatusCode(code int) *GetClusterTerminationReportDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get cluster termination report default response
+func (o *GetClusterTerminationReportDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get cluster termination report default response
+func (o *GetClusterTerminationReportDefault) WithPayload(payload *models.Error) *GetClusterTerminationReportDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get cluster termination report default response
+func (o *GetClusterTerminationReportDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetClusterTerminationReportDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
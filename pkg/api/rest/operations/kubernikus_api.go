@@ -44,9 +44,15 @@ func NewKubernikusAPI(spec *loads.Document) *KubernikusAPI {
 
 		JSONProducer: runtime.JSONProducer(),
 
+		AllowUserCertificatesHandler: AllowUserCertificatesHandlerFunc(func(params AllowUserCertificatesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation AllowUserCertificates has not yet been implemented")
+		}),
 		CreateClusterHandler: CreateClusterHandlerFunc(func(params CreateClusterParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation CreateCluster has not yet been implemented")
 		}),
+		CreateClusterBackupHandler: CreateClusterBackupHandlerFunc(func(params CreateClusterBackupParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation CreateClusterBackup has not yet been implemented")
+		}),
 		GetAuthCallbackHandler: GetAuthCallbackHandlerFunc(func(params GetAuthCallbackParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetAuthCallback has not yet been implemented")
 		}),
@@ -56,6 +62,9 @@ func NewKubernikusAPI(spec *loads.Document) *KubernikusAPI {
 		GetBootstrapConfigHandler: GetBootstrapConfigHandlerFunc(func(params GetBootstrapConfigParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation GetBootstrapConfig has not yet been implemented")
 		}),
+		GetClusterCertificatesHandler: GetClusterCertificatesHandlerFunc(func(params GetClusterCertificatesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation GetClusterCertificates has not yet been implemented")
+		}),
 		GetClusterCredentialsHandler: GetClusterCredentialsHandlerFunc(func(params GetClusterCredentialsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation GetClusterCredentials has not yet been implemented")
 		}),
@@ -71,6 +80,9 @@ func NewKubernikusAPI(spec *loads.Document) *KubernikusAPI {
 		GetClusterKubeadmSecretHandler: GetClusterKubeadmSecretHandlerFunc(func(params GetClusterKubeadmSecretParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation GetClusterKubeadmSecret has not yet been implemented")
 		}),
+		GetClusterTerminationReportHandler: GetClusterTerminationReportHandlerFunc(func(params GetClusterTerminationReportParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation GetClusterTerminationReport has not yet been implemented")
+		}),
 		GetClusterValuesHandler: GetClusterValuesHandlerFunc(func(params GetClusterValuesParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation GetClusterValues has not yet been implemented")
 		}),
@@ -83,15 +95,36 @@ func NewKubernikusAPI(spec *loads.Document) *KubernikusAPI {
 		ListAPIVersionsHandler: ListAPIVersionsHandlerFunc(func(params ListAPIVersionsParams) middleware.Responder {
 			return middleware.NotImplemented("operation ListAPIVersions has not yet been implemented")
 		}),
+		ListClusterBackupsHandler: ListClusterBackupsHandlerFunc(func(params ListClusterBackupsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation ListClusterBackups has not yet been implemented")
+		}),
+		ListClusterNodesHandler: ListClusterNodesHandlerFunc(func(params ListClusterNodesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation ListClusterNodes has not yet been implemented")
+		}),
 		ListClustersHandler: ListClustersHandlerFunc(func(params ListClustersParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation ListClusters has not yet been implemented")
 		}),
+		ListUserCertificatesHandler: ListUserCertificatesHandlerFunc(func(params ListUserCertificatesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation ListUserCertificates has not yet been implemented")
+		}),
+		RestoreClusterBackupHandler: RestoreClusterBackupHandlerFunc(func(params RestoreClusterBackupParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation RestoreClusterBackup has not yet been implemented")
+		}),
+		RevokeUserCertificatesHandler: RevokeUserCertificatesHandlerFunc(func(params RevokeUserCertificatesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation RevokeUserCertificates has not yet been implemented")
+		}),
+		RotateClusterCAHandler: RotateClusterCAHandlerFunc(func(params RotateClusterCAParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation RotateClusterCA has not yet been implemented")
+		}),
 		ShowClusterHandler: ShowClusterHandlerFunc(func(params ShowClusterParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation ShowCluster has not yet been implemented")
 		}),
 		TerminateClusterHandler: TerminateClusterHandlerFunc(func(params TerminateClusterParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation TerminateCluster has not yet been implemented")
 		}),
+		UndeleteClusterHandler: UndeleteClusterHandlerFunc(func(params UndeleteClusterParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation UndeleteCluster has not yet been implemented")
+		}),
 		UpdateClusterHandler: UpdateClusterHandlerFunc(func(params UpdateClusterParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation UpdateCluster has not yet been implemented")
 		}),
@@ -152,14 +185,20 @@ type KubernikusAPI struct {
 	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
 	APIAuthorizer runtime.Authorizer
 
+	// AllowUserCertificatesHandler sets the operation handler for the allow user certificates operation
+	AllowUserCertificatesHandler AllowUserCertificatesHandler
 	// CreateClusterHandler sets the operation handler for the create cluster operation
 	CreateClusterHandler CreateClusterHandler
+	// CreateClusterBackupHandler sets the operation handler for the create cluster backup operation
+	CreateClusterBackupHandler CreateClusterBackupHandler
 	// GetAuthCallbackHandler sets the operation handler for the get auth callback operation
 	GetAuthCallbackHandler GetAuthCallbackHandler
 	// GetAuthLoginHandler sets the operation handler for the get auth login operation
 	GetAuthLoginHandler GetAuthLoginHandler
 	// GetBootstrapConfigHandler sets the operation handler for the get bootstrap config operation
 	GetBootstrapConfigHandler GetBootstrapConfigHandler
+	// GetClusterCertificatesHandler sets the operation handler for the get cluster certificates operation
+	GetClusterCertificatesHandler GetClusterCertificatesHandler
 	// GetClusterCredentialsHandler sets the operation handler for the get cluster credentials operation
 	GetClusterCredentialsHandler GetClusterCredentialsHandler
 	// GetClusterCredentialsOIDCHandler sets the operation handler for the get cluster credentials o ID c operation
@@ -170,6 +209,8 @@ type KubernikusAPI struct {
 	GetClusterInfoHandler GetClusterInfoHandler
 	// GetClusterKubeadmSecretHandler sets the operation handler for the get cluster kubeadm secret operation
 	GetClusterKubeadmSecretHandler GetClusterKubeadmSecretHandler
+	// GetClusterTerminationReportHandler sets the operation handler for the get cluster termination report operation
+	GetClusterTerminationReportHandler GetClusterTerminationReportHandler
 	// GetClusterValuesHandler sets the operation handler for the get cluster values operation
 	GetClusterValuesHandler GetClusterValuesHandler
 	// GetOpenstackMetadataHandler sets the operation handler for the get openstack metadata operation
@@ -178,12 +219,26 @@ type KubernikusAPI struct {
 	InfoHandler InfoHandler
 	// ListAPIVersionsHandler sets the operation handler for the list API versions operation
 	ListAPIVersionsHandler ListAPIVersionsHandler
+	// ListClusterBackupsHandler sets the operation handler for the list cluster backups operation
+	ListClusterBackupsHandler ListClusterBackupsHandler
+	// ListClusterNodesHandler sets the operation handler for the list cluster nodes operation
+	ListClusterNodesHandler ListClusterNodesHandler
 	// ListClustersHandler sets the operation handler for the list clusters operation
 	ListClustersHandler ListClustersHandler
+	// ListUserCertificatesHandler sets the operation handler for the list user certificates operation
+	ListUserCertificatesHandler ListUserCertificatesHandler
+	// RestoreClusterBackupHandler sets the operation handler for the restore cluster backup operation
+	RestoreClusterBackupHandler RestoreClusterBackupHandler
+	// RevokeUserCertificatesHandler sets the operation handler for the revoke user certificates operation
+	RevokeUserCertificatesHandler RevokeUserCertificatesHandler
+	// RotateClusterCAHandler sets the operation handler for the rotate cluster c a operation
+	RotateClusterCAHandler RotateClusterCAHandler
 	// ShowClusterHandler sets the operation handler for the show cluster operation
 	ShowClusterHandler ShowClusterHandler
 	// TerminateClusterHandler sets the operation handler for the terminate cluster operation
 	TerminateClusterHandler TerminateClusterHandler
+	// UndeleteClusterHandler sets the operation handler for the undelete cluster operation
+	UndeleteClusterHandler UndeleteClusterHandler
 	// UpdateClusterHandler sets the operation handler for the update cluster operation
 	UpdateClusterHandler UpdateClusterHandler
 
@@ -270,9 +325,15 @@ func (o *KubernikusAPI) Validate() error {
 		unregistered = append(unregistered, "XAuthTokenAuth")
 	}
 
+	if o.AllowUserCertificatesHandler == nil {
+		unregistered = append(unregistered, "AllowUserCertificatesHandler")
+	}
 	if o.CreateClusterHandler == nil {
 		unregistered = append(unregistered, "CreateClusterHandler")
 	}
+	if o.CreateClusterBackupHandler == nil {
+		unregistered = append(unregistered, "CreateClusterBackupHandler")
+	}
 	if o.GetAuthCallbackHandler == nil {
 		unregistered = append(unregistered, "GetAuthCallbackHandler")
 	}
@@ -282,6 +343,9 @@ func (o *KubernikusAPI) Validate() error {
 	if o.GetBootstrapConfigHandler == nil {
 		unregistered = append(unregistered, "GetBootstrapConfigHandler")
 	}
+	if o.GetClusterCertificatesHandler == nil {
+		unregistered = append(unregistered, "GetClusterCertificatesHandler")
+	}
 	if o.GetClusterCredentialsHandler == nil {
 		unregistered = append(unregistered, "GetClusterCredentialsHandler")
 	}
@@ -297,6 +361,9 @@ func (o *KubernikusAPI) Validate() error {
 	if o.GetClusterKubeadmSecretHandler == nil {
 		unregistered = append(unregistered, "GetClusterKubeadmSecretHandler")
 	}
+	if o.GetClusterTerminationReportHandler == nil {
+		unregistered = append(unregistered, "GetClusterTerminationReportHandler")
+	}
 	if o.GetClusterValuesHandler == nil {
 		unregistered = append(unregistered, "GetClusterValuesHandler")
 	}
@@ -309,15 +376,36 @@ func (o *KubernikusAPI) Validate() error {
 	if o.ListAPIVersionsHandler == nil {
 		unregistered = append(unregistered, "ListAPIVersionsHandler")
 	}
+	if o.ListClusterBackupsHandler == nil {
+		unregistered = append(unregistered, "ListClusterBackupsHandler")
+	}
+	if o.ListClusterNodesHandler == nil {
+		unregistered = append(unregistered, "ListClusterNodesHandler")
+	}
 	if o.ListClustersHandler == nil {
 		unregistered = append(unregistered, "ListClustersHandler")
 	}
+	if o.ListUserCertificatesHandler == nil {
+		unregistered = append(unregistered, "ListUserCertificatesHandler")
+	}
+	if o.RestoreClusterBackupHandler == nil {
+		unregistered = append(unregistered, "RestoreClusterBackupHandler")
+	}
+	if o.RevokeUserCertificatesHandler == nil {
+		unregistered = append(unregistered, "RevokeUserCertificatesHandler")
+	}
+	if o.RotateClusterCAHandler == nil {
+		unregistered = append(unregistered, "RotateClusterCAHandler")
+	}
 	if o.ShowClusterHandler == nil {
 		unregistered = append(unregistered, "ShowClusterHandler")
 	}
 	if o.TerminateClusterHandler == nil {
 		unregistered = append(unregistered, "TerminateClusterHandler")
 	}
+	if o.UndeleteClusterHandler == nil {
+		unregistered = append(unregistered, "UndeleteClusterHandler")
+	}
 	if o.UpdateClusterHandler == nil {
 		unregistered = append(unregistered, "UpdateClusterHandler")
 	}
@@ -425,10 +513,18 @@ func (o *KubernikusAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/api/v1/clusters/{name}/usercertificates/denied/{user}"] = NewAllowUserCertificates(o.context, o.AllowUserCertificatesHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/api/v1/clusters"] = NewCreateCluster(o.context, o.CreateClusterHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/api/v1/{account}/clusters/{name}/backups"] = NewCreateClusterBackup(o.context, o.CreateClusterBackupHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -444,6 +540,10 @@ func (o *KubernikusAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/api/v1/{account}/clusters/{name}/certificates"] = NewGetClusterCertificates(o.context, o.GetClusterCertificatesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/api/v1/clusters/{name}/credentials"] = NewGetClusterCredentials(o.context, o.GetClusterCredentialsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -464,6 +564,10 @@ func (o *KubernikusAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/api/v1/{account}/clusters/{name}/terminationreport"] = NewGetClusterTerminationReport(o.context, o.GetClusterTerminationReportHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/api/v1/{account}/clusters/{name}/values"] = NewGetClusterValues(o.context, o.GetClusterValuesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -480,15 +584,43 @@ func (o *KubernikusAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/api/v1/{account}/clusters/{name}/backups"] = NewListClusterBackups(o.context, o.ListClusterBackupsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/api/v1/clusters/{name}/nodes"] = NewListClusterNodes(o.context, o.ListClusterNodesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/api/v1/clusters"] = NewListClusters(o.context, o.ListClustersHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/api/v1/clusters/{name}/usercertificates"] = NewListUserCertificates(o.context, o.ListUserCertificatesHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/api/v1/{account}/clusters/{name}/restore"] = NewRestoreClusterBackup(o.context, o.RestoreClusterBackupHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/api/v1/clusters/{name}/usercertificates/revoke"] = NewRevokeUserCertificates(o.context, o.RevokeUserCertificatesHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/api/v1/{account}/clusters/{name}/carotation"] = NewRotateClusterCA(o.context, o.RotateClusterCAHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/api/v1/clusters/{name}"] = NewShowCluster(o.context, o.ShowClusterHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/api/v1/clusters/{name}"] = NewTerminateCluster(o.context, o.TerminateClusterHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/api/v1/clusters/{name}/undelete"] = NewUndeleteCluster(o.context, o.UndeleteClusterHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// ListClusterBackupsHandlerFunc turns a function with the right signature into a list cluster backups handler
+type ListClusterBackupsHandlerFunc func(ListClusterBackupsParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListClusterBackupsHandlerFunc) Handle(params ListClusterBackupsParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListClusterBackupsHandler interface for that can handle valid list cluster backups params
+type ListClusterBackupsHandler interface {
+	Handle(ListClusterBackupsParams, *models.Principal) middleware.Responder
+}
+
+// NewListClusterBackups creates a new http.Handler for the list cluster backups operation
+func NewListClusterBackups(ctx *middleware.Context, handler ListClusterBackupsHandler) *ListClusterBackups {
+	return &ListClusterBackups{Context: ctx, Handler: handler}
+}
+
+/*
+	ListClusterBackups swagger:route GET /api/v1/{account}/clusters/{name}/backups listClusterBackups
+
+List the etcd snapshots available for restoring the cluster (admin-only)
+*/
+type ListClusterBackups struct {
+	Context *middleware.Context
+	Handler ListClusterBackupsHandler
+}
+
+func (o *ListClusterBackups) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListClusterBackupsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,121 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// ListClusterBackupsOKCode is the HTTP code returned for type ListClusterBackupsOK
+const ListClusterBackupsOKCode int = 200
+
+/*
+ListClusterBackupsOK OK
+
+swagger:response listClusterBackupsOK
+*/
+type ListClusterBackupsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []models.EtcdSnapshot `json:"body,omitempty"`
+}
+
+// NewListClusterBackupsOK creates ListClusterBackupsOK with default headers values
+func NewListClusterBackupsOK() *ListClusterBackupsOK {
+
+	return &ListClusterBackupsOK{}
+}
+
+// WithPayload adds the payload to the list cluster backups o k response
+func (o *ListClusterBackupsOK) WithPayload(payload []models.EtcdSnapshot) *ListClusterBackupsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list cluster backups o k response
+func (o *ListClusterBackupsOK) SetPayload(payload []models.EtcdSnapshot) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListClusterBackupsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]models.EtcdSnapshot, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*
+ListClusterBackupsDefault Error
+
+swagger:response listClusterBackupsDefault
+*/
+type ListClusterBackupsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListClusterBackupsDefault creates ListClusterBackupsDefault with default headers values
+func NewListClusterBackupsDefault(code int) *ListClusterBackupsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListClusterBackupsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list cluster backups default response
+func (o *ListClusterBackupsDefault) WithStatusCode(code int) *ListClusterBackupsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list cluster backups default response
+func (o *ListClusterBackupsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list cluster backups default response
+func (o *ListClusterBackupsDefault) WithPayload(payload *models.Error) *ListClusterBackupsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list cluster backups default response
+func (o *ListClusterBackupsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListClusterBackupsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
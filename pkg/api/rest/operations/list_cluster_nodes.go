@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// ListClusterNodesHandlerFunc turns a function with the right signature into a list cluster nodes handler
+type ListClusterNodesHandlerFunc func(ListClusterNodesParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListClusterNodesHandlerFunc) Handle(params ListClusterNodesParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListClusterNodesHandler interface for that can handle valid list cluster nodes params
+type ListClusterNodesHandler interface {
+	Handle(ListClusterNodesParams, *models.Principal) middleware.Responder
+}
+
+// NewListClusterNodes creates a new http.Handler for the list cluster nodes operation
+func NewListClusterNodes(ctx *middleware.Context, handler ListClusterNodesHandler) *ListClusterNodes {
+	return &ListClusterNodes{Context: ctx, Handler: handler}
+}
+
+/*
+	ListClusterNodes swagger:route GET /api/v1/clusters/{name}/nodes listClusterNodes
+
+List the servers and Kubernetes nodes of the cluster side by side
+*/
+type ListClusterNodes struct {
+	Context *middleware.Context
+	Handler ListClusterNodesHandler
+}
+
+func (o *ListClusterNodes) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListClusterNodesParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,121 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// ListClusterNodesOKCode is the HTTP code returned for type ListClusterNodesOK
+const ListClusterNodesOKCode int = 200
+
+/*
+ListClusterNodesOK OK
+
+swagger:response listClusterNodesOK
+*/
+type ListClusterNodesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []models.ClusterNode `json:"body,omitempty"`
+}
+
+// NewListClusterNodesOK creates ListClusterNodesOK with default headers values
+func NewListClusterNodesOK() *ListClusterNodesOK {
+
+	return &ListClusterNodesOK{}
+}
+
+// WithPayload adds the payload to the list cluster nodes o k response
+func (o *ListClusterNodesOK) WithPayload(payload []models.ClusterNode) *ListClusterNodesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list cluster nodes o k response
+func (o *ListClusterNodesOK) SetPayload(payload []models.ClusterNode) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListClusterNodesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]models.ClusterNode, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*
+ListClusterNodesDefault Error
+
+swagger:response listClusterNodesDefault
+*/
+type ListClusterNodesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListClusterNodesDefault creates ListClusterNodesDefault with default headers values
+func NewListClusterNodesDefault(code int) *ListClusterNodesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListClusterNodesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list cluster nodes default response
+func (o *ListClusterNodesDefault) WithStatusCode(code int) *ListClusterNodesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list cluster nodes default response
+func (o *ListClusterNodesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list cluster nodes default response
+func (o *ListClusterNodesDefault) WithPayload(payload *models.Error) *ListClusterNodesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list cluster nodes default response
+func (o *ListClusterNodesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListClusterNodesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
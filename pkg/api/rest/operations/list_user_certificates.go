@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// ListUserCertificatesHandlerFunc turns a function with the right signature into a list user certificates handler
+type ListUserCertificatesHandlerFunc func(ListUserCertificatesParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListUserCertificatesHandlerFunc) Handle(params ListUserCertificatesParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListUserCertificatesHandler interface for that can handle valid list user certificates params
+type ListUserCertificatesHandler interface {
+	Handle(ListUserCertificatesParams, *models.Principal) middleware.Responder
+}
+
+// NewListUserCertificates creates a new http.Handler for the list user certificates operation
+func NewListUserCertificates(ctx *middleware.Context, handler ListUserCertificatesHandler) *ListUserCertificates {
+	return &ListUserCertificates{Context: ctx, Handler: handler}
+}
+
+/*
+	ListUserCertificates swagger:route GET /api/v1/clusters/{name}/usercertificates listUserCertificates
+
+List the client certificates issued to users and the denied users
+*/
+type ListUserCertificates struct {
+	Context *middleware.Context
+	Handler ListUserCertificatesHandler
+}
+
+func (o *ListUserCertificates) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListUserCertificatesParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
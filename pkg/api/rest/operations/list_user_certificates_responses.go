@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// ListUserCertificatesOKCode is the HTTP code returned for type ListUserCertificatesOK
+const ListUserCertificatesOKCode int = 200
+
+/*
+ListUserCertificatesOK OK
+
+swagger:response listUserCertificatesOK
+*/
+type ListUserCertificatesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.UserCertificateList `json:"body,omitempty"`
+}
+
+// NewListUserCertificatesOK creates ListUserCertificatesOK with default headers values
+func NewListUserCertificatesOK() *ListUserCertificatesOK {
+
+	return &ListUserCertificatesOK{}
+}
+
+// WithPayload adds the payload to the list user certificates o k response
+func (o *ListUserCertificatesOK) WithPayload(payload *models.UserCertificateList) *ListUserCertificatesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list user certificates o k response
+func (o *ListUserCertificatesOK) SetPayload(payload *models.UserCertificateList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListUserCertificatesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+ListUserCertificatesDefault Error
+
+swagger:response listUserCertificatesDefault
+*/
+type ListUserCertificatesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListUserCertificatesDefault creates ListUserCertificatesDefault with default headers values
+func NewListUserCertificatesDefault(code int) *ListUserCertificatesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListUserCertificatesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list user certificates default response
+func (o *ListUserCertificatesDefault) WithStatusCode(code int) *ListUserCertificatesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list user certificates default response
+func (o *ListUserCertificatesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list user certificates default response
+func (o *ListUserCertificatesDefault) WithPayload(payload *models.Error) *ListUserCertificatesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list user certificates default response
+func (o *ListUserCertificatesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListUserCertificatesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
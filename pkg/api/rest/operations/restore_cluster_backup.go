@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// RestoreClusterBackupHandlerFunc turns a function with the right signature into a restore cluster backup handler
+type RestoreClusterBackupHandlerFunc func(RestoreClusterBackupParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn RestoreClusterBackupHandlerFunc) Handle(params RestoreClusterBackupParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// RestoreClusterBackupHandler interface for that can handle valid restore cluster backup params
+type RestoreClusterBackupHandler interface {
+	Handle(RestoreClusterBackupParams, *models.Principal) middleware.Responder
+}
+
+// NewRestoreClusterBackup creates a new http.Handler for the restore cluster backup operation
+func NewRestoreClusterBackup(ctx *middleware.Context, handler RestoreClusterBackupHandler) *RestoreClusterBackup {
+	return &RestoreClusterBackup{Context: ctx, Handler: handler}
+}
+
+/*
+	RestoreClusterBackup swagger:route POST /api/v1/{account}/clusters/{name}/restore restoreClusterBackup
+
+Restore the etcd of the cluster to a snapshot (admin-only)
+*/
+type RestoreClusterBackup struct {
+	Context *middleware.Context
+	Handler RestoreClusterBackupHandler
+}
+
+func (o *RestoreClusterBackup) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewRestoreClusterBackupParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// RestoreClusterBackupOKCode is the HTTP code returned for type RestoreClusterBackupOK
+const RestoreClusterBackupOKCode int = 200
+
+/*
+RestoreClusterBackupOK OK
+
+swagger:response restoreClusterBackupOK
+*/
+type RestoreClusterBackupOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.EtcdRestoreStatus `json:"body,omitempty"`
+}
+
+// NewRestoreClusterBackupOK creates RestoreClusterBackupOK with default headers values
+func NewRestoreClusterBackupOK() *RestoreClusterBackupOK {
+
+	return &RestoreClusterBackupOK{}
+}
+
+// WithPayload adds the payload to the restore cluster backup o k response
+func (o *RestoreClusterBackupOK) WithPayload(payload *models.EtcdRestoreStatus) *RestoreClusterBackupOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the restore cluster backup o k response
+func (o *RestoreClusterBackupOK) SetPayload(payload *models.EtcdRestoreStatus) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RestoreClusterBackupOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+RestoreClusterBackupDefault Error
+
+swagger:response restoreClusterBackupDefault
+*/
+type RestoreClusterBackupDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRestoreClusterBackupDefault creates RestoreClusterBackupDefault with default headers values
+func NewRestoreClusterBackupDefault(code int) *RestoreClusterBackupDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &RestoreClusterBackupDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the restore cluster backup default response
+func (o *RestoreClusterBackupDefault) WithStatusCode(code int) *RestoreClusterBackupDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the restore cluster backup default response
+func (o *RestoreClusterBackupDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the restore cluster backup default response
+func (o *RestoreClusterBackupDefault) WithPayload(payload *models.Error) *RestoreClusterBackupDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the restore cluster backup default response
+func (o *RestoreClusterBackupDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RestoreClusterBackupDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
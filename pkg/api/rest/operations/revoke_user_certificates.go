@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// RevokeUserCertificatesHandlerFunc turns a function with the right signature into a revoke user certificates handler
+type RevokeUserCertificatesHandlerFunc func(RevokeUserCertificatesParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn RevokeUserCertificatesHandlerFunc) Handle(params RevokeUserCertificatesParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// RevokeUserCertificatesHandler interface for that can handle valid revoke user certificates params
+type RevokeUserCertificatesHandler interface {
+	Handle(RevokeUserCertificatesParams, *models.Principal) middleware.Responder
+}
+
+// NewRevokeUserCertificates creates a new http.Handler for the revoke user certificates operation
+func NewRevokeUserCertificates(ctx *middleware.Context, handler RevokeUserCertificatesHandler) *RevokeUserCertificates {
+	return &RevokeUserCertificates{Context: ctx, Handler: handler}
+}
+
+/*
+	RevokeUserCertificates swagger:route POST /api/v1/clusters/{name}/usercertificates/revoke revokeUserCertificates
+
+Revoke all user certificates by replacing the users CA and optionally deny users new ones
+*/
+type RevokeUserCertificates struct {
+	Context *middleware.Context
+	Handler RevokeUserCertificatesHandler
+}
+
+func (o *RevokeUserCertificates) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewRevokeUserCertificatesParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
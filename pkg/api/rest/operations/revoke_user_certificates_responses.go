@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// RevokeUserCertificatesOKCode is the HTTP code returned for type RevokeUserCertificatesOK
+const RevokeUserCertificatesOKCode int = 200
+
+/*
+RevokeUserCertificatesOK OK
+
+swagger:response revokeUserCertificatesOK
+*/
+type RevokeUserCertificatesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.UserCertificateList `json:"body,omitempty"`
+}
+
+// NewRevokeUserCertificatesOK creates RevokeUserCertificatesOK with default headers values
+func NewRevokeUserCertificatesOK() *RevokeUserCertificatesOK {
+
+	return &RevokeUserCertificatesOK{}
+}
+
+// WithPayload adds the payload to the revoke user certificates o k response
+func (o *RevokeUserCertificatesOK) WithPayload(payload *models.UserCertificateList) *RevokeUserCertificatesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the revoke user certificates o k response
+func (o *RevokeUserCertificatesOK) SetPayload(payload *models.UserCertificateList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RevokeUserCertificatesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+RevokeUserCertificatesDefault Error
+
+swagger:response revokeUserCertificatesDefault
+*/
+type RevokeUserCertificatesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRevokeUserCertificatesDefault creates RevokeUserCertificatesDefault with default headers values
+func NewRevokeUserCertificatesDefault(code int) *RevokeUserCertificatesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &RevokeUserCertificatesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the revoke user certificates default response
+func (o *RevokeUserCertificatesDefault) WithStatusCode(code int) *RevokeUserCertificatesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the revoke user certificates default response
+func (o *RevokeUserCertificatesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the revoke user certificates default response
+func (o *RevokeUserCertificatesDefault) WithPayload(payload *models.Error) *RevokeUserCertificatesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the revoke user certificates default response
+func (o *RevokeUserCertificatesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RevokeUserCertificatesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
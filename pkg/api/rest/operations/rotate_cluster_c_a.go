@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// RotateClusterCAHandlerFunc turns a function with the right signature into a rotate cluster c a handler
+type RotateClusterCAHandlerFunc func(RotateClusterCAParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn RotateClusterCAHandlerFunc) Handle(params RotateClusterCAParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// RotateClusterCAHandler interface for that can handle valid rotate cluster c a params
+type RotateClusterCAHandler interface {
+	Handle(RotateClusterCAParams, *models.Principal) middleware.Responder
+}
+
+// NewRotateClusterCA creates a new http.Handler for the rotate cluster c a operation
+func NewRotateClusterCA(ctx *middleware.Context, handler RotateClusterCAHandler) *RotateClusterCA {
+	return &RotateClusterCA{Context: ctx, Handler: handler}
+}
+
+/*
+	RotateClusterCA swagger:route POST /api/v1/{account}/clusters/{name}/carotation rotateClusterCA
+
+Start a CA rotation or advance a manual one to its next phase (admin-only)
+*/
+type RotateClusterCA struct {
+	Context *middleware.Context
+	Handler RotateClusterCAHandler
+}
+
+func (o *RotateClusterCA) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewRotateClusterCAParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// RotateClusterCAOKCode is the HTTP code returned for type RotateClusterCAOK
+const RotateClusterCAOKCode int = 200
+
+/*
+RotateClusterCAOK OK
+
+swagger:response rotateClusterCAOK
+*/
+type RotateClusterCAOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.CARotationStatus `json:"body,omitempty"`
+}
+
+// NewRotateClusterCAOK creates RotateClusterCAOK with default headers values
+func NewRotateClusterCAOK() *RotateClusterCAOK {
+
+	return &RotateClusterCAOK{}
+}
+
+// WithPayload adds the payload to the rotate cluster c a o k response
+func (o *RotateClusterCAOK) WithPayload(payload *models.CARotationStatus) *RotateClusterCAOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the rotate cluster c a o k response
+func (o *RotateClusterCAOK) SetPayload(payload *models.CARotationStatus) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RotateClusterCAOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+RotateClusterCADefault Error
+
+swagger:response rotateClusterCADefault
+*/
+type RotateClusterCADefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRotateClusterCADefault creates RotateClusterCADefault with default headers values
+func NewRotateClusterCADefault(code int) *RotateClusterCADefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &RotateClusterCADefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the rotate cluster c a default response
+func (o *RotateClusterCADefault) WithStatusCode(code int) *RotateClusterCADefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the rotate cluster c a default response
+func (o *RotateClusterCADefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the rotate cluster c a default response
+func (o *RotateClusterCADefault) WithPayload(payload *models.Error) *RotateClusterCADefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the rotate cluster c a default response
+func (o *RotateClusterCADefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RotateClusterCADefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
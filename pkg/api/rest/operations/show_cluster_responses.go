@@ -22,6 +22,10 @@ ShowClusterOK OK
 swagger:response showClusterOK
 */
 type ShowClusterOK struct {
+	/*Version of the cluster, pass it as If-Match to update only this version
+
+	 */
+	ETag string `json:"ETag"`
 
 	/*
 	  In: Body
@@ -35,6 +39,17 @@ func NewShowClusterOK() *ShowClusterOK {
 	return &ShowClusterOK{}
 }
 
+// WithETag adds the eTag to the show cluster o k response
+func (o *ShowClusterOK) WithETag(eTag string) *ShowClusterOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the show cluster o k response
+func (o *ShowClusterOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the show cluster o k response
 func (o *ShowClusterOK) WithPayload(payload *models.Kluster) *ShowClusterOK {
 	o.Payload = payload
@@ -49,6 +64,13 @@ func (o *ShowClusterOK) SetPayload(payload *models.Kluster) {
 // WriteResponse to the client
 func (o *ShowClusterOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
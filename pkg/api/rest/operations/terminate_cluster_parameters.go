@@ -9,8 +9,10 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewTerminateClusterParams creates a new TerminateClusterParams object
@@ -30,6 +32,14 @@ type TerminateClusterParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Only list what would be deleted
+	  In: query
+	*/
+	DryRun *bool
+	/*Scale the cluster to zero and delete it after this duration (e.g. 72h) unless it is undeleted
+	  In: query
+	*/
+	GracePeriod *string
 	/*
 	  Required: true
 	  In: path
@@ -46,6 +56,18 @@ func (o *TerminateClusterParams) BindRequest(r *http.Request, route *middleware.
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
+	qDryRun, qhkDryRun, _ := qs.GetOK("dryRun")
+	if err := o.bindDryRun(qDryRun, qhkDryRun, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qGracePeriod, qhkGracePeriod, _ := qs.GetOK("gracePeriod")
+	if err := o.bindGracePeriod(qGracePeriod, qhkGracePeriod, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rName, rhkName, _ := route.Params.GetOK("name")
 	if err := o.bindName(rName, rhkName, route.Formats); err != nil {
 		res = append(res, err)
@@ -56,6 +78,47 @@ func (o *TerminateClusterParams) BindRequest(r *http.Request, route *middleware.
 	return nil
 }
 
+// bindDryRun binds and validates parameter DryRun from query.
+func (o *TerminateClusterParams) bindDryRun(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("dryRun", "query", "bool", raw)
+	}
+	o.DryRun = &value
+
+	return nil
+}
+
+// bindGracePeriod binds and validates parameter GracePeriod from query.
+func (o *TerminateClusterParams) bindGracePeriod(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.GracePeriod = &raw
+
+	return nil
+}
+
 // bindName binds and validates parameter Name from path.
 func (o *TerminateClusterParams) bindName(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -13,6 +13,51 @@ import (
 	"github.com/sapcc/kubernikus/pkg/api/models"
 )
 
+// TerminateClusterOKCode is the HTTP code returned for type TerminateClusterOK
+const TerminateClusterOKCode int = 200
+
+/*
+TerminateClusterOK Dry run
+
+swagger:response terminateClusterOK
+*/
+type TerminateClusterOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.TerminationPlan `json:"body,omitempty"`
+}
+
+// NewTerminateClusterOK creates TerminateClusterOK with default headers values
+func NewTerminateClusterOK() *TerminateClusterOK {
+
+	return &TerminateClusterOK{}
+}
+
+// WithPayload adds the payload to the terminate cluster o k response
+func (o *TerminateClusterOK) WithPayload(payload *models.TerminationPlan) *TerminateClusterOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the terminate cluster o k response
+func (o *TerminateClusterOK) SetPayload(payload *models.TerminationPlan) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TerminateClusterOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // TerminateClusterAcceptedCode is the HTTP code returned for type TerminateClusterAccepted
 const TerminateClusterAcceptedCode int = 202
 
@@ -10,12 +10,17 @@ import (
 	"net/url"
 	golangswaggerpaths "path"
 	"strings"
+
+	"github.com/go-openapi/swag"
 )
 
 // TerminateClusterURL generates an URL for the terminate cluster operation
 type TerminateClusterURL struct {
 	Name string
 
+	DryRun      *bool
+	GracePeriod *string
+
 	_basePath string
 	// avoid unkeyed usage
 	_ struct{}
@@ -52,6 +57,26 @@ func (o *TerminateClusterURL) Build() (*url.URL, error) {
 	_basePath := o._basePath
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var dryRunQ string
+	if o.DryRun != nil {
+		dryRunQ = swag.FormatBool(*o.DryRun)
+	}
+	if dryRunQ != "" {
+		qs.Set("dryRun", dryRunQ)
+	}
+
+	var gracePeriodQ string
+	if o.GracePeriod != nil {
+		gracePeriodQ = *o.GracePeriod
+	}
+	if gracePeriodQ != "" {
+		qs.Set("gracePeriod", gracePeriodQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// UndeleteClusterHandlerFunc turns a function with the right signature into a undelete cluster handler
+type UndeleteClusterHandlerFunc func(UndeleteClusterParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn UndeleteClusterHandlerFunc) Handle(params UndeleteClusterParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// UndeleteClusterHandler interface for that can handle valid undelete cluster params
+type UndeleteClusterHandler interface {
+	Handle(UndeleteClusterParams, *models.Principal) middleware.Responder
+}
+
+// NewUndeleteCluster creates a new http.Handler for the undelete cluster operation
+func NewUndeleteCluster(ctx *middleware.Context, handler UndeleteClusterHandler) *UndeleteCluster {
+	return &UndeleteCluster{Context: ctx, Handler: handler}
+}
+
+/*
+	UndeleteCluster swagger:route POST /api/v1/clusters/{name}/undelete undeleteCluster
+
+Cancel the scheduled deletion of the specified cluster
+*/
+type UndeleteCluster struct {
+	Context *middleware.Context
+	Handler UndeleteClusterHandler
+}
+
+func (o *UndeleteCluster) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewUndeleteClusterParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// UndeleteClusterOKCode is the HTTP code returned for type UndeleteClusterOK
+const UndeleteClusterOKCode int = 200
+
+/*
+UndeleteClusterOK OK
+
+swagger:response undeleteClusterOK
+*/
+type UndeleteClusterOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Kluster `json:"body,omitempty"`
+}
+
+// NewUndeleteClusterOK creates UndeleteClusterOK with default headers values
+func NewUndeleteClusterOK() *UndeleteClusterOK {
+
+	return &UndeleteClusterOK{}
+}
+
+// WithPayload adds the payload to the undelete cluster o k response
+func (o *UndeleteClusterOK) WithPayload(payload *models.Kluster) *UndeleteClusterOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the undelete cluster o k response
+func (o *UndeleteClusterOK) SetPayload(payload *models.Kluster) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UndeleteClusterOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+UndeleteClusterDefault Error
+
+swagger:response undeleteClusterDefault
+*/
+type UndeleteClusterDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUndeleteClusterDefault creates UndeleteClusterDefault with default headers values
+func NewUndeleteClusterDefault(code int) *UndeleteClusterDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &UndeleteClusterDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the undelete cluster default response
+func (o *UndeleteClusterDefault) WithStatusCode(code int) *UndeleteClusterDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the undelete cluster default response
+func (o *UndeleteClusterDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the undelete cluster default response
+func (o *UndeleteClusterDefault) WithPayload(payload *models.Error) *UndeleteClusterDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the undelete cluster default response
+func (o *UndeleteClusterDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UndeleteClusterDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -35,6 +35,10 @@ type UpdateClusterParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Only update the cluster if it still has the ETag returned by ShowCluster, fails with 412 otherwise
+	  In: header
+	*/
+	IfMatch *string
 	/*
 	  Required: true
 	  In: body
@@ -56,6 +60,10 @@ func (o *UpdateClusterParams) BindRequest(r *http.Request, route *middleware.Mat
 
 	o.HTTPRequest = r
 
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
 		var body models.Kluster
@@ -94,6 +102,23 @@ func (o *UpdateClusterParams) BindRequest(r *http.Request, route *middleware.Mat
 	return nil
 }
 
+// bindIfMatch binds and validates parameter IfMatch from header.
+func (o *UpdateClusterParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfMatch = &raw
+
+	return nil
+}
+
 // bindName binds and validates parameter Name from path.
 func (o *UpdateClusterParams) bindName(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
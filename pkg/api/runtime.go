@@ -1,17 +1,32 @@
 package api
 
 import (
+	"net/http"
+	"time"
+
 	"github.com/go-kit/log"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
 	kubernikus_client_kubernetes "github.com/sapcc/kubernikus/pkg/client/kubernetes"
 	"github.com/sapcc/kubernikus/pkg/generated/clientset"
 	kubernikus_informers_v1 "github.com/sapcc/kubernikus/pkg/generated/informers/externalversions/kubernikus/v1"
 	kubernikus_listers_v1 "github.com/sapcc/kubernikus/pkg/generated/listers/kubernikus/v1"
+	etcd_util "github.com/sapcc/kubernikus/pkg/util/etcd"
 	"github.com/sapcc/kubernikus/pkg/version"
 )
 
+// DefaultUserCertificateTTL is the default maximum lifetime of user client certificates
+const DefaultUserCertificateTTL = 24 * time.Hour
+
+// PolicyEnforcer checks a principal against a named policy rule
+type PolicyEnforcer interface {
+	Enforce(rule string, principal *models.Principal) bool
+}
+
 type Runtime struct {
 	Kubernikus           clientset.Interface
 	Kubernetes           kubernetes.Interface
@@ -21,6 +36,19 @@ type Runtime struct {
 	KlusterClientFactory kubernikus_client_kubernetes.SharedClientFactory
 	Informer             cache.SharedIndexInformer
 	Klusters             kubernikus_listers_v1.KlusterLister
+	// Policy is nil if authorization isn't policy based
+	Policy PolicyEnforcer
+	// UserCertificateTTL is the maximum lifetime of user client certificates
+	UserCertificateTTL time.Duration
+
+	// The OpenStack and etcd backup dependencies of the handlers, they are set
+	// when the API is configured and replaced by the dev setup and the tests
+	FetchOpenstackMetadata    func(*http.Request, *models.Principal) (*models.OpenstackMetadata, error)
+	FetchTerminationInventory func(*http.Request, *models.Principal, *v1.Kluster) ([]models.DebrisResource, error)
+	FetchClusterServers       func(*http.Request, *models.Principal, *v1.Kluster) ([]servers.Server, error)
+	SnapshotStore             func(kubernetes.Interface, *http.Request, *v1.Kluster) (etcd_util.SnapshotStore, error)
+	TakeFullSnapshot          func(kubernetes.Interface, *v1.Kluster, string) (models.EtcdSnapshotReference, error)
+	VerifyBackupTarget        func(*http.Request, *models.Principal, *models.BackupTarget) error
 }
 
 func NewRuntime(namespace string, kubernikusClient clientset.Interface, kubeClient kubernetes.Interface, logger log.Logger) *Runtime {
@@ -35,6 +63,7 @@ func NewRuntime(namespace string, kubernikusClient clientset.Interface, kubeClie
 		KlusterClientFactory: kubernikus_client_kubernetes.NewSharedClientFactory(kubeClient, informer, logger),
 		Informer:             informer,
 		Klusters:             kubernikus_listers_v1.NewKlusterLister(informer.GetIndexer()),
+		UserCertificateTTL:   DefaultUserCertificateTTL,
 	}
 
 }
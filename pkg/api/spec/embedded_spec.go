@@ -99,6 +99,12 @@ func init() {
             "description": "OK",
             "schema": {
               "$ref": "#/definitions/Kluster"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the cluster, pass it as If-Match to update only this version"
+              }
             }
           },
           "default": {
@@ -117,6 +123,12 @@ func init() {
             "schema": {
               "$ref": "#/definitions/Kluster"
             }
+          },
+          {
+            "type": "string",
+            "description": "Only update the cluster if it still has the ETag returned by ShowCluster, fails with 412 otherwise",
+            "name": "If-Match",
+            "in": "header"
           }
         ],
         "responses": {
@@ -134,7 +146,27 @@ func init() {
       "delete": {
         "summary": "Terminate the specified cluster",
         "operationId": "TerminateCluster",
+        "parameters": [
+          {
+            "type": "boolean",
+            "description": "Only list what would be deleted",
+            "name": "dryRun",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Scale the cluster to zero and delete it after this duration (e.g. 72h) unless it is undeleted",
+            "name": "gracePeriod",
+            "in": "query"
+          }
+        ],
         "responses": {
+          "200": {
+            "description": "Dry run",
+            "schema": {
+              "$ref": "#/definitions/TerminationPlan"
+            }
+          },
           "202": {
             "description": "OK"
           },
@@ -183,6 +215,15 @@ func init() {
       "get": {
         "summary": "Get user specific credentials to access the cluster",
         "operationId": "GetClusterCredentials",
+        "parameters": [
+          {
+            "minimum": 60,
+            "type": "integer",
+            "description": "Lifetime of the client certificate in seconds. Limited by the API, defaults to the maximum.",
+            "name": "ttl",
+            "in": "query"
+          }
+        ],
         "responses": {
           "200": {
             "description": "OK",
@@ -312,38 +353,70 @@ func init() {
         }
       ]
     },
-    "/api/v1/openstack/metadata": {
+    "/api/v1/clusters/{name}/nodes": {
       "get": {
-        "summary": "Grab bag of openstack metadata",
-        "operationId": "GetOpenstackMetadata",
+        "summary": "List the servers and Kubernetes nodes of the cluster side by side",
+        "operationId": "ListClusterNodes",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/OpenstackMetadata"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ClusterNode"
+              }
             }
           },
           "default": {
             "$ref": "#/responses/errorResponse"
           }
         }
-      }
+      },
+      "parameters": [
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
     },
-    "/api/v1/{account}/clusters/{name}/values": {
+    "/api/v1/clusters/{name}/undelete": {
+      "post": {
+        "summary": "Cancel the scheduled deletion of the specified cluster",
+        "operationId": "UndeleteCluster",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Kluster"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/api/v1/clusters/{name}/usercertificates": {
       "get": {
-        "summary": "Get values for cluster chart (admin-only)",
-        "operationId": "GetClusterValues",
+        "summary": "List the client certificates issued to users and the denied users",
+        "operationId": "ListUserCertificates",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "type": "object",
-              "properties": {
-                "values": {
-                  "description": "The values in yaml Format",
-                  "type": "string"
-                }
-              }
+              "$ref": "#/definitions/UserCertificateList"
             }
           },
           "default": {
@@ -358,133 +431,797 @@ func init() {
           "name": "name",
           "in": "path",
           "required": true
-        },
+        }
+      ]
+    },
+    "/api/v1/clusters/{name}/usercertificates/denied/{user}": {
+      "delete": {
+        "summary": "Remove a user from the deny list",
+        "operationId": "AllowUserCertificates",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/UserCertificateList"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
         {
           "uniqueItems": true,
           "type": "string",
-          "name": "account",
+          "name": "name",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "user",
           "in": "path",
           "required": true
         }
       ]
     },
-    "/auth/callback": {
-      "get": {
-        "security": [],
-        "summary": "callback for oauth result",
+    "/api/v1/clusters/{name}/usercertificates/revoke": {
+      "post": {
+        "summary": "Revoke all user certificates by replacing the users CA and optionally deny users new ones",
+        "operationId": "RevokeUserCertificates",
         "parameters": [
           {
-            "type": "string",
-            "name": "code",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "state",
-            "in": "query",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/UserCertificateRevocation"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "type": "object",
-              "properties": {
-                "idToken": {
-                  "description": "idToken",
-                  "type": "string"
-                },
-                "type": {
-                  "description": "Token type",
-                  "type": "string"
-                }
-              }
+              "$ref": "#/definitions/UserCertificateList"
             }
           },
           "default": {
             "$ref": "#/responses/errorResponse"
           }
         }
-      }
+      },
+      "parameters": [
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
     },
-    "/auth/login": {
+    "/api/v1/openstack/metadata": {
       "get": {
-        "security": [],
-        "summary": "login through oauth2 server",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "connector_id",
-            "in": "query"
-          }
-        ],
+        "summary": "Grab bag of openstack metadata",
+        "operationId": "GetOpenstackMetadata",
         "responses": {
-          "302": {
-            "description": "Redirect"
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/OpenstackMetadata"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
           }
         }
       }
     },
-    "/info": {
+    "/api/v1/{account}/clusters/{name}/backups": {
       "get": {
-        "security": [],
-        "summary": "Get info about Kubernikus",
-        "operationId": "Info",
+        "summary": "List the etcd snapshots available for restoring the cluster (admin-only)",
+        "operationId": "ListClusterBackups",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/Info"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/EtcdSnapshot"
+              }
             }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
           }
         }
-      }
-    }
-  },
-  "definitions": {
-    "ApiVersions": {
-      "required": [
-        "versions"
-      ],
-      "properties": {
-        "versions": {
-          "description": "versions are the api versions that are available.",
-          "type": "array",
-          "items": {
-            "type": "string"
+      },
+      "post": {
+        "summary": "Take a full etcd snapshot of the cluster and wait until it is stored (admin-only)",
+        "operationId": "CreateClusterBackup",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/EtcdSnapshotReference"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
           }
         }
-      }
-    },
-    "BootstrapConfig": {
-      "type": "object",
-      "properties": {
-        "config": {
-          "type": "string"
-        },
-        "kubeconfig": {
-          "type": "string"
-        },
-        "kubeletClientsCA": {
-          "type": "string"
+      },
+      "parameters": [
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
         },
-        "kubeletClientsCAFile": {
-          "type": "string"
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "account",
+          "in": "path",
+          "required": true
         }
-      }
+      ]
     },
-    "Credentials": {
-      "type": "object",
-      "properties": {
-        "kubeconfig": {
-          "type": "string"
+    "/api/v1/{account}/clusters/{name}/carotation": {
+      "post": {
+        "summary": "Start a CA rotation or advance a manual one to its next phase (admin-only)",
+        "operationId": "RotateClusterCA",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/CARotationRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/CARotationStatus"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
         }
-      }
-    },
-    "Event": {
-      "type": "object",
+      },
+      "parameters": [
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        },
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "account",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/api/v1/{account}/clusters/{name}/certificates": {
+      "get": {
+        "summary": "List the certificates and CAs of the cluster with their expiry (admin-only)",
+        "operationId": "GetClusterCertificates",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/CertificateInfo"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        },
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "account",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/api/v1/{account}/clusters/{name}/restore": {
+      "post": {
+        "summary": "Restore the etcd of the cluster to a snapshot (admin-only)",
+        "operationId": "RestoreClusterBackup",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/EtcdRestoreRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/EtcdRestoreStatus"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        },
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "account",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/api/v1/{account}/clusters/{name}/terminationreport": {
+      "get": {
+        "summary": "Get OpenStack resources left behind by a terminated cluster (admin-only)",
+        "operationId": "GetClusterTerminationReport",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/TerminationReport"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        },
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "account",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/api/v1/{account}/clusters/{name}/values": {
+      "get": {
+        "summary": "Get values for cluster chart (admin-only)",
+        "operationId": "GetClusterValues",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "values": {
+                  "description": "The values in yaml Format",
+                  "type": "string"
+                }
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        },
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "account",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/auth/callback": {
+      "get": {
+        "security": [],
+        "summary": "callback for oauth result",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "code",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "state",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "idToken": {
+                  "description": "idToken",
+                  "type": "string"
+                },
+                "type": {
+                  "description": "Token type",
+                  "type": "string"
+                }
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      }
+    },
+    "/auth/login": {
+      "get": {
+        "security": [],
+        "summary": "login through oauth2 server",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "connector_id",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "302": {
+            "description": "Redirect"
+          }
+        }
+      }
+    },
+    "/info": {
+      "get": {
+        "security": [],
+        "summary": "Get info about Kubernikus",
+        "operationId": "Info",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Info"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "ApiVersions": {
+      "required": [
+        "versions"
+      ],
+      "properties": {
+        "versions": {
+          "description": "versions are the api versions that are available.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "BackupRetention": {
+      "type": "object",
+      "properties": {
+        "maxBackups": {
+          "type": "integer",
+          "maximum": 1000,
+          "minimum": 1
+        },
+        "policy": {
+          "description": "Exponential keeps hourly, daily and weekly snapshots, LimitBased the latest maxBackups full snapshots. Defaults to Exponential.",
+          "type": "string",
+          "enum": [
+            "Exponential",
+            "LimitBased"
+          ]
+        }
+      },
+      "x-nullable": true
+    },
+    "BackupTarget": {
+      "description": "Where etcd backups are stored when backup is on. Defaults to Swift in the project and region of the cluster.",
+      "type": "object",
+      "required": [
+        "type"
+      ],
+      "properties": {
+        "retention": {
+          "$ref": "#/definitions/BackupRetention"
+        },
+        "s3": {
+          "$ref": "#/definitions/BackupTargetS3"
+        },
+        "swift": {
+          "$ref": "#/definitions/BackupTargetSwift"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "swift",
+            "s3"
+          ]
+        }
+      },
+      "x-nullable": true
+    },
+    "BackupTargetS3": {
+      "type": "object",
+      "required": [
+        "endpoint",
+        "bucket"
+      ],
+      "properties": {
+        "accessKeyID": {
+          "description": "Only accepted on creation, the credentials are stored in the cluster secret and never returned.",
+          "type": "string"
+        },
+        "bucket": {
+          "type": "string"
+        },
+        "endpoint": {
+          "description": "URL of the S3-compatible endpoint",
+          "type": "string"
+        },
+        "forcePathStyle": {
+          "description": "Address the bucket as part of the path instead of the host name",
+          "type": "boolean"
+        },
+        "region": {
+          "type": "string"
+        },
+        "secretAccessKey": {
+          "description": "Only accepted on creation, the credentials are stored in the cluster secret and never returned.",
+          "type": "string"
+        }
+      },
+      "x-nullable": true
+    },
+    "BackupTargetSwift": {
+      "type": "object",
+      "properties": {
+        "container": {
+          "description": "Name of the container. Defaults to kubernikus-etcd-backup-\u003cname\u003e-\u003cuid\u003e. Can not be updated.",
+          "type": "string",
+          "pattern": "^[^/]{1,256}$"
+        },
+        "projectID": {
+          "description": "Project of the container. Defaults to the project of the cluster. Can not be updated.",
+          "type": "string"
+        },
+        "region": {
+          "description": "Region of the container. Defaults to the region of the cluster.",
+          "type": "string"
+        }
+      },
+      "x-nullable": true
+    },
+    "BootstrapConfig": {
+      "type": "object",
+      "properties": {
+        "config": {
+          "type": "string"
+        },
+        "kubeconfig": {
+          "type": "string"
+        },
+        "kubeletClientsCA": {
+          "type": "string"
+        },
+        "kubeletClientsCAFile": {
+          "type": "string"
+        }
+      }
+    },
+    "CARotationPhase": {
+      "type": "string",
+      "enum": [
+        "TrustNew",
+        "Reissue",
+        "RemoveOld",
+        "Completed"
+      ]
+    },
+    "CARotationRequest": {
+      "type": "object",
+      "properties": {
+        "authorities": {
+          "description": "Names of the CAs to rotate (e.g. tls, etcd-clients). Defaults to all rotatable CAs.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "manual": {
+          "description": "Wait for the API to advance the rotation before the Reissue and RemoveOld phases",
+          "type": "boolean"
+        }
+      }
+    },
+    "CARotationStatus": {
+      "type": "object",
+      "properties": {
+        "authorities": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "completedAt": {
+          "type": "string"
+        },
+        "manual": {
+          "type": "boolean"
+        },
+        "phase": {
+          "$ref": "#/definitions/CARotationPhase"
+        },
+        "phaseStartedAt": {
+          "type": "string"
+        },
+        "startedAt": {
+          "type": "string"
+        },
+        "waiting": {
+          "description": "The current phase is rolled out, the rotation waits to be advanced via the API",
+          "type": "boolean"
+        }
+      }
+    },
+    "CertificateInfo": {
+      "type": "object",
+      "properties": {
+        "ca": {
+          "type": "boolean"
+        },
+        "commonName": {
+          "type": "string"
+        },
+        "issuer": {
+          "type": "string"
+        },
+        "name": {
+          "description": "name of the certificate in the cluster secret",
+          "type": "string"
+        },
+        "notAfter": {
+          "type": "string"
+        },
+        "notBefore": {
+          "type": "string"
+        }
+      },
+      "x-nullable": false
+    },
+    "ClusterNode": {
+      "type": "object",
+      "properties": {
+        "annotations": {
+          "description": "The servicing annotations of the node",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "kubeletVersion": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "osImage": {
+          "type": "string"
+        },
+        "pool": {
+          "type": "string"
+        },
+        "ready": {
+          "description": "Status of the Ready condition, empty if the server didn't register as node",
+          "type": "string"
+        },
+        "routeBroken": {
+          "type": "boolean"
+        },
+        "serverID": {
+          "description": "ID of the OpenStack server, empty if there is no server for the node",
+          "type": "string",
+          "x-go-name": "ServerID"
+        },
+        "serverStatus": {
+          "type": "string"
+        }
+      },
+      "x-nullable": false
+    },
+    "Credentials": {
+      "type": "object",
+      "properties": {
+        "kubeconfig": {
+          "type": "string"
+        }
+      }
+    },
+    "DebrisResource": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "server",
+            "volume",
+            "snapshot",
+            "loadbalancer",
+            "floatingip",
+            "port",
+            "route",
+            "servergroup",
+            "container",
+            "serviceuser",
+            "helmrelease"
+          ]
+        },
+        "name": {
+          "type": "string"
+        }
+      },
+      "x-nullable": false
+    },
+    "EtcdRestorePhase": {
+      "type": "string",
+      "enum": [
+        "ScalingDown",
+        "Restoring",
+        "ScalingUp",
+        "Completed",
+        "Failed"
+      ]
+    },
+    "EtcdRestoreRequest": {
+      "type": "object",
+      "required": [
+        "snapshot"
+      ],
+      "properties": {
+        "snapshot": {
+          "description": "Name of the snapshot to restore, deltas are replayed up to it",
+          "type": "string"
+        }
+      }
+    },
+    "EtcdRestoreStatus": {
+      "type": "object",
+      "properties": {
+        "completedAt": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "phase": {
+          "$ref": "#/definitions/EtcdRestorePhase"
+        },
+        "phaseStartedAt": {
+          "type": "string"
+        },
+        "snapshot": {
+          "type": "string"
+        },
+        "startedAt": {
+          "type": "string"
+        }
+      }
+    },
+    "EtcdSnapshot": {
+      "type": "object",
+      "properties": {
+        "createdAt": {
+          "type": "string"
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "full",
+            "delta"
+          ]
+        },
+        "lastRevision": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "description": "Name of the snapshot object in the backup container",
+          "type": "string"
+        },
+        "size": {
+          "description": "Size in bytes",
+          "type": "integer",
+          "format": "int64"
+        },
+        "startRevision": {
+          "type": "integer",
+          "format": "int64"
+        }
+      },
+      "x-nullable": false
+    },
+    "EtcdSnapshotReference": {
+      "type": "object",
+      "properties": {
+        "createdAt": {
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the snapshot object in the backup container",
+          "type": "string"
+        },
+        "reason": {
+          "description": "Why the snapshot was taken",
+          "type": "string"
+        },
+        "revision": {
+          "type": "integer",
+          "format": "int64"
+        }
+      },
+      "x-nullable": false
+    },
+    "Event": {
+      "type": "object",
       "properties": {
         "count": {
           "description": "The number of times this event has occurred.",
@@ -516,6 +1253,66 @@ func init() {
         }
       }
     },
+    "HammertimeActivation": {
+      "type": "object",
+      "properties": {
+        "end": {
+          "description": "The time the cluster recovered",
+          "type": "string"
+        },
+        "mode": {
+          "type": "string"
+        },
+        "start": {
+          "description": "The time hammertime intervened",
+          "type": "string"
+        }
+      },
+      "x-nullable": false
+    },
+    "HammertimeSpec": {
+      "description": "Tunables for hammertime which intervenes when all nodes of the cluster stop sending heartbeats.",
+      "type": "object",
+      "properties": {
+        "gracePeriod": {
+          "description": "Seconds hammertime only warns before pausing node eviction in graded mode. Defaults to the timeout.",
+          "type": "integer"
+        },
+        "minNodes": {
+          "description": "Minimum number of nodes required for hammertime to be active.",
+          "type": "integer",
+          "minimum": 2
+        },
+        "mode": {
+          "description": "scale stops the controller-manager. graded only warns during the grace period and then pauses node eviction of the controller-manager.",
+          "type": "string",
+          "enum": [
+            "scale",
+            "graded"
+          ]
+        },
+        "timeout": {
+          "description": "Seconds without any node heartbeat before hammertime intervenes.",
+          "type": "integer",
+          "minimum": 10
+        }
+      }
+    },
+    "HammertimeStatus": {
+      "type": "object",
+      "properties": {
+        "activations": {
+          "description": "The most recent activations of hammertime",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/HammertimeActivation"
+          }
+        },
+        "active": {
+          "type": "boolean"
+        }
+      }
+    },
     "Info": {
       "properties": {
         "availableClusterVersions": {
@@ -604,6 +1401,7 @@ func init() {
         "Creating",
         "Running",
         "Upgrading",
+        "Restoring",
         "Terminating"
       ]
     },
@@ -647,6 +1445,9 @@ func init() {
           ],
           "x-nullable": false
         },
+        "backupTarget": {
+          "$ref": "#/definitions/BackupTarget"
+        },
         "clusterCIDR": {
           "description": "CIDR Range for Pods in the cluster. Can not be updated.",
           "type": "string",
@@ -677,6 +1478,18 @@ func init() {
           "default": "cluster.local",
           "x-nullable": false
         },
+        "hammertime": {
+          "$ref": "#/definitions/HammertimeSpec"
+        },
+        "keyAlgorithm": {
+          "description": "Algorithm of newly created private keys. Existing keys are kept, certificates switch when they are\nrenewed and CAs when they are rotated. Defaults to rsa.\n",
+          "type": "string",
+          "enum": [
+            "rsa",
+            "ecdsa-p256",
+            "ed25519"
+          ]
+        },
         "name": {
           "type": "string"
         },
@@ -713,6 +1526,11 @@ func init() {
           "type": "string",
           "maxLength": 10000
         },
+        "terminationProtection": {
+          "description": "Prevents the cluster from being terminated. Can only be changed by kubernetes admins.",
+          "type": "boolean",
+          "x-nullable": true
+        },
         "version": {
           "description": "Kubernetes version",
           "type": "string",
@@ -730,6 +1548,9 @@ func init() {
         "apiserverVersion": {
           "type": "string"
         },
+        "caRotation": {
+          "$ref": "#/definitions/CARotationStatus"
+        },
         "chartName": {
           "type": "string"
         },
@@ -739,6 +1560,19 @@ func init() {
         "dashboard": {
           "type": "string"
         },
+        "etcdRestore": {
+          "$ref": "#/definitions/EtcdRestoreStatus"
+        },
+        "etcdSnapshots": {
+          "description": "Full etcd snapshots taken on demand, e.g. before upgrades. The latest is last.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/EtcdSnapshotReference"
+          }
+        },
+        "hammertime": {
+          "$ref": "#/definitions/HammertimeStatus"
+        },
         "migrationsPending": {
           "type": "boolean"
         },
@@ -803,7 +1637,7 @@ func init() {
           "x-nullable": false
         },
         "labels": {
-          "description": "The specified labels will be added to members of this pool once during initial registration of the node",
+          "description": "The specified labels are applied to members of this pool and kept in sync when the pool is updated",
           "type": "array",
           "items": {
             "type": "string",
@@ -823,7 +1657,7 @@ func init() {
           "x-nullable": false
         },
         "taints": {
-          "description": "The specified taints will be added to members of this pool once during initial registration of the node",
+          "description": "The specified taints are applied to members of this pool and kept in sync when the pool is updated",
           "type": "array",
           "items": {
             "type": "string",
@@ -1068,16 +1902,129 @@ func init() {
             "type": "string"
           }
         },
-        "id": {
-          "description": "userid",
+        "id": {
+          "description": "userid",
+          "type": "string"
+        },
+        "name": {
+          "description": "username",
+          "type": "string"
+        },
+        "roles": {
+          "description": "list of roles the user has in the given scope",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "TerminationPlan": {
+      "type": "object",
+      "properties": {
+        "delete": {
+          "description": "Resources deleted when terminating the cluster",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DebrisResource"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "retain": {
+          "description": "Resources kept after terminating the cluster",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DebrisResource"
+          }
+        },
+        "scheduledDeletion": {
+          "description": "The time at which the cluster is going to be deleted, if scheduled",
+          "type": "string"
+        },
+        "warnings": {
+          "description": "Problems encountered while listing resources, the plan might be incomplete",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "TerminationReport": {
+      "type": "object",
+      "properties": {
+        "account": {
+          "description": "account id",
+          "type": "string"
+        },
+        "createdAt": {
+          "description": "The time at which the inventory was taken",
+          "type": "string"
+        },
+        "name": {
+          "description": "name of the terminated cluster",
+          "type": "string"
+        },
+        "reason": {
+          "description": "why the cluster self-destructed",
+          "type": "string"
+        },
+        "resources": {
+          "description": "OpenStack resources attributable to the cluster that still existed",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DebrisResource"
+          }
+        }
+      }
+    },
+    "UserCertificate": {
+      "type": "object",
+      "properties": {
+        "expiresAt": {
+          "type": "string"
+        },
+        "issuedAt": {
+          "type": "string"
+        },
+        "revoked": {
+          "type": "boolean"
+        },
+        "serial": {
           "type": "string"
         },
-        "name": {
-          "description": "username",
+        "user": {
           "type": "string"
+        }
+      },
+      "x-nullable": false
+    },
+    "UserCertificateList": {
+      "type": "object",
+      "properties": {
+        "certificates": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/UserCertificate"
+          }
         },
-        "roles": {
-          "description": "list of roles the user has in the given scope",
+        "deniedUsers": {
+          "description": "Users which can't get new certificates",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "UserCertificateRevocation": {
+      "description": "Revocation replaces the CA signing user certificates, all certificates issued before become invalid.\nSingle certificates can't be revoked.\n",
+      "type": "object",
+      "properties": {
+        "deny": {
+          "description": "Users to add to the deny list",
           "type": "array",
           "items": {
             "type": "string"
@@ -1145,48 +2092,299 @@ func init() {
       "dex": [
         "user"
       ]
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "title": "Kubernikus",
-    "version": "1.0.0"
-  },
-  "paths": {
-    "/api": {
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "title": "Kubernikus",
+    "version": "1.0.0"
+  },
+  "paths": {
+    "/api": {
+      "get": {
+        "security": [],
+        "summary": "List available api versions",
+        "operationId": "ListAPIVersions",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/ApiVersions"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/clusters": {
+      "get": {
+        "summary": "List available clusters",
+        "operationId": "ListClusters",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Kluster"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "summary": "Create a cluster",
+        "operationId": "CreateCluster",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Kluster"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Kluster"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/clusters/{name}": {
+      "get": {
+        "summary": "Show the specified cluster",
+        "operationId": "ShowCluster",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Kluster"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the cluster, pass it as If-Match to update only this version"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "summary": "Update the specified cluster",
+        "operationId": "UpdateCluster",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Kluster"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Only update the cluster if it still has the ETag returned by ShowCluster, fails with 412 otherwise",
+            "name": "If-Match",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Kluster"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "summary": "Terminate the specified cluster",
+        "operationId": "TerminateCluster",
+        "parameters": [
+          {
+            "type": "boolean",
+            "description": "Only list what would be deleted",
+            "name": "dryRun",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Scale the cluster to zero and delete it after this duration (e.g. 72h) unless it is undeleted",
+            "name": "gracePeriod",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Dry run",
+            "schema": {
+              "$ref": "#/definitions/TerminationPlan"
+            }
+          },
+          "202": {
+            "description": "OK"
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/api/v1/clusters/{name}/bootstrap": {
+      "get": {
+        "summary": "Get bootstrap config to onboard a node",
+        "operationId": "GetBootstrapConfig",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/BootstrapConfig"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/api/v1/clusters/{name}/credentials": {
+      "get": {
+        "summary": "Get user specific credentials to access the cluster",
+        "operationId": "GetClusterCredentials",
+        "parameters": [
+          {
+            "minimum": 60,
+            "type": "integer",
+            "description": "Lifetime of the client certificate in seconds. Limited by the API, defaults to the maximum.",
+            "name": "ttl",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Credentials"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/api/v1/clusters/{name}/credentials/oidc": {
       "get": {
-        "security": [],
-        "summary": "List available api versions",
-        "operationId": "ListAPIVersions",
+        "summary": "Get user specific credentials to access the cluster with OIDC",
+        "operationId": "GetClusterCredentialsOIDC",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/ApiVersions"
+              "$ref": "#/definitions/Credentials"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
         }
-      }
+      },
+      "parameters": [
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
     },
-    "/api/v1/clusters": {
+    "/api/v1/clusters/{name}/events": {
       "get": {
-        "summary": "List available clusters",
-        "operationId": "ListClusters",
+        "summary": "Get recent events about the cluster",
+        "operationId": "GetClusterEvents",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/Kluster"
+                "$ref": "#/definitions/Event"
               }
             }
           },
@@ -1198,24 +2396,25 @@ func init() {
           }
         }
       },
-      "post": {
-        "summary": "Create a cluster",
-        "operationId": "CreateCluster",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Kluster"
-            }
-          }
-        ],
+      "parameters": [
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/api/v1/clusters/{name}/info": {
+      "get": {
+        "summary": "Get user specific info about the cluster",
+        "operationId": "GetClusterInfo",
         "responses": {
-          "201": {
+          "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/Kluster"
+              "$ref": "#/definitions/KlusterInfo"
             }
           },
           "default": {
@@ -1225,17 +2424,26 @@ func init() {
             }
           }
         }
-      }
+      },
+      "parameters": [
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
     },
-    "/api/v1/clusters/{name}": {
+    "/api/v1/clusters/{name}/kubeadmsecret": {
       "get": {
-        "summary": "Show the specified cluster",
-        "operationId": "ShowCluster",
+        "summary": "Get CA secret for kubeadm",
+        "operationId": "GetClusterKubeadmSecret",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/Kluster"
+              "$ref": "#/definitions/KubeadmSecret"
             }
           },
           "default": {
@@ -1246,19 +2454,52 @@ func init() {
           }
         }
       },
-      "put": {
-        "summary": "Update the specified cluster",
-        "operationId": "UpdateCluster",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
+      "parameters": [
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/api/v1/clusters/{name}/nodes": {
+      "get": {
+        "summary": "List the servers and Kubernetes nodes of the cluster side by side",
+        "operationId": "ListClusterNodes",
+        "responses": {
+          "200": {
+            "description": "OK",
             "schema": {
-              "$ref": "#/definitions/Kluster"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ClusterNode"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
-        ],
+        }
+      },
+      "parameters": [
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/api/v1/clusters/{name}/undelete": {
+      "post": {
+        "summary": "Cancel the scheduled deletion of the specified cluster",
+        "operationId": "UndeleteCluster",
         "responses": {
           "200": {
             "description": "OK",
@@ -1274,12 +2515,26 @@ func init() {
           }
         }
       },
-      "delete": {
-        "summary": "Terminate the specified cluster",
-        "operationId": "TerminateCluster",
+      "parameters": [
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/api/v1/clusters/{name}/usercertificates": {
+      "get": {
+        "summary": "List the client certificates issued to users and the denied users",
+        "operationId": "ListUserCertificates",
         "responses": {
-          "202": {
-            "description": "OK"
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/UserCertificateList"
+            }
           },
           "default": {
             "description": "Error",
@@ -1299,15 +2554,15 @@ func init() {
         }
       ]
     },
-    "/api/v1/clusters/{name}/bootstrap": {
-      "get": {
-        "summary": "Get bootstrap config to onboard a node",
-        "operationId": "GetBootstrapConfig",
+    "/api/v1/clusters/{name}/usercertificates/denied/{user}": {
+      "delete": {
+        "summary": "Remove a user from the deny list",
+        "operationId": "AllowUserCertificates",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/BootstrapConfig"
+              "$ref": "#/definitions/UserCertificateList"
             }
           },
           "default": {
@@ -1325,18 +2580,33 @@ func init() {
           "name": "name",
           "in": "path",
           "required": true
+        },
+        {
+          "type": "string",
+          "name": "user",
+          "in": "path",
+          "required": true
         }
       ]
     },
-    "/api/v1/clusters/{name}/credentials": {
-      "get": {
-        "summary": "Get user specific credentials to access the cluster",
-        "operationId": "GetClusterCredentials",
+    "/api/v1/clusters/{name}/usercertificates/revoke": {
+      "post": {
+        "summary": "Revoke all user certificates by replacing the users CA and optionally deny users new ones",
+        "operationId": "RevokeUserCertificates",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/UserCertificateRevocation"
+            }
+          }
+        ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/Credentials"
+              "$ref": "#/definitions/UserCertificateList"
             }
           },
           "default": {
@@ -1357,15 +2627,56 @@ func init() {
         }
       ]
     },
-    "/api/v1/clusters/{name}/credentials/oidc": {
+    "/api/v1/openstack/metadata": {
       "get": {
-        "summary": "Get user specific credentials to access the cluster with OIDC",
-        "operationId": "GetClusterCredentialsOIDC",
+        "summary": "Grab bag of openstack metadata",
+        "operationId": "GetOpenstackMetadata",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/Credentials"
+              "$ref": "#/definitions/OpenstackMetadata"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/{account}/clusters/{name}/backups": {
+      "get": {
+        "summary": "List the etcd snapshots available for restoring the cluster (admin-only)",
+        "operationId": "ListClusterBackups",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/EtcdSnapshot"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "summary": "Take a full etcd snapshot of the cluster and wait until it is stored (admin-only)",
+        "operationId": "CreateClusterBackup",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/EtcdSnapshotReference"
             }
           },
           "default": {
@@ -1383,21 +2694,34 @@ func init() {
           "name": "name",
           "in": "path",
           "required": true
+        },
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "account",
+          "in": "path",
+          "required": true
         }
       ]
     },
-    "/api/v1/clusters/{name}/events": {
-      "get": {
-        "summary": "Get recent events about the cluster",
-        "operationId": "GetClusterEvents",
+    "/api/v1/{account}/clusters/{name}/carotation": {
+      "post": {
+        "summary": "Start a CA rotation or advance a manual one to its next phase (admin-only)",
+        "operationId": "RotateClusterCA",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/CARotationRequest"
+            }
+          }
+        ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Event"
-              }
+              "$ref": "#/definitions/CARotationStatus"
             }
           },
           "default": {
@@ -1415,18 +2739,28 @@ func init() {
           "name": "name",
           "in": "path",
           "required": true
+        },
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "account",
+          "in": "path",
+          "required": true
         }
       ]
     },
-    "/api/v1/clusters/{name}/info": {
+    "/api/v1/{account}/clusters/{name}/certificates": {
       "get": {
-        "summary": "Get user specific info about the cluster",
-        "operationId": "GetClusterInfo",
+        "summary": "List the certificates and CAs of the cluster with their expiry (admin-only)",
+        "operationId": "GetClusterCertificates",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/KlusterInfo"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/CertificateInfo"
+              }
             }
           },
           "default": {
@@ -1444,18 +2778,35 @@ func init() {
           "name": "name",
           "in": "path",
           "required": true
+        },
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "account",
+          "in": "path",
+          "required": true
         }
       ]
     },
-    "/api/v1/clusters/{name}/kubeadmsecret": {
-      "get": {
-        "summary": "Get CA secret for kubeadm",
-        "operationId": "GetClusterKubeadmSecret",
+    "/api/v1/{account}/clusters/{name}/restore": {
+      "post": {
+        "summary": "Restore the etcd of the cluster to a snapshot (admin-only)",
+        "operationId": "RestoreClusterBackup",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/EtcdRestoreRequest"
+            }
+          }
+        ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/KubeadmSecret"
+              "$ref": "#/definitions/EtcdRestoreStatus"
             }
           },
           "default": {
@@ -1473,18 +2824,25 @@ func init() {
           "name": "name",
           "in": "path",
           "required": true
+        },
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "account",
+          "in": "path",
+          "required": true
         }
       ]
     },
-    "/api/v1/openstack/metadata": {
+    "/api/v1/{account}/clusters/{name}/terminationreport": {
       "get": {
-        "summary": "Grab bag of openstack metadata",
-        "operationId": "GetOpenstackMetadata",
+        "summary": "Get OpenStack resources left behind by a terminated cluster (admin-only)",
+        "operationId": "GetClusterTerminationReport",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/OpenstackMetadata"
+              "$ref": "#/definitions/TerminationReport"
             }
           },
           "default": {
@@ -1494,7 +2852,23 @@ func init() {
             }
           }
         }
-      }
+      },
+      "parameters": [
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        },
+        {
+          "uniqueItems": true,
+          "type": "string",
+          "name": "account",
+          "in": "path",
+          "required": true
+        }
+      ]
     },
     "/api/v1/{account}/clusters/{name}/values": {
       "get": {
@@ -1564,81 +2938,424 @@ func init() {
             }
           }
         }
-      }
+      }
+    },
+    "/auth/login": {
+      "get": {
+        "security": [],
+        "summary": "login through oauth2 server",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "connector_id",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "302": {
+            "description": "Redirect"
+          }
+        }
+      }
+    },
+    "/info": {
+      "get": {
+        "security": [],
+        "summary": "Get info about Kubernikus",
+        "operationId": "Info",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Info"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "ApiVersions": {
+      "required": [
+        "versions"
+      ],
+      "properties": {
+        "versions": {
+          "description": "versions are the api versions that are available.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "BackupRetention": {
+      "type": "object",
+      "properties": {
+        "maxBackups": {
+          "type": "integer",
+          "maximum": 1000,
+          "minimum": 1
+        },
+        "policy": {
+          "description": "Exponential keeps hourly, daily and weekly snapshots, LimitBased the latest maxBackups full snapshots. Defaults to Exponential.",
+          "type": "string",
+          "enum": [
+            "Exponential",
+            "LimitBased"
+          ]
+        }
+      },
+      "x-nullable": true
+    },
+    "BackupTarget": {
+      "description": "Where etcd backups are stored when backup is on. Defaults to Swift in the project and region of the cluster.",
+      "type": "object",
+      "required": [
+        "type"
+      ],
+      "properties": {
+        "retention": {
+          "$ref": "#/definitions/BackupRetention"
+        },
+        "s3": {
+          "$ref": "#/definitions/BackupTargetS3"
+        },
+        "swift": {
+          "$ref": "#/definitions/BackupTargetSwift"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "swift",
+            "s3"
+          ]
+        }
+      },
+      "x-nullable": true
+    },
+    "BackupTargetS3": {
+      "type": "object",
+      "required": [
+        "endpoint",
+        "bucket"
+      ],
+      "properties": {
+        "accessKeyID": {
+          "description": "Only accepted on creation, the credentials are stored in the cluster secret and never returned.",
+          "type": "string"
+        },
+        "bucket": {
+          "type": "string"
+        },
+        "endpoint": {
+          "description": "URL of the S3-compatible endpoint",
+          "type": "string"
+        },
+        "forcePathStyle": {
+          "description": "Address the bucket as part of the path instead of the host name",
+          "type": "boolean"
+        },
+        "region": {
+          "type": "string"
+        },
+        "secretAccessKey": {
+          "description": "Only accepted on creation, the credentials are stored in the cluster secret and never returned.",
+          "type": "string"
+        }
+      },
+      "x-nullable": true
+    },
+    "BackupTargetSwift": {
+      "type": "object",
+      "properties": {
+        "container": {
+          "description": "Name of the container. Defaults to kubernikus-etcd-backup-\u003cname\u003e-\u003cuid\u003e. Can not be updated.",
+          "type": "string",
+          "pattern": "^[^/]{1,256}$"
+        },
+        "projectID": {
+          "description": "Project of the container. Defaults to the project of the cluster. Can not be updated.",
+          "type": "string"
+        },
+        "region": {
+          "description": "Region of the container. Defaults to the region of the cluster.",
+          "type": "string"
+        }
+      },
+      "x-nullable": true
+    },
+    "BootstrapConfig": {
+      "type": "object",
+      "properties": {
+        "config": {
+          "type": "string"
+        },
+        "kubeconfig": {
+          "type": "string"
+        },
+        "kubeletClientsCA": {
+          "type": "string"
+        },
+        "kubeletClientsCAFile": {
+          "type": "string"
+        }
+      }
+    },
+    "CARotationPhase": {
+      "type": "string",
+      "enum": [
+        "TrustNew",
+        "Reissue",
+        "RemoveOld",
+        "Completed"
+      ]
+    },
+    "CARotationRequest": {
+      "type": "object",
+      "properties": {
+        "authorities": {
+          "description": "Names of the CAs to rotate (e.g. tls, etcd-clients). Defaults to all rotatable CAs.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "manual": {
+          "description": "Wait for the API to advance the rotation before the Reissue and RemoveOld phases",
+          "type": "boolean"
+        }
+      }
+    },
+    "CARotationStatus": {
+      "type": "object",
+      "properties": {
+        "authorities": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "completedAt": {
+          "type": "string"
+        },
+        "manual": {
+          "type": "boolean"
+        },
+        "phase": {
+          "$ref": "#/definitions/CARotationPhase"
+        },
+        "phaseStartedAt": {
+          "type": "string"
+        },
+        "startedAt": {
+          "type": "string"
+        },
+        "waiting": {
+          "description": "The current phase is rolled out, the rotation waits to be advanced via the API",
+          "type": "boolean"
+        }
+      }
+    },
+    "CertificateInfo": {
+      "type": "object",
+      "properties": {
+        "ca": {
+          "type": "boolean"
+        },
+        "commonName": {
+          "type": "string"
+        },
+        "issuer": {
+          "type": "string"
+        },
+        "name": {
+          "description": "name of the certificate in the cluster secret",
+          "type": "string"
+        },
+        "notAfter": {
+          "type": "string"
+        },
+        "notBefore": {
+          "type": "string"
+        }
+      },
+      "x-nullable": false
+    },
+    "ClusterNode": {
+      "type": "object",
+      "properties": {
+        "annotations": {
+          "description": "The servicing annotations of the node",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "kubeletVersion": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "osImage": {
+          "type": "string"
+        },
+        "pool": {
+          "type": "string"
+        },
+        "ready": {
+          "description": "Status of the Ready condition, empty if the server didn't register as node",
+          "type": "string"
+        },
+        "routeBroken": {
+          "type": "boolean"
+        },
+        "serverID": {
+          "description": "ID of the OpenStack server, empty if there is no server for the node",
+          "type": "string",
+          "x-go-name": "ServerID"
+        },
+        "serverStatus": {
+          "type": "string"
+        }
+      },
+      "x-nullable": false
     },
-    "/auth/login": {
-      "get": {
-        "security": [],
-        "summary": "login through oauth2 server",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "connector_id",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "302": {
-            "description": "Redirect"
-          }
+    "Credentials": {
+      "type": "object",
+      "properties": {
+        "kubeconfig": {
+          "type": "string"
         }
       }
     },
-    "/info": {
-      "get": {
-        "security": [],
-        "summary": "Get info about Kubernikus",
-        "operationId": "Info",
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/Info"
-            }
-          }
+    "DebrisResource": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "server",
+            "volume",
+            "snapshot",
+            "loadbalancer",
+            "floatingip",
+            "port",
+            "route",
+            "servergroup",
+            "container",
+            "serviceuser",
+            "helmrelease"
+          ]
+        },
+        "name": {
+          "type": "string"
         }
-      }
-    }
-  },
-  "definitions": {
-    "ApiVersions": {
+      },
+      "x-nullable": false
+    },
+    "EtcdRestorePhase": {
+      "type": "string",
+      "enum": [
+        "ScalingDown",
+        "Restoring",
+        "ScalingUp",
+        "Completed",
+        "Failed"
+      ]
+    },
+    "EtcdRestoreRequest": {
+      "type": "object",
       "required": [
-        "versions"
+        "snapshot"
       ],
       "properties": {
-        "versions": {
-          "description": "versions are the api versions that are available.",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "snapshot": {
+          "description": "Name of the snapshot to restore, deltas are replayed up to it",
+          "type": "string"
         }
       }
     },
-    "BootstrapConfig": {
+    "EtcdRestoreStatus": {
       "type": "object",
       "properties": {
-        "config": {
+        "completedAt": {
           "type": "string"
         },
-        "kubeconfig": {
+        "message": {
           "type": "string"
         },
-        "kubeletClientsCA": {
+        "phase": {
+          "$ref": "#/definitions/EtcdRestorePhase"
+        },
+        "phaseStartedAt": {
           "type": "string"
         },
-        "kubeletClientsCAFile": {
+        "snapshot": {
+          "type": "string"
+        },
+        "startedAt": {
           "type": "string"
         }
       }
     },
-    "Credentials": {
+    "EtcdSnapshot": {
       "type": "object",
       "properties": {
-        "kubeconfig": {
+        "createdAt": {
+          "type": "string"
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "full",
+            "delta"
+          ]
+        },
+        "lastRevision": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "description": "Name of the snapshot object in the backup container",
           "type": "string"
+        },
+        "size": {
+          "description": "Size in bytes",
+          "type": "integer",
+          "format": "int64"
+        },
+        "startRevision": {
+          "type": "integer",
+          "format": "int64"
         }
-      }
+      },
+      "x-nullable": false
+    },
+    "EtcdSnapshotReference": {
+      "type": "object",
+      "properties": {
+        "createdAt": {
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the snapshot object in the backup container",
+          "type": "string"
+        },
+        "reason": {
+          "description": "Why the snapshot was taken",
+          "type": "string"
+        },
+        "revision": {
+          "type": "integer",
+          "format": "int64"
+        }
+      },
+      "x-nullable": false
     },
     "Event": {
       "type": "object",
@@ -1673,6 +3390,67 @@ func init() {
         }
       }
     },
+    "HammertimeActivation": {
+      "type": "object",
+      "properties": {
+        "end": {
+          "description": "The time the cluster recovered",
+          "type": "string"
+        },
+        "mode": {
+          "type": "string"
+        },
+        "start": {
+          "description": "The time hammertime intervened",
+          "type": "string"
+        }
+      },
+      "x-nullable": false
+    },
+    "HammertimeSpec": {
+      "description": "Tunables for hammertime which intervenes when all nodes of the cluster stop sending heartbeats.",
+      "type": "object",
+      "properties": {
+        "gracePeriod": {
+          "description": "Seconds hammertime only warns before pausing node eviction in graded mode. Defaults to the timeout.",
+          "type": "integer",
+          "minimum": 0
+        },
+        "minNodes": {
+          "description": "Minimum number of nodes required for hammertime to be active.",
+          "type": "integer",
+          "minimum": 2
+        },
+        "mode": {
+          "description": "scale stops the controller-manager. graded only warns during the grace period and then pauses node eviction of the controller-manager.",
+          "type": "string",
+          "enum": [
+            "scale",
+            "graded"
+          ]
+        },
+        "timeout": {
+          "description": "Seconds without any node heartbeat before hammertime intervenes.",
+          "type": "integer",
+          "minimum": 10
+        }
+      }
+    },
+    "HammertimeStatus": {
+      "type": "object",
+      "properties": {
+        "activations": {
+          "description": "The most recent activations of hammertime",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/HammertimeActivation"
+          }
+        },
+        "active": {
+          "type": "boolean"
+        }
+      }
+    },
     "Info": {
       "properties": {
         "availableClusterVersions": {
@@ -1738,6 +3516,7 @@ func init() {
         "Creating",
         "Running",
         "Upgrading",
+        "Restoring",
         "Terminating"
       ]
     },
@@ -1781,6 +3560,9 @@ func init() {
           ],
           "x-nullable": false
         },
+        "backupTarget": {
+          "$ref": "#/definitions/BackupTarget"
+        },
         "clusterCIDR": {
           "description": "CIDR Range for Pods in the cluster. Can not be updated.",
           "type": "string",
@@ -1811,6 +3593,18 @@ func init() {
           "default": "cluster.local",
           "x-nullable": false
         },
+        "hammertime": {
+          "$ref": "#/definitions/HammertimeSpec"
+        },
+        "keyAlgorithm": {
+          "description": "Algorithm of newly created private keys. Existing keys are kept, certificates switch when they are\nrenewed and CAs when they are rotated. Defaults to rsa.\n",
+          "type": "string",
+          "enum": [
+            "rsa",
+            "ecdsa-p256",
+            "ed25519"
+          ]
+        },
         "name": {
           "type": "string"
         },
@@ -1847,6 +3641,11 @@ func init() {
           "type": "string",
           "maxLength": 10000
         },
+        "terminationProtection": {
+          "description": "Prevents the cluster from being terminated. Can only be changed by kubernetes admins.",
+          "type": "boolean",
+          "x-nullable": true
+        },
         "version": {
           "description": "Kubernetes version",
           "type": "string",
@@ -1864,6 +3663,9 @@ func init() {
         "apiserverVersion": {
           "type": "string"
         },
+        "caRotation": {
+          "$ref": "#/definitions/CARotationStatus"
+        },
         "chartName": {
           "type": "string"
         },
@@ -1873,6 +3675,19 @@ func init() {
         "dashboard": {
           "type": "string"
         },
+        "etcdRestore": {
+          "$ref": "#/definitions/EtcdRestoreStatus"
+        },
+        "etcdSnapshots": {
+          "description": "Full etcd snapshots taken on demand, e.g. before upgrades. The latest is last.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/EtcdSnapshotReference"
+          }
+        },
+        "hammertime": {
+          "$ref": "#/definitions/HammertimeStatus"
+        },
         "migrationsPending": {
           "type": "boolean"
         },
@@ -1937,7 +3752,7 @@ func init() {
           "x-nullable": false
         },
         "labels": {
-          "description": "The specified labels will be added to members of this pool once during initial registration of the node",
+          "description": "The specified labels are applied to members of this pool and kept in sync when the pool is updated",
           "type": "array",
           "items": {
             "type": "string",
@@ -1958,7 +3773,7 @@ func init() {
           "x-nullable": false
         },
         "taints": {
-          "description": "The specified taints will be added to members of this pool once during initial registration of the node",
+          "description": "The specified taints are applied to members of this pool and kept in sync when the pool is updated",
           "type": "array",
           "items": {
             "type": "string",
@@ -2123,6 +3938,119 @@ func init() {
         }
       }
     },
+    "TerminationPlan": {
+      "type": "object",
+      "properties": {
+        "delete": {
+          "description": "Resources deleted when terminating the cluster",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DebrisResource"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "retain": {
+          "description": "Resources kept after terminating the cluster",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DebrisResource"
+          }
+        },
+        "scheduledDeletion": {
+          "description": "The time at which the cluster is going to be deleted, if scheduled",
+          "type": "string"
+        },
+        "warnings": {
+          "description": "Problems encountered while listing resources, the plan might be incomplete",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "TerminationReport": {
+      "type": "object",
+      "properties": {
+        "account": {
+          "description": "account id",
+          "type": "string"
+        },
+        "createdAt": {
+          "description": "The time at which the inventory was taken",
+          "type": "string"
+        },
+        "name": {
+          "description": "name of the terminated cluster",
+          "type": "string"
+        },
+        "reason": {
+          "description": "why the cluster self-destructed",
+          "type": "string"
+        },
+        "resources": {
+          "description": "OpenStack resources attributable to the cluster that still existed",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DebrisResource"
+          }
+        }
+      }
+    },
+    "UserCertificate": {
+      "type": "object",
+      "properties": {
+        "expiresAt": {
+          "type": "string"
+        },
+        "issuedAt": {
+          "type": "string"
+        },
+        "revoked": {
+          "type": "boolean"
+        },
+        "serial": {
+          "type": "string"
+        },
+        "user": {
+          "type": "string"
+        }
+      },
+      "x-nullable": false
+    },
+    "UserCertificateList": {
+      "type": "object",
+      "properties": {
+        "certificates": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/UserCertificate"
+          }
+        },
+        "deniedUsers": {
+          "description": "Users which can't get new certificates",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "UserCertificateRevocation": {
+      "description": "Revocation replaces the CA signing user certificates, all certificates issued before become invalid.\nSingle certificates can't be revoked.\n",
+      "type": "object",
+      "properties": {
+        "deny": {
+          "description": "Users to add to the deny list",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "error": {
       "description": "the error model is a model for all the error responses coming from Kubernikus\n",
       "type": "object",
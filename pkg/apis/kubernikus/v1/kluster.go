@@ -1,8 +1,10 @@
 package v1
 
 import (
+	"encoding/json"
 	"fmt"
 	"net"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -12,6 +14,24 @@ import (
 
 var TerminationProtectionAnnotationKey = "kubernikus.cloud.sap/termination-protection"
 
+// ScheduledDeletionAnnotationKey holds the RFC3339 timestamp after which a
+// kluster scheduled for deletion is terminated. The node pool sizes before
+// scaling to zero are kept in ScheduledDeletionPoolSizesAnnotationKey.
+var ScheduledDeletionAnnotationKey = "kubernikus.cloud.sap/scheduled-deletion"
+var ScheduledDeletionPoolSizesAnnotationKey = "kubernikus.cloud.sap/scheduled-deletion-pool-sizes"
+
+// UserCARevisionAnnotationKey is increased to revoke all user certificates,
+// the certs controller replaces the users CA when it changes.
+var UserCARevisionAnnotationKey = "kubernikus.cloud.sap/user-ca-revision"
+
+// PauseNodeEvictionAnnotationKey is set by hammertime while node eviction is
+// paused. The kube-controller-manager flags are rendered by the chart from it.
+var PauseNodeEvictionAnnotationKey = "kubernikus.cloud.sap/pause-node-eviction"
+
+// NodeEvictionPausedDeployedAnnotationKey records that groundctl deployed the
+// chart with node eviction paused, so it is only rolled out again on changes.
+var NodeEvictionPausedDeployedAnnotationKey = "kubernikus.cloud.sap/node-eviction-paused-deployed"
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
@@ -100,8 +120,72 @@ func (k *Kluster) Disabled() bool {
 	return k.Status.MigrationsPending
 }
 
+// TerminationProtection reports whether the kluster must not be terminated.
+// The annotation predates the spec field and is still honored.
 func (k *Kluster) TerminationProtection() bool {
-	return k.Annotations[TerminationProtectionAnnotationKey] != ""
+	return (k.Spec.TerminationProtection != nil && *k.Spec.TerminationProtection) || k.Annotations[TerminationProtectionAnnotationKey] != ""
+}
+
+func (k *Kluster) NodeEvictionPaused() bool {
+	return k.Annotations[PauseNodeEvictionAnnotationKey] == "true"
+}
+
+func (k *Kluster) NodeEvictionPausedDeployed() bool {
+	return k.Annotations[NodeEvictionPausedDeployedAnnotationKey] == "true"
+}
+
+// ScheduledDeletion returns the time the kluster is going to be terminated
+// at, if a deletion is scheduled.
+func (k *Kluster) ScheduledDeletion() (time.Time, bool) {
+	value, ok := k.Annotations[ScheduledDeletionAnnotationKey]
+	if !ok {
+		return time.Time{}, false
+	}
+	deadline, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		// An unparsable deadline is treated as due
+		return time.Time{}, true
+	}
+	return deadline, true
+}
+
+// ScheduleDeletion scales all node pools to zero and records the deadline
+// and the previous pool sizes so the deletion can be cancelled.
+func (k *Kluster) ScheduleDeletion(deadline time.Time) error {
+	sizes := make(map[string]int64, len(k.Spec.NodePools))
+	for i := range k.Spec.NodePools {
+		sizes[k.Spec.NodePools[i].Name] = k.Spec.NodePools[i].Size
+		k.Spec.NodePools[i].Size = 0
+	}
+	data, err := json.Marshal(sizes)
+	if err != nil {
+		return err
+	}
+	if k.Annotations == nil {
+		k.Annotations = map[string]string{}
+	}
+	k.Annotations[ScheduledDeletionAnnotationKey] = deadline.UTC().Format(time.RFC3339)
+	k.Annotations[ScheduledDeletionPoolSizesAnnotationKey] = string(data)
+	return nil
+}
+
+// CancelScheduledDeletion restores the node pool sizes recorded by
+// ScheduleDeletion and removes the schedule.
+func (k *Kluster) CancelScheduledDeletion() error {
+	sizes := map[string]int64{}
+	if data, ok := k.Annotations[ScheduledDeletionPoolSizesAnnotationKey]; ok {
+		if err := json.Unmarshal([]byte(data), &sizes); err != nil {
+			return fmt.Errorf("failed to parse node pool sizes: %s", err)
+		}
+	}
+	for i := range k.Spec.NodePools {
+		if size, ok := sizes[k.Spec.NodePools[i].Name]; ok {
+			k.Spec.NodePools[i].Size = size
+		}
+	}
+	delete(k.Annotations, ScheduledDeletionAnnotationKey)
+	delete(k.Annotations, ScheduledDeletionPoolSizesAnnotationKey)
+	return nil
 }
 
 func (k *Kluster) ClusterCIDR() string {
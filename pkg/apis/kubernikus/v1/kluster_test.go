@@ -0,0 +1,46 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+func TestScheduledDeletion(t *testing.T) {
+	kluster := &Kluster{
+		Spec: models.KlusterSpec{
+			NodePools: []models.NodePool{
+				{Name: "small", Size: 3},
+				{Name: "large", Size: 1},
+			},
+		},
+	}
+
+	_, scheduled := kluster.ScheduledDeletion()
+	assert.False(t, scheduled)
+
+	deadline := time.Now().Add(72 * time.Hour).Truncate(time.Second)
+	require.NoError(t, kluster.ScheduleDeletion(deadline))
+
+	scheduledAt, scheduled := kluster.ScheduledDeletion()
+	assert.True(t, scheduled)
+	assert.True(t, deadline.Equal(scheduledAt))
+	for _, pool := range kluster.Spec.NodePools {
+		assert.Equal(t, int64(0), pool.Size, "pool %s should be scaled to zero", pool.Name)
+	}
+
+	// A pool added while scheduled keeps its size
+	kluster.Spec.NodePools = append(kluster.Spec.NodePools, models.NodePool{Name: "new", Size: 0})
+
+	require.NoError(t, kluster.CancelScheduledDeletion())
+	_, scheduled = kluster.ScheduledDeletion()
+	assert.False(t, scheduled)
+	assert.Equal(t, int64(3), kluster.Spec.NodePools[0].Size)
+	assert.Equal(t, int64(1), kluster.Spec.NodePools[1].Size)
+	assert.Equal(t, int64(0), kluster.Spec.NodePools[2].Size)
+	assert.Empty(t, kluster.Annotations)
+}
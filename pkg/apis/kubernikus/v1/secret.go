@@ -18,6 +18,8 @@ type Secret struct {
 
 	Certificates
 
+	Backup
+
 	ExtraValues string `json:"extra-values,omitempty"`
 }
 
@@ -59,6 +61,9 @@ func (s *Secret) ToStringData() (map[string]string, error) {
 		return nil, err
 	}
 	result["apiserver-clients-and-nodes-ca.pem"] = strings.TrimSuffix(s.ApiserverClientsCACertifcate, "\n") + "\n" + s.ApiserverNodesCACertificate
+	if s.ApiserverUsersCACertificate != "" {
+		result["apiserver-clients-and-nodes-ca.pem"] = strings.TrimSuffix(result["apiserver-clients-and-nodes-ca.pem"], "\n") + "\n" + s.ApiserverUsersCACertificate
+	}
 	return result, nil
 }
 
@@ -75,6 +80,13 @@ type Openstack struct {
 	ProjectDomainID   string `json:"openstack-project-domain-id,omitempty"`
 }
 
+// Backup holds what etcdbr needs to access the backup target of the kluster
+type Backup struct {
+	SwiftProjectName  string `json:"backup-swift-project-name,omitempty"`
+	S3AccessKeyID     string `json:"backup-s3-access-key-id,omitempty"`
+	S3SecretAccessKey string `json:"backup-s3-secret-access-key,omitempty"`
+}
+
 type Certificates struct {
 	ApiserverClientsCAPrivateKey                     string `json:"apiserver-clients-ca-key.pem"`
 	ApiserverClientsCACertifcate                     string `json:"apiserver-clients-ca.pem"`
@@ -132,6 +144,17 @@ type Certificates struct {
 	AdmissionCACertificate string `json:"admission-ca.pem"`
 	AdmissionPrivateKey    string `json:"admission-key.pem"`
 	AdmissionCertificate   string `json:"admission.pem"`
+
+	// ApiserverUsersCA signs the client certificates of users. It is trusted
+	// by the apiserver next to the clients CA and replaced to revoke them.
+	ApiserverUsersCAPrivateKey  string `json:"apiserver-users-ca-key.pem,omitempty"`
+	ApiserverUsersCACertificate string `json:"apiserver-users-ca.pem,omitempty"`
+	// ApiserverUsersCARevision is the UserCARevisionAnnotationKey value the users CA was created for
+	ApiserverUsersCARevision string `json:"apiserver-users-ca-revision,omitempty"`
+
+	// CARotationPrivateKeys holds the keys of new CAs which are trusted but
+	// don't sign yet during a CA rotation (JSON object: CA name -> PEM key)
+	CARotationPrivateKeys string `json:"ca-rotation-keys.json,omitempty"`
 }
 
 func (s *Certificates) ToStringData() (map[string]string, error) {
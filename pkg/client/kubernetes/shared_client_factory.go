@@ -41,6 +41,21 @@ func NewSharedClientFactory(client kubernetes.Interface, klusterEvents cache.Sha
 
 	if klusterEvents != nil {
 		klusterEvents.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			// cached clients only trust the TLS CAs at the time they were created
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				oldKluster, ok1 := oldObj.(*kubernikus_v1.Kluster)
+				newKluster, ok2 := newObj.(*kubernikus_v1.Kluster)
+				if !ok1 || !ok2 || caRotationPhase(oldKluster) == caRotationPhase(newKluster) {
+					return
+				}
+				factory.clients.Delete(newKluster.GetUID())
+				factory.Logger.Log(
+					"msg", "deleted shared kubernetes client due to CA rotation",
+					"kluster", newKluster.GetName(),
+					"project", newKluster.Account(),
+					"v", 2,
+				)
+			},
 			DeleteFunc: func(obj interface{}) {
 				if kluster, ok := obj.(*kubernikus_v1.Kluster); ok {
 					factory.clients.Delete(kluster.GetUID())
@@ -176,3 +191,10 @@ func (m *MockSharedClientFactory) ClientFor(k *kubernikus_v1.Kluster) (kubernete
 func (m *MockSharedClientFactory) DynamicClientFor(k *kubernikus_v1.Kluster) (dynamic.Interface, error) {
 	return m.DynamicClientset, nil
 }
+
+func caRotationPhase(k *kubernikus_v1.Kluster) string {
+	if k.Status.CaRotation == nil {
+		return ""
+	}
+	return string(k.Status.CaRotation.Phase)
+}
@@ -34,9 +34,9 @@ type AdminClient interface {
 	GetKubernikusCatalogEntry() (string, error)
 	GetRegion() (string, error)
 	GetDomainID(domainName string) (string, error)
-	CreateStorageContainer(projectID, containerName, serviceUserName, serviceUserDomainName string) error
-	GetStorageContainerMeta(projectID, containerName string) (*ContainerMeta, error)
-	UpdateStorageContainerMeta(projectID, container string, meta ContainerMeta) error
+	CreateStorageContainer(projectID, region, containerName, serviceUserName, serviceUserDomainName string) error
+	GetStorageContainerMeta(projectID, region, containerName string) (*ContainerMeta, error)
+	UpdateStorageContainerMeta(projectID, region, container string, meta ContainerMeta) error
 	GetContainerACLEntry(projectID, serviceUserName, serviceUserDomainName string) (string, error)
 	AssignUserRoles(projectID, userName, domainName string, userRoles []string) error
 	GetUserRoles(projectID, userName, domainName string) ([]string, error)
@@ -363,8 +363,8 @@ func (c *adminClient) getRoleID(roleName string) (string, error) {
 
 }
 
-func (c *adminClient) CreateStorageContainer(projectID, containerName, serviceUserName, serviceUserDomainName string) error {
-	endpointURL, err := c.getPublicObjectStoreEndpointURL(projectID)
+func (c *adminClient) CreateStorageContainer(projectID, region, containerName, serviceUserName, serviceUserDomainName string) error {
+	endpointURL, err := c.getPublicObjectStoreEndpointURL(projectID, region)
 	if err != nil {
 		return err
 	}
@@ -402,8 +402,8 @@ func (c *adminClient) GetContainerACLEntry(projectID, serviceUserName, serviceUs
 }
 
 // a nil value and nil error marks a non-existent container
-func (c *adminClient) GetStorageContainerMeta(projectID, containerName string) (*ContainerMeta, error) {
-	endpointURL, err := c.getPublicObjectStoreEndpointURL(projectID)
+func (c *adminClient) GetStorageContainerMeta(projectID, region, containerName string) (*ContainerMeta, error) {
+	endpointURL, err := c.getPublicObjectStoreEndpointURL(projectID, region)
 	if err != nil {
 		return nil, err
 	}
@@ -427,8 +427,8 @@ func (c *adminClient) GetStorageContainerMeta(projectID, containerName string) (
 	}, nil
 }
 
-func (c *adminClient) UpdateStorageContainerMeta(projectID, container string, meta ContainerMeta) error {
-	endpointURL, err := c.getPublicObjectStoreEndpointURL(projectID)
+func (c *adminClient) UpdateStorageContainerMeta(projectID, region, container string, meta ContainerMeta) error {
+	endpointURL, err := c.getPublicObjectStoreEndpointURL(projectID, region)
 	if err != nil {
 		return err
 	}
@@ -447,7 +447,8 @@ func (c *adminClient) UpdateStorageContainerMeta(projectID, container string, me
 	return err
 }
 
-func (c *adminClient) getPublicObjectStoreEndpointURL(projectID string) (string, error) {
+// an empty region expects a single object-store endpoint
+func (c *adminClient) getPublicObjectStoreEndpointURL(projectID, region string) (string, error) {
 	serviceListOpts := services.ListOpts{
 		ServiceType: "object-store",
 	}
@@ -468,6 +469,7 @@ func (c *adminClient) getPublicObjectStoreEndpointURL(projectID string) (string,
 	endpointListOpts := endpoints.ListOpts{
 		ServiceID:    allServices[0].ID,
 		Availability: gophercloud.AvailabilityPublic,
+		RegionID:     region,
 	}
 
 	allEndpointPages, err := endpoints.List(c.IdentityClient, endpointListOpts).AllPages()
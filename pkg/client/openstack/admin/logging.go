@@ -67,11 +67,12 @@ func (c LoggingClient) GetRegion() (region string, err error) {
 	return c.Client.GetRegion()
 }
 
-func (c LoggingClient) CreateStorageContainer(projectID, containerName, serviceUserName, serviceUserDomainName string) (err error) {
+func (c LoggingClient) CreateStorageContainer(projectID, region, containerName, serviceUserName, serviceUserDomainName string) (err error) {
 	defer func(begin time.Time) {
 		c.Logger.Log(
 			"msg", "create storage container",
 			"project_id", projectID,
+			"region", region,
 			"container_name", containerName,
 			"service_user_name", serviceUserName,
 			"service_user_domain", serviceUserDomainName,
@@ -80,14 +81,15 @@ func (c LoggingClient) CreateStorageContainer(projectID, containerName, serviceU
 			"err", err,
 		)
 	}(time.Now())
-	return c.Client.CreateStorageContainer(projectID, containerName, serviceUserName, serviceUserDomainName)
+	return c.Client.CreateStorageContainer(projectID, region, containerName, serviceUserName, serviceUserDomainName)
 }
 
-func (c LoggingClient) GetStorageContainerMeta(projectID, containerName string) (result *ContainerMeta, err error) {
+func (c LoggingClient) GetStorageContainerMeta(projectID, region, containerName string) (result *ContainerMeta, err error) {
 	defer func(begin time.Time) {
 		c.Logger.Log(
 			"msg", "checking if storage container exists",
 			"project_id", projectID,
+			"region", region,
 			"container_name", containerName,
 			"took", time.Since(begin),
 			"meta", result,
@@ -95,18 +97,19 @@ func (c LoggingClient) GetStorageContainerMeta(projectID, containerName string)
 			"err", err,
 		)
 	}(time.Now())
-	return c.Client.GetStorageContainerMeta(projectID, containerName)
+	return c.Client.GetStorageContainerMeta(projectID, region, containerName)
 }
 
 func (c LoggingClient) GetContainerACLEntry(projectID, serviceUserName, serviceUserDomainName string) (result string, err error) {
 	return c.Client.GetContainerACLEntry(projectID, serviceUserName, serviceUserDomainName)
 }
 
-func (c LoggingClient) UpdateStorageContainerMeta(projectID, container string, meta ContainerMeta) (err error) {
+func (c LoggingClient) UpdateStorageContainerMeta(projectID, region, container string, meta ContainerMeta) (err error) {
 	defer func(begin time.Time) {
 		c.Logger.Log(
 			"msg", "updating storage container",
 			"project_id", projectID,
+			"region", region,
 			"container_name", container,
 			"took", time.Since(begin),
 			"meta", meta,
@@ -114,7 +117,7 @@ func (c LoggingClient) UpdateStorageContainerMeta(projectID, container string, m
 			"err", err,
 		)
 	}(time.Now())
-	return c.Client.UpdateStorageContainerMeta(projectID, container, meta)
+	return c.Client.UpdateStorageContainerMeta(projectID, region, container, meta)
 }
 
 func (c LoggingClient) AssignUserRoles(projectID, userName, domainName string, userRoles []string) (err error) {
@@ -0,0 +1,228 @@
+package fake
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sapcc/kubernikus/pkg/client/openstack/admin"
+)
+
+var _ admin.AdminClient = &AdminClient{}
+
+// AdminClient is an in-memory AdminClient operating on keystone and swift
+type AdminClient struct {
+	cloud *Cloud
+}
+
+func (c *AdminClient) CreateKlusterServiceUser(username, password, domainName, projectID string) error {
+	domainID, err := c.GetDomainID(domainName)
+	if err != nil {
+		return err
+	}
+
+	c.cloud.lock.Lock()
+	user := c.cloud.userByName(username, domainID)
+	if user == nil {
+		user = &User{ID: newID(), Name: username, DomainID: domainID}
+		c.cloud.users[user.ID] = user
+	}
+	user.Password = password
+	user.DefaultProjectID = projectID
+	c.cloud.lock.Unlock()
+
+	if err := c.AssignUserRoles(projectID, username, domainName, c.GetDefaultServiceUserRoles()); err != nil {
+		return fmt.Errorf("failed to assign roles to service user: %s", err)
+	}
+	return nil
+}
+
+func (c *AdminClient) DeleteUser(username, domainName string) error {
+	domainID, err := c.GetDomainID(domainName)
+	if err != nil {
+		return err
+	}
+	c.cloud.lock.Lock()
+	defer c.cloud.lock.Unlock()
+	if user := c.cloud.userByName(username, domainID); user != nil {
+		delete(c.cloud.users, user.ID)
+		for key := range c.cloud.roleAssignment {
+			if strings.HasSuffix(key, "/"+user.ID) {
+				delete(c.cloud.roleAssignment, key)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *AdminClient) GetKubernikusCatalogEntry() (string, error) {
+	return c.cloud.KubernikusURL, nil
+}
+
+func (c *AdminClient) GetRegion() (string, error) {
+	return c.cloud.Region, nil
+}
+
+func (c *AdminClient) GetDomainID(domainName string) (string, error) {
+	c.cloud.lock.RLock()
+	defer c.cloud.lock.RUnlock()
+	id, ok := c.cloud.domains[domainName]
+	if !ok {
+		return "", fmt.Errorf("domain %s not found", domainName)
+	}
+	return id, nil
+}
+
+func (c *AdminClient) CreateStorageContainer(projectID, region, containerName, serviceUserName, serviceUserDomainName string) error {
+	acl, err := c.GetContainerACLEntry(projectID, serviceUserName, serviceUserDomainName)
+	if err != nil {
+		return err
+	}
+	c.cloud.lock.Lock()
+	defer c.cloud.lock.Unlock()
+	if region == "" {
+		region = c.cloud.Region
+	}
+	c.cloud.containers[projectID+"/"+containerName] = &Container{
+		ProjectID: projectID,
+		Region:    region,
+		Name:      containerName,
+		ReadACL:   []string{acl},
+		WriteACL:  []string{acl},
+	}
+	return nil
+}
+
+// a nil value and nil error marks a non-existent container
+func (c *AdminClient) GetStorageContainerMeta(projectID, region, containerName string) (*admin.ContainerMeta, error) {
+	c.cloud.lock.RLock()
+	defer c.cloud.lock.RUnlock()
+	container, ok := c.cloud.containers[projectID+"/"+containerName]
+	if !ok {
+		return nil, nil
+	}
+	return &admin.ContainerMeta{
+		ReadACL:  append([]string{}, container.ReadACL...),
+		WriteACL: append([]string{}, container.WriteACL...),
+	}, nil
+}
+
+func (c *AdminClient) UpdateStorageContainerMeta(projectID, region, containerName string, meta admin.ContainerMeta) error {
+	c.cloud.lock.Lock()
+	defer c.cloud.lock.Unlock()
+	container, ok := c.cloud.containers[projectID+"/"+containerName]
+	if !ok {
+		return fmt.Errorf("container %s not found", containerName)
+	}
+	container.ReadACL = append([]string{}, meta.ReadACL...)
+	container.WriteACL = append([]string{}, meta.WriteACL...)
+	return nil
+}
+
+func (c *AdminClient) GetContainerACLEntry(projectID, serviceUserName, serviceUserDomainName string) (string, error) {
+	domainID, err := c.GetDomainID(serviceUserDomainName)
+	if err != nil {
+		return "", err
+	}
+	c.cloud.lock.RLock()
+	defer c.cloud.lock.RUnlock()
+	user := c.cloud.userByName(serviceUserName, domainID)
+	if user == nil {
+		return "", fmt.Errorf("user %s not found", serviceUserName)
+	}
+	return fmt.Sprintf("%s:%s", projectID, user.ID), nil
+}
+
+func (c *AdminClient) AssignUserRoles(projectID, userName, domainName string, userRoles []string) error {
+	domainID, err := c.GetDomainID(domainName)
+	if err != nil {
+		return err
+	}
+	c.cloud.lock.Lock()
+	defer c.cloud.lock.Unlock()
+	user := c.cloud.userByName(userName, domainID)
+	if user == nil {
+		return fmt.Errorf("user %s not found", userName)
+	}
+	for _, role := range userRoles {
+		if !contains(c.cloud.Roles, role) {
+			return fmt.Errorf("role %s not found", role)
+		}
+	}
+	key := assignmentKey(projectID, user.ID)
+	if c.cloud.roleAssignment[key] == nil {
+		c.cloud.roleAssignment[key] = map[string]bool{}
+	}
+	for _, role := range userRoles {
+		c.cloud.roleAssignment[key][role] = true
+	}
+	return nil
+}
+
+func (c *AdminClient) GetUserRoles(projectID, userName, domainName string) ([]string, error) {
+	domainID, err := c.GetDomainID(domainName)
+	if err != nil {
+		return nil, err
+	}
+	c.cloud.lock.RLock()
+	defer c.cloud.lock.RUnlock()
+	user := c.cloud.userByName(userName, domainID)
+	if user == nil {
+		return nil, fmt.Errorf("user %s not found", userName)
+	}
+	var roles []string
+	for role := range c.cloud.roleAssignment[assignmentKey(projectID, user.ID)] {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+	return roles, nil
+}
+
+func (c *AdminClient) GetDefaultServiceUserRoles() []string {
+	return []string{"network_admin", "member"}
+}
+
+func (c *AdminClient) GetDomainNameByProject(projectID string) (string, error) {
+	c.cloud.lock.RLock()
+	defer c.cloud.lock.RUnlock()
+	project, ok := c.cloud.projects[projectID]
+	if !ok {
+		return "", fmt.Errorf("project %s not found", projectID)
+	}
+	for name, id := range c.cloud.domains {
+		if id == project.DomainID {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("domain %s not found", project.DomainID)
+}
+
+func (c *AdminClient) GetProjectName(projectID string) (string, error) {
+	project, ok := c.cloud.Project(projectID)
+	if !ok {
+		return "", fmt.Errorf("project %s not found", projectID)
+	}
+	return project.Name, nil
+}
+
+func assignmentKey(projectID, userID string) string {
+	return projectID + "/" + userID
+}
+
+func contains(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAll(list []string, want []string) bool {
+	for _, s := range want {
+		if !contains(list, s) {
+			return false
+		}
+	}
+	return true
+}
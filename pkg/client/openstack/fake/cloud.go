@@ -0,0 +1,817 @@
+package fake
+
+import (
+	"fmt"
+	"net"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/uuid"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+const (
+	DefaultRegion     = "eu-de-1"
+	DefaultDomain     = "Default"
+	DefaultNetwork    = "10.180.0.0/16"
+	KubernikusCatalog = "https://kubernikus.eu-de-1.cloud.sap"
+)
+
+type (
+	// Project is a keystone project
+	Project struct {
+		ID       string
+		Name     string
+		DomainID string
+	}
+
+	// User is a keystone user
+	User struct {
+		ID               string
+		Name             string
+		DomainID         string
+		Password         string
+		DefaultProjectID string
+	}
+
+	// Server is a nova server
+	Server struct {
+		ID             string
+		ProjectID      string
+		Name           string
+		Flavor         string
+		Image          string
+		Zone           string
+		NetworkID      string
+		Address        string
+		SecurityGroups []string
+		ServerGroupID  string
+		Metadata       map[string]string
+		Tags           []string
+		UserData       []byte
+		Created        time.Time
+		VMState        string
+		TaskState      string
+		PowerState     int
+		Reboots        int
+		Volumes        []string
+	}
+
+	// ServerGroup is a nova server group
+	ServerGroup struct {
+		ID        string
+		ProjectID string
+		Name      string
+		Policies  []string
+	}
+
+	// SecurityGroupRule is a neutron security group rule
+	SecurityGroupRule struct {
+		ID             string
+		Direction      string
+		EtherType      string
+		Protocol       string
+		RemoteIPPrefix string
+	}
+
+	// SecurityGroup is a neutron security group
+	SecurityGroup struct {
+		ID        string
+		ProjectID string
+		Name      string
+		Rules     []SecurityGroupRule
+	}
+
+	// Route is a static route of a neutron router
+	Route struct {
+		DestinationCIDR string
+		NextHop         string
+	}
+
+	// Subnet is a neutron subnet
+	Subnet struct {
+		ID   string
+		Name string
+		CIDR string
+	}
+
+	// Network is a neutron network
+	Network struct {
+		ID      string
+		Name    string
+		Subnets []Subnet
+	}
+
+	// Router is a neutron router
+	Router struct {
+		ID                string
+		ProjectID         string
+		Name              string
+		ExternalNetworkID string
+		Networks          []Network
+		Routes            []Route
+	}
+
+	// Port is a neutron port
+	Port struct {
+		ID          string
+		ProjectID   string
+		Name        string
+		NetworkID   string
+		DeviceID    string
+		DeviceOwner string
+		Address     string
+		Tags        []string
+	}
+
+	// Volume is a cinder volume
+	Volume struct {
+		ID                  string
+		ProjectID           string
+		Name                string
+		Size                int
+		Status              string
+		AttachedTo          string
+		DeleteOnTermination bool
+		Bootable            bool
+		Metadata            map[string]string
+	}
+
+	// Snapshot is a cinder volume snapshot
+	Snapshot struct {
+		ID        string
+		ProjectID string
+		Name      string
+		VolumeID  string
+		Status    string
+		Metadata  map[string]string
+	}
+
+	// LoadBalancer is an octavia load balancer
+	LoadBalancer struct {
+		ID         string
+		ProjectID  string
+		Name       string
+		Tags       []string
+		VipPortID  string
+		VipAddress string
+		Status     string
+	}
+
+	// FloatingIP is a neutron floating ip
+	FloatingIP struct {
+		ID          string
+		ProjectID   string
+		Description string
+		Address     string
+		PortID      string
+	}
+
+	// Container is a swift container
+	Container struct {
+		ProjectID string
+		Region    string
+		Name      string
+		ReadACL   []string
+		WriteACL  []string
+	}
+)
+
+// Cloud is a stateful in-memory OpenStack.
+//
+// It backs the fake clients returned by Factory. All resources are kept in
+// memory and can be seeded and inspected by tests.
+type Cloud struct {
+	lock sync.RWMutex
+
+	Region            string
+	KubernikusURL     string
+	Flavors           []models.Flavor
+	Images            []string
+	AvailabilityZones []string
+	VolumeTypes       []string
+	Roles             []string
+
+	domains        map[string]string
+	projects       map[string]*Project
+	users          map[string]*User
+	roleAssignment map[string]map[string]bool
+	servers        map[string]*Server
+	serverGroups   map[string]*ServerGroup
+	securityGroups map[string]*SecurityGroup
+	routers        map[string]*Router
+	ports          map[string]*Port
+	volumes        map[string]*Volume
+	snapshots      map[string]*Snapshot
+	loadBalancers  map[string]*LoadBalancer
+	floatingIPs    map[string]*FloatingIP
+	containers     map[string]*Container
+
+	nextIP         net.IP
+	nextFloatingIP int
+	server         *httptest.Server
+}
+
+// NewCloud returns an empty cloud with a default domain, flavors and images
+func NewCloud() *Cloud {
+	ip, _, _ := net.ParseCIDR(DefaultNetwork)
+	return &Cloud{
+		Region:        DefaultRegion,
+		KubernikusURL: KubernikusCatalog,
+		Flavors: []models.Flavor{
+			{ID: "10", Name: "m1.small", RAM: 2048, Vcpus: 1},
+			{ID: "20", Name: "m1.xlarge", RAM: 8192, Vcpus: 4},
+		},
+		Images:            []string{"flatcar-stable-amd64"},
+		AvailabilityZones: []string{"eu-de-1a", "eu-de-1b"},
+		VolumeTypes:       []string{"vmware", "premium"},
+		Roles:             []string{"member", "network_admin", "kubernetes_admin", "kubernetes_member"},
+
+		domains:        map[string]string{DefaultDomain: "default"},
+		projects:       map[string]*Project{},
+		users:          map[string]*User{},
+		roleAssignment: map[string]map[string]bool{},
+		servers:        map[string]*Server{},
+		serverGroups:   map[string]*ServerGroup{},
+		securityGroups: map[string]*SecurityGroup{},
+		routers:        map[string]*Router{},
+		ports:          map[string]*Port{},
+		volumes:        map[string]*Volume{},
+		snapshots:      map[string]*Snapshot{},
+		loadBalancers:  map[string]*LoadBalancer{},
+		floatingIPs:    map[string]*FloatingIP{},
+		containers:     map[string]*Container{},
+		nextIP:         ip.To4(),
+	}
+}
+
+func newID() string {
+	return string(uuid.NewUUID())
+}
+
+// AddDomain registers a domain and returns its id
+func (c *Cloud) AddDomain(name string) string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if id, ok := c.domains[name]; ok {
+		return id
+	}
+	id := newID()
+	c.domains[name] = id
+	return id
+}
+
+// AddProject registers a project in the given domain. The project gets a
+// router with a network and subnet and a default security group.
+func (c *Cloud) AddProject(id, name, domainName string) *Project {
+	domainID := c.AddDomain(domainName)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	project := &Project{ID: id, Name: name, DomainID: domainID}
+	c.projects[id] = project
+
+	routerID := newID()
+	c.routers[routerID] = &Router{
+		ID:                routerID,
+		ProjectID:         id,
+		Name:              "default",
+		ExternalNetworkID: newID(),
+		Networks: []Network{{
+			ID:      newID(),
+			Name:    "default",
+			Subnets: []Subnet{{ID: newID(), Name: "default", CIDR: DefaultNetwork}},
+		}},
+	}
+	sgID := newID()
+	c.securityGroups[sgID] = &SecurityGroup{ID: sgID, ProjectID: id, Name: "default"}
+
+	return project
+}
+
+// Project returns a project by id
+func (c *Cloud) Project(id string) (*Project, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	p, ok := c.projects[id]
+	return p, ok
+}
+
+// Routers returns the routers of a project
+func (c *Cloud) Routers(projectID string) []Router {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	result := []Router{}
+	for _, r := range c.routers {
+		if r.ProjectID == projectID {
+			result = append(result, copyRouter(r))
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// Router returns a router by id
+func (c *Cloud) Router(id string) (Router, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	r, ok := c.routers[id]
+	if !ok {
+		return Router{}, false
+	}
+	return copyRouter(r), true
+}
+
+// SetRoutes replaces the static routes of a router
+func (c *Cloud) SetRoutes(routerID string, routes []Route) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	r, ok := c.routers[routerID]
+	if !ok {
+		return fmt.Errorf("router %s not found", routerID)
+	}
+	r.Routes = append([]Route{}, routes...)
+	return nil
+}
+
+// Servers returns all servers of a project
+func (c *Cloud) Servers(projectID string) []Server {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	result := []Server{}
+	for _, s := range c.servers {
+		if projectID == "" || s.ProjectID == projectID {
+			result = append(result, *s)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// Server returns a server by id
+func (c *Cloud) Server(id string) (Server, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	s, ok := c.servers[id]
+	if !ok {
+		return Server{}, false
+	}
+	return *s, true
+}
+
+// AddServer inserts a server and allocates an address if none is given
+func (c *Cloud) AddServer(server Server) Server {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if server.ID == "" {
+		server.ID = newID()
+	}
+	if server.Address == "" {
+		server.Address = c.allocateIP()
+	}
+	if server.Created.IsZero() {
+		server.Created = time.Now()
+	}
+	if server.VMState == "" {
+		server.VMState = "active"
+		server.PowerState = 1
+	}
+	if server.Metadata == nil {
+		server.Metadata = map[string]string{}
+	}
+	c.servers[server.ID] = &server
+	port := &Port{
+		ID:          newID(),
+		ProjectID:   server.ProjectID,
+		NetworkID:   server.NetworkID,
+		DeviceID:    server.ID,
+		DeviceOwner: "compute:" + server.Zone,
+		Address:     server.Address,
+	}
+	c.ports[port.ID] = port
+	return server
+}
+
+// SetServerState changes the nova state of a server
+func (c *Cloud) SetServerState(id, vmState, taskState string, powerState int) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	s, ok := c.servers[id]
+	if !ok {
+		return fmt.Errorf("server %s not found", id)
+	}
+	s.VMState = vmState
+	s.TaskState = taskState
+	s.PowerState = powerState
+	return nil
+}
+
+// DeleteServer removes a server, its port and all volumes marked for deletion on termination
+func (c *Cloud) DeleteServer(id string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	s, ok := c.servers[id]
+	if !ok {
+		return fmt.Errorf("server %s not found", id)
+	}
+	for _, volumeID := range s.Volumes {
+		if v, ok := c.volumes[volumeID]; ok {
+			if v.DeleteOnTermination {
+				delete(c.volumes, volumeID)
+				continue
+			}
+			v.AttachedTo = ""
+			v.Status = "available"
+		}
+	}
+	for portID, port := range c.ports {
+		if port.DeviceID == id {
+			delete(c.ports, portID)
+		}
+	}
+	delete(c.servers, id)
+	return nil
+}
+
+// ServerGroups returns all server groups of a project
+func (c *Cloud) ServerGroups(projectID string) []ServerGroup {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	result := []ServerGroup{}
+	for _, sg := range c.serverGroups {
+		if sg.ProjectID == projectID {
+			result = append(result, *sg)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// AddServerGroup creates a server group
+func (c *Cloud) AddServerGroup(sg ServerGroup) ServerGroup {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if sg.ID == "" {
+		sg.ID = newID()
+	}
+	c.serverGroups[sg.ID] = &sg
+	return sg
+}
+
+// SecurityGroup returns a security group by project and name
+func (c *Cloud) SecurityGroup(projectID, name string) (SecurityGroup, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	sg := c.securityGroupByName(projectID, name)
+	if sg == nil {
+		return SecurityGroup{}, false
+	}
+	return *sg, true
+}
+
+// AddSecurityGroup creates a security group
+func (c *Cloud) AddSecurityGroup(projectID, name string) SecurityGroup {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	sg := &SecurityGroup{ID: newID(), ProjectID: projectID, Name: name}
+	c.securityGroups[sg.ID] = sg
+	return *sg
+}
+
+// Ports returns all ports of a project
+func (c *Cloud) Ports(projectID string) []Port {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	result := []Port{}
+	for _, p := range c.ports {
+		if p.ProjectID == projectID {
+			result = append(result, *p)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result
+}
+
+// AddPort adds a port that is not bound to a server
+func (c *Cloud) AddPort(port Port) Port {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if port.ID == "" {
+		port.ID = newID()
+	}
+	if port.Address == "" {
+		port.Address = c.allocateIP()
+	}
+	c.ports[port.ID] = &port
+	return port
+}
+
+// DeletePort removes a port
+func (c *Cloud) DeletePort(id string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if _, ok := c.ports[id]; !ok {
+		return fmt.Errorf("port %s not found", id)
+	}
+	delete(c.ports, id)
+	return nil
+}
+
+// TagPort adds a tag to a port
+func (c *Cloud) TagPort(id, tag string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	p, ok := c.ports[id]
+	if !ok {
+		return fmt.Errorf("port %s not found", id)
+	}
+	if !contains(p.Tags, tag) {
+		p.Tags = append(p.Tags, tag)
+	}
+	return nil
+}
+
+// Volumes returns all volumes of a project
+func (c *Cloud) Volumes(projectID string) []Volume {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	result := []Volume{}
+	for _, v := range c.volumes {
+		if v.ProjectID == projectID {
+			result = append(result, *v)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// AddVolume adds a volume
+func (c *Cloud) AddVolume(volume Volume) Volume {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if volume.ID == "" {
+		volume.ID = newID()
+	}
+	if volume.Status == "" {
+		volume.Status = "available"
+		if volume.AttachedTo != "" {
+			volume.Status = "in-use"
+		}
+	}
+	if s, ok := c.servers[volume.AttachedTo]; ok {
+		s.Volumes = append(s.Volumes, volume.ID)
+	}
+	c.volumes[volume.ID] = &volume
+	return volume
+}
+
+// DeleteVolume removes a volume
+func (c *Cloud) DeleteVolume(id string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	v, ok := c.volumes[id]
+	if !ok {
+		return fmt.Errorf("volume %s not found", id)
+	}
+	if v.Status == "in-use" {
+		return fmt.Errorf("volume %s is in use", id)
+	}
+	delete(c.volumes, id)
+	return nil
+}
+
+// SetVolumeMetadata replaces the metadata of a volume
+func (c *Cloud) SetVolumeMetadata(id string, metadata map[string]string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	v, ok := c.volumes[id]
+	if !ok {
+		return fmt.Errorf("volume %s not found", id)
+	}
+	v.Metadata = map[string]string{}
+	for key, value := range metadata {
+		v.Metadata[key] = value
+	}
+	return nil
+}
+
+// Snapshots returns all volume snapshots of a project
+func (c *Cloud) Snapshots(projectID string) []Snapshot {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	result := []Snapshot{}
+	for _, s := range c.snapshots {
+		if s.ProjectID == projectID {
+			result = append(result, *s)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// AddSnapshot adds a volume snapshot
+func (c *Cloud) AddSnapshot(snapshot Snapshot) Snapshot {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if snapshot.ID == "" {
+		snapshot.ID = newID()
+	}
+	if snapshot.Status == "" {
+		snapshot.Status = "available"
+	}
+	c.snapshots[snapshot.ID] = &snapshot
+	return snapshot
+}
+
+// DeleteSnapshot removes a volume snapshot
+func (c *Cloud) DeleteSnapshot(id string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if _, ok := c.snapshots[id]; !ok {
+		return fmt.Errorf("snapshot %s not found", id)
+	}
+	delete(c.snapshots, id)
+	return nil
+}
+
+// LoadBalancers returns all load balancers of a project
+func (c *Cloud) LoadBalancers(projectID string) []LoadBalancer {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	result := []LoadBalancer{}
+	for _, lb := range c.loadBalancers {
+		if lb.ProjectID == projectID {
+			result = append(result, *lb)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// AddLoadBalancer adds an active load balancer together with its vip port
+func (c *Cloud) AddLoadBalancer(lb LoadBalancer) LoadBalancer {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if lb.ID == "" {
+		lb.ID = newID()
+	}
+	if lb.Status == "" {
+		lb.Status = "ACTIVE"
+	}
+	if lb.VipAddress == "" {
+		lb.VipAddress = c.allocateIP()
+	}
+	port := &Port{
+		ID:          newID(),
+		ProjectID:   lb.ProjectID,
+		Name:        "octavia-lb-" + lb.ID,
+		DeviceID:    "lb-" + lb.ID,
+		DeviceOwner: "Octavia",
+		Address:     lb.VipAddress,
+	}
+	c.ports[port.ID] = port
+	lb.VipPortID = port.ID
+	c.loadBalancers[lb.ID] = &lb
+	return lb
+}
+
+// DeleteLoadBalancer removes a load balancer and its vip port. Floating ips
+// associated with the vip port are disassociated.
+func (c *Cloud) DeleteLoadBalancer(id string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	lb, ok := c.loadBalancers[id]
+	if !ok {
+		return fmt.Errorf("load balancer %s not found", id)
+	}
+	if lb.Status != "ACTIVE" && lb.Status != "ERROR" {
+		return fmt.Errorf("load balancer %s is immutable in status %s", id, lb.Status)
+	}
+	for _, fip := range c.floatingIPs {
+		if fip.PortID == lb.VipPortID {
+			fip.PortID = ""
+		}
+	}
+	delete(c.ports, lb.VipPortID)
+	delete(c.loadBalancers, id)
+	return nil
+}
+
+// FloatingIPs returns all floating ips of a project
+func (c *Cloud) FloatingIPs(projectID string) []FloatingIP {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	result := []FloatingIP{}
+	for _, fip := range c.floatingIPs {
+		if fip.ProjectID == projectID {
+			result = append(result, *fip)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Address < result[j].Address })
+	return result
+}
+
+// AddFloatingIP adds a floating ip
+func (c *Cloud) AddFloatingIP(fip FloatingIP) FloatingIP {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if fip.ID == "" {
+		fip.ID = newID()
+	}
+	if fip.Address == "" {
+		c.nextFloatingIP++
+		fip.Address = fmt.Sprintf("172.24.%d.%d", c.nextFloatingIP/256, c.nextFloatingIP%256)
+	}
+	c.floatingIPs[fip.ID] = &fip
+	return fip
+}
+
+// DeleteFloatingIP removes a floating ip
+func (c *Cloud) DeleteFloatingIP(id string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if _, ok := c.floatingIPs[id]; !ok {
+		return fmt.Errorf("floating ip %s not found", id)
+	}
+	delete(c.floatingIPs, id)
+	return nil
+}
+
+// Containers returns all swift containers of a project
+func (c *Cloud) Containers(projectID string) []Container {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	result := []Container{}
+	for _, ct := range c.containers {
+		if ct.ProjectID == projectID {
+			result = append(result, *ct)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// Users returns all users of a domain
+func (c *Cloud) Users(domainName string) []User {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	result := []User{}
+	domainID := c.domains[domainName]
+	for _, u := range c.users {
+		if u.DomainID == domainID {
+			result = append(result, *u)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+func (c *Cloud) userByName(name, domainID string) *User {
+	for _, u := range c.users {
+		if u.Name == name && u.DomainID == domainID {
+			return u
+		}
+	}
+	return nil
+}
+
+func (c *Cloud) securityGroupByName(projectID, name string) *SecurityGroup {
+	for _, sg := range c.securityGroups {
+		if sg.ProjectID == projectID && sg.Name == name {
+			return sg
+		}
+	}
+	return nil
+}
+
+func (c *Cloud) serverGroupByName(projectID, name string) *ServerGroup {
+	for _, sg := range c.serverGroups {
+		if sg.ProjectID == projectID && sg.Name == name {
+			return sg
+		}
+	}
+	return nil
+}
+
+func (c *Cloud) allocateIP() string {
+	ip := make(net.IP, len(c.nextIP))
+	copy(ip, c.nextIP)
+	for i := len(ip) - 1; i >= 0; i-- {
+		ip[i]++
+		if ip[i] != 0 {
+			break
+		}
+	}
+	c.nextIP = ip
+	return ip.String()
+}
+
+func copyRouter(r *Router) Router {
+	result := *r
+	result.Routes = append([]Route{}, r.Routes...)
+	result.Networks = append([]Network{}, r.Networks...)
+	return result
+}
@@ -0,0 +1,64 @@
+package fake
+
+import (
+	"fmt"
+
+	"github.com/go-kit/log"
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+
+	kubernikus_v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	"github.com/sapcc/kubernikus/pkg/client/openstack"
+	"github.com/sapcc/kubernikus/pkg/client/openstack/admin"
+	openstack_kluster "github.com/sapcc/kubernikus/pkg/client/openstack/kluster"
+	openstack_project "github.com/sapcc/kubernikus/pkg/client/openstack/project"
+)
+
+var _ openstack.SharedOpenstackClientFactory = &Factory{}
+
+// Factory hands out clients operating on an in-memory Cloud.
+// Klusters are mapped to projects using their account label.
+type Factory struct {
+	Cloud *Cloud
+}
+
+// NewFactory returns a factory backed by the given cloud
+func NewFactory(cloud *Cloud) *Factory {
+	return &Factory{Cloud: cloud}
+}
+
+func (f *Factory) KlusterClientFor(kluster *kubernikus_v1.Kluster) (openstack_kluster.KlusterClient, error) {
+	if _, ok := f.Cloud.Project(kluster.Account()); !ok {
+		return nil, fmt.Errorf("project %s not found", kluster.Account())
+	}
+	return &KlusterClient{cloud: f.Cloud, projectID: kluster.Account()}, nil
+}
+
+func (f *Factory) ProjectClientFor(authOptions *tokens.AuthOptions) (openstack_project.ProjectClient, error) {
+	if authOptions.Scope.ProjectID == "" {
+		return nil, fmt.Errorf("AuthOptions must be scoped to a projectID")
+	}
+	return f.ProjectAdminClientFor(authOptions.Scope.ProjectID)
+}
+
+func (f *Factory) ProjectAdminClientFor(projectID string) (openstack_project.ProjectClient, error) {
+	if _, ok := f.Cloud.Project(projectID); !ok {
+		return nil, fmt.Errorf("project %s not found", projectID)
+	}
+	return &ProjectClient{cloud: f.Cloud, projectID: projectID}, nil
+}
+
+func (f *Factory) ProviderClientFor(authOptions *tokens.AuthOptions, logger log.Logger) (*gophercloud.ProviderClient, error) {
+	if _, ok := f.Cloud.Project(authOptions.Scope.ProjectID); !ok {
+		return nil, fmt.Errorf("project %s not found", authOptions.Scope.ProjectID)
+	}
+	return f.Cloud.ProviderClient(authOptions.Scope.ProjectID), nil
+}
+
+func (f *Factory) ProviderClientForKluster(kluster *kubernikus_v1.Kluster, logger log.Logger) (*gophercloud.ProviderClient, error) {
+	return f.ProviderClientFor(&tokens.AuthOptions{Scope: tokens.Scope{ProjectID: kluster.Account()}}, logger)
+}
+
+func (f *Factory) AdminClient() (admin.AdminClient, error) {
+	return &AdminClient{cloud: f.Cloud}, nil
+}
@@ -0,0 +1,123 @@
+package fake
+
+import (
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+)
+
+func newKluster() *v1.Kluster {
+	clusterCIDR := "100.100.0.0/16"
+	return &v1.Kluster{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "test", Labels: map[string]string{"account": "project"}},
+		Spec: models.KlusterSpec{
+			Name:        "test",
+			Version:     "1.30.1",
+			ClusterCIDR: &clusterCIDR,
+			Openstack:   models.OpenstackSpec{SecurityGroupName: "default"},
+		},
+	}
+}
+
+func TestKlusterClient(t *testing.T) {
+	cloud := NewCloud()
+	cloud.AddProject("project", "project", DefaultDomain)
+	kluster := newKluster()
+	pool := &models.NodePool{Name: "pool", Flavor: "m1.small", Image: "flatcar-stable-amd64", CustomRootDiskSize: 64}
+
+	client, err := NewFactory(cloud).KlusterClientFor(kluster)
+	require.NoError(t, err)
+
+	_, err = client.CreateNode(kluster, &models.NodePool{Name: "pool", Flavor: "unknown", Image: pool.Image}, "kks-test-pool-aaaaa", nil)
+	assert.Error(t, err)
+
+	id, err := client.CreateNode(kluster, pool, "kks-test-pool-abcde", []byte("userdata"))
+	require.NoError(t, err)
+
+	nodes, err := client.ListNodes(kluster, pool)
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	assert.Equal(t, id, nodes[0].ID)
+	assert.True(t, nodes[0].Starting())
+	assert.Contains(t, *nodes[0].Tags, "kubernikus:nodepool=pool")
+	assert.Len(t, cloud.Volumes("project"), 1)
+
+	created, err := client.EnsureKubernikusRuleInSecurityGroup(kluster)
+	require.NoError(t, err)
+	assert.True(t, created)
+	created, err = client.EnsureKubernikusRuleInSecurityGroup(kluster)
+	require.NoError(t, err)
+	assert.False(t, created)
+
+	require.NoError(t, client.DeleteNode(id))
+	nodes, err = client.ListNodes(kluster, pool)
+	require.NoError(t, err)
+	assert.Empty(t, nodes)
+	assert.Empty(t, cloud.Volumes("project"), "root disk should be deleted with the server")
+	assert.Empty(t, cloud.Ports("project"))
+}
+
+func TestAdminClient(t *testing.T) {
+	cloud := NewCloud()
+	cloud.AddProject("project", "project", "domain")
+	client, err := NewFactory(cloud).AdminClient()
+	require.NoError(t, err)
+
+	require.NoError(t, client.CreateKlusterServiceUser("kubernikus-test", "secret", "Default", "project"))
+	roles, err := client.GetUserRoles("project", "kubernikus-test", "Default")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"member", "network_admin"}, roles)
+
+	meta, err := client.GetStorageContainerMeta("project", "", "etcd-backup")
+	require.NoError(t, err)
+	assert.Nil(t, meta)
+	require.NoError(t, client.CreateStorageContainer("project", "", "etcd-backup", "kubernikus-test", "Default"))
+	meta, err = client.GetStorageContainerMeta("project", "", "etcd-backup")
+	require.NoError(t, err)
+	require.NotNil(t, meta)
+	assert.Len(t, meta.ReadACL, 1)
+
+	domain, err := client.GetDomainNameByProject("project")
+	require.NoError(t, err)
+	assert.Equal(t, "domain", domain)
+
+	require.NoError(t, client.DeleteUser("kubernikus-test", "Default"))
+	assert.Empty(t, cloud.Users("Default"))
+}
+
+func TestProviderClient(t *testing.T) {
+	cloud := NewCloud()
+	defer cloud.Close()
+	cloud.AddProject("project", "project", DefaultDomain)
+	cloud.AddProject("other", "other", DefaultDomain)
+	server := cloud.AddServer(Server{ProjectID: "project", Name: "server", Tags: []string{"kubernikus"}})
+	cloud.AddServer(Server{ProjectID: "other", Name: "foreign"})
+	volume := cloud.AddVolume(Volume{ProjectID: "project", Name: "pvc"})
+
+	provider, err := NewFactory(cloud).ProviderClientForKluster(newKluster(), log.NewNopLogger())
+	require.NoError(t, err)
+
+	compute, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{})
+	require.NoError(t, err)
+	pages, err := servers.List(compute, servers.ListOpts{}).AllPages()
+	require.NoError(t, err)
+	list, err := servers.ExtractServers(pages)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, server.ID, list[0].ID)
+
+	blockstorage, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	require.NoError(t, err)
+	require.NoError(t, volumes.Delete(blockstorage, volume.ID, volumes.DeleteOpts{}).ExtractErr())
+	assert.Empty(t, cloud.Volumes("project"))
+}
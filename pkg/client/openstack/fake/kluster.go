@@ -0,0 +1,294 @@
+package fake
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/extendedstatus"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	openstack_kluster "github.com/sapcc/kubernikus/pkg/client/openstack/kluster"
+	"github.com/sapcc/kubernikus/pkg/templates"
+	"github.com/sapcc/kubernikus/pkg/util"
+)
+
+var _ openstack_kluster.KlusterClient = &KlusterClient{}
+
+// KlusterClient is an in-memory KlusterClient scoped to a project
+type KlusterClient struct {
+	cloud     *Cloud
+	projectID string
+}
+
+func (c *KlusterClient) CreateNode(kluster *v1.Kluster, pool *models.NodePool, name string, userData []byte) (string, error) {
+	c.cloud.lock.RLock()
+	flavorFound := false
+	for _, flavor := range c.cloud.Flavors {
+		if flavor.Name == pool.Flavor {
+			flavorFound = true
+		}
+	}
+	imageFound := false
+	for _, image := range c.cloud.Images {
+		if image == pool.Image {
+			imageFound = true
+		}
+	}
+	c.cloud.lock.RUnlock()
+
+	if !flavorFound {
+		return "", fmt.Errorf("failed to find id for flavor %s: resource not found", pool.Flavor)
+	}
+	if !imageFound {
+		return "", fmt.Errorf("failed to find id for image %s: resource not found", pool.Image)
+	}
+
+	tags := nodeTags(kluster.Spec.Name, pool.Name)
+	tags = append(tags, "kubernikus:template-version="+templates.TEMPLATE_VERSION)
+	tags = append(tags, "kubernikus:api-version="+kluster.Spec.Version)
+	metadata := nodeMetadata(kluster.Spec.Name, pool.Name)
+	metadata["kubernikus:template-version"] = templates.TEMPLATE_VERSION
+	metadata["kubernikus:api-version"] = kluster.Spec.Version
+
+	server := c.cloud.AddServer(Server{
+		ProjectID:      c.projectID,
+		Name:           name,
+		Flavor:         pool.Flavor,
+		Image:          pool.Image,
+		Zone:           pool.AvailabilityZone,
+		NetworkID:      kluster.Spec.Openstack.NetworkID,
+		SecurityGroups: []string{kluster.Spec.Openstack.SecurityGroupName},
+		Metadata:       metadata,
+		Tags:           tags,
+		UserData:       userData,
+		VMState:        "building",
+		TaskState:      "spawning",
+	})
+
+	if pool.CustomRootDiskSize > 0 {
+		c.cloud.AddVolume(Volume{
+			ProjectID:           c.projectID,
+			Name:                name + "-root",
+			Size:                int(pool.CustomRootDiskSize),
+			AttachedTo:          server.ID,
+			DeleteOnTermination: true,
+		})
+	}
+
+	return server.ID, nil
+}
+
+func (c *KlusterClient) DeleteNode(id string) error {
+	if err := c.ownServer(id); err != nil {
+		return err
+	}
+	return c.cloud.DeleteServer(id)
+}
+
+func (c *KlusterClient) RebootNode(id string) error {
+	if err := c.ownServer(id); err != nil {
+		return err
+	}
+	c.cloud.lock.Lock()
+	defer c.cloud.lock.Unlock()
+	c.cloud.servers[id].Reboots++
+	return nil
+}
+
+func (c *KlusterClient) ListNodes(k *v1.Kluster, pool *models.NodePool) ([]openstack_kluster.Node, error) {
+	var nodes []openstack_kluster.Node
+	for _, server := range c.cloud.Servers(c.projectID) {
+		if util.IsKubernikusNode(server.Name, k.Spec.Name, pool.Name) {
+			nodes = append(nodes, server.Node())
+		}
+	}
+	return nodes, nil
+}
+
+func (c *KlusterClient) SetSecurityGroup(sgName, nodeID string) error {
+	c.cloud.lock.Lock()
+	defer c.cloud.lock.Unlock()
+	server, ok := c.cloud.servers[nodeID]
+	if !ok || server.ProjectID != c.projectID {
+		return fmt.Errorf("server %s not found", nodeID)
+	}
+	if c.cloud.securityGroupByName(c.projectID, sgName) == nil {
+		return fmt.Errorf("security group %s not found", sgName)
+	}
+	for _, name := range server.SecurityGroups {
+		if name == sgName {
+			return nil
+		}
+	}
+	server.SecurityGroups = append(server.SecurityGroups, sgName)
+	return nil
+}
+
+func (c *KlusterClient) EnsureKubernikusRuleInSecurityGroup(kluster *v1.Kluster) (bool, error) {
+	if kluster.ClusterCIDR() == "" {
+		return false, fmt.Errorf("cluster CIDR for kluster not set")
+	}
+
+	c.cloud.lock.Lock()
+	defer c.cloud.lock.Unlock()
+	sg := c.cloud.securityGroupByName(c.projectID, kluster.Spec.Openstack.SecurityGroupName)
+	if sg == nil {
+		return false, fmt.Errorf("security group %v not found", kluster.Spec.Openstack.SecurityGroupName)
+	}
+
+	found := map[string]bool{}
+	for _, rule := range sg.Rules {
+		if rule.Direction == "ingress" && rule.EtherType == "IPv4" && rule.RemoteIPPrefix == kluster.ClusterCIDR() {
+			found[rule.Protocol] = true
+		}
+	}
+
+	created := false
+	for _, protocol := range []string{"udp", "tcp", "icmp"} {
+		if found[protocol] {
+			continue
+		}
+		sg.Rules = append(sg.Rules, SecurityGroupRule{
+			ID:             newID(),
+			Direction:      "ingress",
+			EtherType:      "IPv4",
+			Protocol:       protocol,
+			RemoteIPPrefix: kluster.ClusterCIDR(),
+		})
+		created = true
+	}
+	return created, nil
+}
+
+func (c *KlusterClient) EnsureServerGroup(name string) (string, error) {
+	c.cloud.lock.Lock()
+	defer c.cloud.lock.Unlock()
+	if sg := c.cloud.serverGroupByName(c.projectID, name); sg != nil {
+		return sg.ID, nil
+	}
+	sg := &ServerGroup{ID: newID(), ProjectID: c.projectID, Name: name, Policies: []string{"soft-affinity"}}
+	c.cloud.serverGroups[sg.ID] = sg
+	return sg.ID, nil
+}
+
+func (c *KlusterClient) DeleteServerGroup(name string) error {
+	c.cloud.lock.Lock()
+	defer c.cloud.lock.Unlock()
+	if sg := c.cloud.serverGroupByName(c.projectID, name); sg != nil {
+		delete(c.cloud.serverGroups, sg.ID)
+	}
+	return nil
+}
+
+func (c *KlusterClient) EnsureNodeTags(node openstack_kluster.Node, klusterName, poolName string) ([]string, error) {
+	c.cloud.lock.Lock()
+	defer c.cloud.lock.Unlock()
+	server, ok := c.cloud.servers[node.ID]
+	if !ok {
+		return nil, fmt.Errorf("server %s not found", node.ID)
+	}
+	existing := map[string]bool{}
+	for _, tag := range server.Tags {
+		existing[tag] = true
+	}
+	added := []string{}
+	for _, tag := range nodeTags(klusterName, poolName) {
+		if !existing[tag] {
+			server.Tags = append(server.Tags, tag)
+			added = append(added, tag)
+		}
+	}
+	return added, nil
+}
+
+func (c *KlusterClient) EnsureMetadata(node openstack_kluster.Node, klusterName, poolName string) (map[string]string, error) {
+	c.cloud.lock.Lock()
+	defer c.cloud.lock.Unlock()
+	server, ok := c.cloud.servers[node.ID]
+	if !ok {
+		return nil, fmt.Errorf("server %s not found", node.ID)
+	}
+	metadata := nodeMetadata(klusterName, poolName)
+	for k, v := range metadata {
+		if server.Metadata[k] == v {
+			delete(metadata, k)
+		}
+	}
+	if len(metadata) == 0 {
+		return nil, nil
+	}
+	for k, v := range metadata {
+		server.Metadata[k] = v
+	}
+	result := make(map[string]string, len(server.Metadata))
+	for k, v := range server.Metadata {
+		result[k] = v
+	}
+	return result, nil
+}
+
+func (c *KlusterClient) ownServer(id string) error {
+	server, ok := c.cloud.Server(id)
+	if !ok || server.ProjectID != c.projectID {
+		return fmt.Errorf("server %s not found", id)
+	}
+	return nil
+}
+
+// Node converts the server into the representation returned by the KlusterClient
+func (s Server) Node() openstack_kluster.Node {
+	tags := append([]string{}, s.Tags...)
+	metadata := make(map[string]string, len(s.Metadata))
+	for k, v := range s.Metadata {
+		metadata[k] = v
+	}
+	securityGroups := make([]map[string]interface{}, 0, len(s.SecurityGroups))
+	for _, name := range s.SecurityGroups {
+		securityGroups = append(securityGroups, map[string]interface{}{"name": name})
+	}
+	status := "ACTIVE"
+	if s.VMState == "building" {
+		status = "BUILD"
+	}
+	return openstack_kluster.Node{
+		Server: servers.Server{
+			ID:       s.ID,
+			TenantID: s.ProjectID,
+			Name:     s.Name,
+			Created:  s.Created,
+			Updated:  s.Created,
+			Status:   status,
+			Flavor:   map[string]interface{}{"original_name": s.Flavor},
+			Addresses: map[string]interface{}{
+				"default": []interface{}{
+					map[string]interface{}{"addr": s.Address, "version": float64(4)},
+				},
+			},
+			Metadata:       metadata,
+			SecurityGroups: securityGroups,
+			Tags:           &tags,
+		},
+		ServerExtendedStatusExt: extendedstatus.ServerExtendedStatusExt{
+			TaskState:  s.TaskState,
+			VmState:    s.VMState,
+			PowerState: extendedstatus.PowerState(s.PowerState),
+		},
+	}
+}
+
+func nodeTags(kluster, pool string) []string {
+	return []string{
+		"kubernikus",
+		"kubernikus:kluster=" + kluster,
+		"kubernikus:nodepool=" + pool,
+	}
+}
+
+func nodeMetadata(kluster, pool string) map[string]string {
+	return map[string]string{
+		"provisioner":         "kubernikus",
+		"kubernikus:nodepool": pool,
+		"kubernikus:kluster":  kluster,
+	}
+}
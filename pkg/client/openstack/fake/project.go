@@ -0,0 +1,67 @@
+package fake
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	openstack_project "github.com/sapcc/kubernikus/pkg/client/openstack/project"
+)
+
+var _ openstack_project.ProjectClient = &ProjectClient{}
+
+// ProjectClient is an in-memory ProjectClient scoped to a project
+type ProjectClient struct {
+	cloud     *Cloud
+	projectID string
+}
+
+func (c *ProjectClient) GetMetadata() (*models.OpenstackMetadata, error) {
+	c.cloud.lock.RLock()
+	defer c.cloud.lock.RUnlock()
+
+	if _, ok := c.cloud.projects[c.projectID]; !ok {
+		return nil, fmt.Errorf("project %s not found", c.projectID)
+	}
+
+	metadata := &models.OpenstackMetadata{
+		Flavors:           append([]models.Flavor{}, c.cloud.Flavors...),
+		KeyPairs:          make([]*models.KeyPair, 0),
+		Routers:           make([]*models.Router, 0),
+		SecurityGroups:    make([]*models.SecurityGroup, 0),
+		AvailabilityZones: make([]models.AvailabilityZone, 0, len(c.cloud.AvailabilityZones)),
+		VolumeTypes:       make([]models.VolumeType, 0, len(c.cloud.VolumeTypes)),
+	}
+
+	for _, router := range c.cloud.routers {
+		if router.ProjectID != c.projectID {
+			continue
+		}
+		r := &models.Router{ID: router.ID, Name: router.Name, ExternalNetworkID: router.ExternalNetworkID}
+		for _, network := range router.Networks {
+			n := &models.Network{ID: network.ID, Name: network.Name}
+			for _, subnet := range network.Subnets {
+				n.Subnets = append(n.Subnets, &models.Subnet{ID: subnet.ID, Name: subnet.Name, CIDR: subnet.CIDR})
+			}
+			r.Networks = append(r.Networks, n)
+		}
+		metadata.Routers = append(metadata.Routers, r)
+	}
+	sort.Slice(metadata.Routers, func(i, j int) bool { return metadata.Routers[i].Name < metadata.Routers[j].Name })
+
+	for _, sg := range c.cloud.securityGroups {
+		if sg.ProjectID == c.projectID {
+			metadata.SecurityGroups = append(metadata.SecurityGroups, &models.SecurityGroup{ID: sg.ID, Name: sg.Name})
+		}
+	}
+	sort.Slice(metadata.SecurityGroups, func(i, j int) bool { return metadata.SecurityGroups[i].Name < metadata.SecurityGroups[j].Name })
+
+	for _, zone := range c.cloud.AvailabilityZones {
+		metadata.AvailabilityZones = append(metadata.AvailabilityZones, models.AvailabilityZone{Name: zone})
+	}
+	for _, volumeType := range c.cloud.VolumeTypes {
+		metadata.VolumeTypes = append(metadata.VolumeTypes, models.VolumeType{ID: volumeType, Name: volumeType})
+	}
+
+	return metadata, nil
+}
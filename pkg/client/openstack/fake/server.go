@@ -0,0 +1,415 @@
+package fake
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/snapshots"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+)
+
+// ProviderClient returns a gophercloud client scoped to the given project.
+//
+// Requests are served by an embedded http server exposing a subset of the
+// compute, network, load balancer and block storage APIs backed by the cloud's state. The
+// project is carried in the token so no keystone roundtrip is needed.
+func (c *Cloud) ProviderClient(projectID string) *gophercloud.ProviderClient {
+	base := c.URL()
+	provider := &gophercloud.ProviderClient{
+		IdentityBase:     base + "/identity/",
+		IdentityEndpoint: base + "/identity/v3/",
+	}
+	provider.SetToken(projectID)
+	provider.EndpointLocator = func(opts gophercloud.EndpointOpts) (string, error) {
+		switch opts.Type {
+		case "compute":
+			return base + "/compute/", nil
+		case "network":
+			return base + "/network/", nil
+		case "load-balancer":
+			return base + "/load-balancer/", nil
+		case "volumev3", "block-storage":
+			return base + "/volume/", nil
+		case "identity":
+			return base + "/identity/v3/", nil
+		}
+		return "", &gophercloud.ErrEndpointNotFound{}
+	}
+	return provider
+}
+
+// URL returns the address of the embedded http server, starting it if needed
+func (c *Cloud) URL() string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.server == nil {
+		c.server = httptest.NewServer(http.HandlerFunc(c.serveHTTP))
+	}
+	return c.server.URL
+}
+
+// Close stops the embedded http server
+func (c *Cloud) Close() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.server != nil {
+		c.server.Close()
+		c.server = nil
+	}
+}
+
+func (c *Cloud) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	projectID := r.Header.Get("X-Auth-Token")
+	if _, ok := c.Project(projectID); !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+
+	switch {
+	case match(parts, "compute", "servers", "detail") && r.Method == http.MethodGet:
+		c.listServers(w, r, projectID)
+	case match(parts, "compute", "servers", "*") && r.Method == http.MethodGet:
+		if server, ok := c.Server(parts[2]); ok && server.ProjectID == projectID {
+			writeJSON(w, http.StatusOK, map[string]interface{}{"server": server.Node()})
+			return
+		}
+		http.NotFound(w, r)
+	case match(parts, "compute", "servers", "*") && r.Method == http.MethodDelete:
+		if server, ok := c.Server(parts[2]); ok && server.ProjectID == projectID {
+			c.DeleteServer(server.ID)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		http.NotFound(w, r)
+	case match(parts, "compute", "os-server-groups") && r.Method == http.MethodGet:
+		result := []servergroups.ServerGroup{}
+		for _, sg := range c.ServerGroups(projectID) {
+			result = append(result, servergroups.ServerGroup{ID: sg.ID, Name: sg.Name, Policies: sg.Policies})
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"server_groups": result})
+	case match(parts, "network", "v2.0", "routers") && r.Method == http.MethodGet:
+		result := []routers.Router{}
+		for _, router := range c.Routers(projectID) {
+			result = append(result, router.gophercloud())
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"routers": result})
+	case match(parts, "network", "v2.0", "routers", "*") && r.Method == http.MethodGet:
+		if router, ok := c.Router(parts[3]); ok && router.ProjectID == projectID {
+			writeJSON(w, http.StatusOK, map[string]interface{}{"router": router.gophercloud()})
+			return
+		}
+		http.NotFound(w, r)
+	case match(parts, "network", "v2.0", "routers", "*") && r.Method == http.MethodPut:
+		c.updateRouter(w, r, projectID, parts[3])
+	case match(parts, "network", "v2.0", "ports") && r.Method == http.MethodGet:
+		c.listPorts(w, r, projectID)
+	case match(parts, "network", "v2.0", "ports", "*") && r.Method == http.MethodDelete:
+		c.deleteOwned(w, r, c.DeletePort, c.portProject(parts[3]) == projectID)
+	case match(parts, "network", "v2.0", "ports", "*", "tags", "*") && r.Method == http.MethodPut:
+		if c.portProject(parts[3]) != projectID {
+			http.NotFound(w, r)
+			return
+		}
+		c.TagPort(parts[3], parts[5])
+		w.WriteHeader(http.StatusCreated)
+	case match(parts, "network", "v2.0", "floatingips") && r.Method == http.MethodGet:
+		c.listFloatingIPs(w, r, projectID)
+	case match(parts, "network", "v2.0", "floatingips", "*") && r.Method == http.MethodDelete:
+		c.deleteOwned(w, r, c.DeleteFloatingIP, c.floatingIPProject(parts[3]) == projectID)
+	case match(parts, "load-balancer", "v2.0", "lbaas", "loadbalancers") && r.Method == http.MethodGet:
+		result := []loadbalancers.LoadBalancer{}
+		for _, lb := range c.LoadBalancers(projectID) {
+			if name := r.URL.Query().Get("name"); name != "" && name != lb.Name {
+				continue
+			}
+			result = append(result, lb.gophercloud())
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"loadbalancers": result})
+	case match(parts, "load-balancer", "v2.0", "lbaas", "loadbalancers", "*") && r.Method == http.MethodDelete:
+		c.deleteOwned(w, r, c.DeleteLoadBalancer, c.loadBalancerProject(parts[4]) == projectID)
+	case match(parts, "volume", "volumes", "detail") && r.Method == http.MethodGet:
+		result := []volumes.Volume{}
+		for _, volume := range c.Volumes(projectID) {
+			result = append(result, volume.gophercloud())
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"volumes": result})
+	case match(parts, "volume", "volumes", "*") && r.Method == http.MethodPut:
+		c.updateVolume(w, r, projectID, parts[2])
+	case match(parts, "volume", "volumes", "*") && r.Method == http.MethodDelete:
+		c.deleteOwned(w, r, c.DeleteVolume, c.volumeProject(parts[2]) == projectID)
+	case match(parts, "volume", "snapshots") && r.Method == http.MethodGet:
+		result := []snapshots.Snapshot{}
+		for _, snapshot := range c.Snapshots(projectID) {
+			result = append(result, snapshots.Snapshot{ID: snapshot.ID, Name: snapshot.Name, VolumeID: snapshot.VolumeID, Status: snapshot.Status, Metadata: snapshot.Metadata})
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"snapshots": result})
+	case match(parts, "volume", "snapshots", "*") && r.Method == http.MethodDelete:
+		c.deleteOwned(w, r, c.DeleteSnapshot, c.snapshotProject(parts[2]) == projectID)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (c *Cloud) listServers(w http.ResponseWriter, r *http.Request, projectID string) {
+	var wantTags []string
+	if tags := r.URL.Query().Get("tags"); tags != "" {
+		wantTags = strings.Split(tags, ",")
+	}
+	name := r.URL.Query().Get("name")
+
+	result := []interface{}{}
+	for _, server := range c.Servers(projectID) {
+		if name != "" && !strings.Contains(server.Name, name) {
+			continue
+		}
+		matches := true
+		for _, tag := range wantTags {
+			matches = matches && contains(server.Tags, tag)
+		}
+		if matches {
+			result = append(result, server.Node())
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"servers": result})
+}
+
+func (c *Cloud) updateRouter(w http.ResponseWriter, r *http.Request, projectID, routerID string) {
+	router, ok := c.Router(routerID)
+	if !ok || router.ProjectID != projectID {
+		http.NotFound(w, r)
+		return
+	}
+	var body struct {
+		Router struct {
+			Routes *[]routers.Route `json:"routes"`
+		} `json:"router"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if body.Router.Routes != nil {
+		routes := make([]Route, 0, len(*body.Router.Routes))
+		for _, route := range *body.Router.Routes {
+			routes = append(routes, Route{DestinationCIDR: route.DestinationCIDR, NextHop: route.NextHop})
+		}
+		c.SetRoutes(routerID, routes)
+	}
+	router, _ = c.Router(routerID)
+	writeJSON(w, http.StatusOK, map[string]interface{}{"router": router.gophercloud()})
+}
+
+func (c *Cloud) listPorts(w http.ResponseWriter, r *http.Request, projectID string) {
+	query := r.URL.Query()
+	result := []ports.Port{}
+	for _, port := range c.Ports(projectID) {
+		if v := query.Get("device_id"); v != "" && v != port.DeviceID {
+			continue
+		}
+		if v := query.Get("device_owner"); v != "" && v != port.DeviceOwner {
+			continue
+		}
+		if v := query.Get("network_id"); v != "" && v != port.NetworkID {
+			continue
+		}
+		if v := query.Get("tags"); v != "" && !containsAll(port.Tags, strings.Split(v, ",")) {
+			continue
+		}
+		result = append(result, ports.Port{
+			ID:          port.ID,
+			Name:        port.Name,
+			NetworkID:   port.NetworkID,
+			TenantID:    port.ProjectID,
+			ProjectID:   port.ProjectID,
+			DeviceID:    port.DeviceID,
+			DeviceOwner: port.DeviceOwner,
+			FixedIPs:    []ports.IP{{IPAddress: port.Address}},
+			Tags:        port.Tags,
+			Status:      "ACTIVE",
+		})
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"ports": result})
+}
+
+func (c *Cloud) updateVolume(w http.ResponseWriter, r *http.Request, projectID, volumeID string) {
+	if c.volumeProject(volumeID) != projectID {
+		http.NotFound(w, r)
+		return
+	}
+	var body struct {
+		Volume struct {
+			Metadata map[string]string `json:"metadata"`
+		} `json:"volume"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if body.Volume.Metadata != nil {
+		c.SetVolumeMetadata(volumeID, body.Volume.Metadata)
+	}
+	for _, volume := range c.Volumes(projectID) {
+		if volume.ID == volumeID {
+			writeJSON(w, http.StatusOK, map[string]interface{}{"volume": volume.gophercloud()})
+			return
+		}
+	}
+	http.NotFound(w, r)
+}
+
+func (c *Cloud) listFloatingIPs(w http.ResponseWriter, r *http.Request, projectID string) {
+	query := r.URL.Query()
+	result := []floatingips.FloatingIP{}
+	for _, fip := range c.FloatingIPs(projectID) {
+		if v := query.Get("port_id"); v != "" && v != fip.PortID {
+			continue
+		}
+		if v := query.Get("description"); v != "" && v != fip.Description {
+			continue
+		}
+		status := "DOWN"
+		if fip.PortID != "" {
+			status = "ACTIVE"
+		}
+		result = append(result, floatingips.FloatingIP{
+			ID:          fip.ID,
+			Description: fip.Description,
+			FloatingIP:  fip.Address,
+			PortID:      fip.PortID,
+			TenantID:    fip.ProjectID,
+			ProjectID:   fip.ProjectID,
+			Status:      status,
+		})
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"floatingips": result})
+}
+
+func (c *Cloud) deleteOwned(w http.ResponseWriter, r *http.Request, del func(string) error, owned bool) {
+	if !owned {
+		http.NotFound(w, r)
+		return
+	}
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if err := del(parts[len(parts)-1]); err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c *Cloud) portProject(id string) string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if p, ok := c.ports[id]; ok {
+		return p.ProjectID
+	}
+	return ""
+}
+
+func (c *Cloud) floatingIPProject(id string) string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if f, ok := c.floatingIPs[id]; ok {
+		return f.ProjectID
+	}
+	return ""
+}
+
+func (c *Cloud) loadBalancerProject(id string) string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if lb, ok := c.loadBalancers[id]; ok {
+		return lb.ProjectID
+	}
+	return ""
+}
+
+func (c *Cloud) volumeProject(id string) string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if v, ok := c.volumes[id]; ok {
+		return v.ProjectID
+	}
+	return ""
+}
+
+func (c *Cloud) snapshotProject(id string) string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if s, ok := c.snapshots[id]; ok {
+		return s.ProjectID
+	}
+	return ""
+}
+
+func (r Router) gophercloud() routers.Router {
+	routes := make([]routers.Route, 0, len(r.Routes))
+	for _, route := range r.Routes {
+		routes = append(routes, routers.Route{DestinationCIDR: route.DestinationCIDR, NextHop: route.NextHop})
+	}
+	return routers.Router{
+		ID:          r.ID,
+		Name:        r.Name,
+		TenantID:    r.ProjectID,
+		ProjectID:   r.ProjectID,
+		Status:      "ACTIVE",
+		GatewayInfo: routers.GatewayInfo{NetworkID: r.ExternalNetworkID},
+		Routes:      routes,
+	}
+}
+
+func (lb LoadBalancer) gophercloud() loadbalancers.LoadBalancer {
+	return loadbalancers.LoadBalancer{
+		ID:                 lb.ID,
+		ProjectID:          lb.ProjectID,
+		Name:               lb.Name,
+		Tags:               lb.Tags,
+		VipPortID:          lb.VipPortID,
+		VipAddress:         lb.VipAddress,
+		ProvisioningStatus: lb.Status,
+		OperatingStatus:    "ONLINE",
+	}
+}
+
+func (v Volume) gophercloud() volumes.Volume {
+	volume := volumes.Volume{
+		ID:       v.ID,
+		Name:     v.Name,
+		Size:     v.Size,
+		Status:   v.Status,
+		Bootable: strconv.FormatBool(v.Bootable),
+		Metadata: v.Metadata,
+	}
+	if v.AttachedTo != "" {
+		volume.Attachments = []volumes.Attachment{{ServerID: v.AttachedTo, VolumeID: v.ID}}
+	}
+	return volume
+}
+
+func match(parts []string, pattern ...string) bool {
+	if len(parts) != len(pattern) {
+		return false
+	}
+	for i := range pattern {
+		if pattern[i] != "*" && pattern[i] != parts[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,337 @@
+// Package inventory finds the OpenStack resources in a project that were
+// created for a kluster, either by Kubernikus itself or by the cloud
+// controller manager and the Cinder CSI driver running for the kluster.
+package inventory
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/snapshots"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	"github.com/sapcc/kubernikus/pkg/client/openstack/admin"
+	etcd_util "github.com/sapcc/kubernikus/pkg/util/etcd"
+)
+
+const (
+	// The openstack cloud controller manager names and tags load balancers
+	// kube_service_<cluster>_<namespace>_<service>
+	LoadBalancerPrefix = "kube_service_%s_"
+
+	// Floating IPs allocated by the openstack cloud controller manager carry
+	// this suffix in their description
+	FloatingIPDescriptionSuffix = " from cluster %s"
+
+	// The cinder csi driver marks volumes and snapshots with the name of the
+	// cluster they were created for
+	CinderCSIClusterMetadata = "cinder.csi.openstack.org/cluster"
+
+	// Ports and root volumes of nodes are marked with the name of the kluster
+	// so they can be found once the node is gone. Ports carry the tag
+	// <NodeResourceMarker>=<kluster>, volumes the metadata key.
+	NodeResourceMarker = "kubernikus:kluster"
+)
+
+// NodeResourceTag is the tag of ports belonging to nodes of the kluster
+func NodeResourceTag(kluster *v1.Kluster) string {
+	return NodeResourceMarker + "=" + kluster.Spec.Name
+}
+
+// Clients used to take the inventory. Resources of services without a
+// client are skipped.
+type Clients struct {
+	BlockStorage *gophercloud.ServiceClient
+	Network      *gophercloud.ServiceClient
+	LoadBalancer *gophercloud.ServiceClient
+	Compute      *gophercloud.ServiceClient
+	Admin        admin.AdminClient
+}
+
+// NewClients creates the service clients for the given provider. Octavia is
+// optional as not every region offers it.
+func NewClients(provider *gophercloud.ProviderClient) (clients Clients, err error) {
+	if clients.BlockStorage, err = openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{}); err != nil {
+		return clients, fmt.Errorf("could not create block storage client: %w", err)
+	}
+	if clients.Network, err = openstack.NewNetworkV2(provider, gophercloud.EndpointOpts{}); err != nil {
+		return clients, fmt.Errorf("could not create network client: %w", err)
+	}
+	if clients.Compute, err = openstack.NewComputeV2(provider, gophercloud.EndpointOpts{}); err != nil {
+		return clients, fmt.Errorf("could not create compute client: %w", err)
+	}
+	if lb, err := openstack.NewLoadBalancerV2(provider, gophercloud.EndpointOpts{}); err == nil {
+		clients.LoadBalancer = lb
+	}
+	return clients, nil
+}
+
+// IsClusterLoadBalancer reports whether the load balancer was created by the
+// cloud controller manager of the kluster
+func IsClusterLoadBalancer(kluster *v1.Kluster, lb loadbalancers.LoadBalancer) bool {
+	prefix := fmt.Sprintf(LoadBalancerPrefix, kluster.Spec.Name)
+	if strings.HasPrefix(lb.Name, prefix) {
+		return true
+	}
+	for _, tag := range lb.Tags {
+		if strings.HasPrefix(tag, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsClusterFloatingIP reports whether the floating IP was allocated by the
+// cloud controller manager of the kluster. Pre-allocated floating IPs that
+// are merely associated with a load balancer of the kluster don't count.
+func IsClusterFloatingIP(kluster *v1.Kluster, fip floatingips.FloatingIP) bool {
+	return strings.HasSuffix(fip.Description, fmt.Sprintf(FloatingIPDescriptionSuffix, kluster.Spec.Name))
+}
+
+// Take lists the OpenStack resources in the kluster's project that are
+// attributable to the kluster and still exist. It is best effort: Services
+// that can't be queried are skipped and reported in the returned error.
+func Take(kluster *v1.Kluster, clients Clients) ([]models.DebrisResource, error) {
+	i := &inventory{Clients: clients, kluster: kluster}
+
+	result := []models.DebrisResource{}
+	errs := []error{}
+	for _, collect := range []func() ([]models.DebrisResource, error){
+		i.volumes,
+		i.snapshots,
+		i.loadBalancersServersAndPorts,
+		i.floatingIPs,
+		i.routes,
+		i.serverGroups,
+		i.containers,
+	} {
+		resources, err := collect()
+		if err != nil {
+			errs = append(errs, err)
+		}
+		result = append(result, resources...)
+	}
+
+	return result, utilerrors.NewAggregate(errs)
+}
+
+type inventory struct {
+	Clients
+	kluster *v1.Kluster
+}
+
+func (i *inventory) volumes() ([]models.DebrisResource, error) {
+	result := []models.DebrisResource{}
+	if i.BlockStorage == nil {
+		return result, nil
+	}
+	allPages, err := volumes.List(i.BlockStorage, volumes.ListOpts{TenantID: i.kluster.Account()}).AllPages()
+	if err != nil {
+		return result, fmt.Errorf("failed to list volumes: %w", err)
+	}
+	allVolumes, err := volumes.ExtractVolumes(allPages)
+	if err != nil {
+		return result, fmt.Errorf("failed to extract volumes: %w", err)
+	}
+	for _, volume := range allVolumes {
+		if volume.Metadata[CinderCSIClusterMetadata] == i.kluster.GetName() || volume.Metadata[NodeResourceMarker] == i.kluster.Spec.Name {
+			result = append(result, models.DebrisResource{Kind: models.DebrisResourceKindVolume, ID: volume.ID, Name: volume.Name})
+		}
+	}
+	return result, nil
+}
+
+func (i *inventory) snapshots() ([]models.DebrisResource, error) {
+	result := []models.DebrisResource{}
+	if i.BlockStorage == nil {
+		return result, nil
+	}
+	allPages, err := snapshots.List(i.BlockStorage, snapshots.ListOpts{TenantID: i.kluster.Account()}).AllPages()
+	if err != nil {
+		return result, fmt.Errorf("failed to list snapshots: %w", err)
+	}
+	allSnapshots, err := snapshots.ExtractSnapshots(allPages)
+	if err != nil {
+		return result, fmt.Errorf("failed to extract snapshots: %w", err)
+	}
+	for _, snapshot := range allSnapshots {
+		if snapshot.Metadata[CinderCSIClusterMetadata] == i.kluster.GetName() {
+			result = append(result, models.DebrisResource{Kind: models.DebrisResourceKindSnapshot, ID: snapshot.ID, Name: snapshot.Name})
+		}
+	}
+	return result, nil
+}
+
+// Servers are the kluster's nodes. Ports are attributed to the kluster if they
+// belong to one of its load balancers or nodes.
+func (i *inventory) loadBalancersServersAndPorts() ([]models.DebrisResource, error) {
+	result := []models.DebrisResource{}
+	clusterPorts := map[string]bool{}
+
+	if i.LoadBalancer != nil {
+		allPages, err := loadbalancers.List(i.LoadBalancer, loadbalancers.ListOpts{ProjectID: i.kluster.Account()}).AllPages()
+		if err != nil {
+			return result, fmt.Errorf("failed to list load balancers: %w", err)
+		}
+		allLoadBalancers, err := loadbalancers.ExtractLoadBalancers(allPages)
+		if err != nil {
+			return result, fmt.Errorf("failed to extract load balancers: %w", err)
+		}
+		for _, lb := range allLoadBalancers {
+			if IsClusterLoadBalancer(i.kluster, lb) {
+				result = append(result, models.DebrisResource{Kind: models.DebrisResourceKindLoadbalancer, ID: lb.ID, Name: lb.Name})
+				clusterPorts[lb.VipPortID] = true
+			}
+		}
+	}
+
+	clusterDevices := map[string]bool{}
+	if i.Compute != nil {
+		allPages, err := servers.List(i.Compute, servers.ListOpts{Tags: NodeResourceTag(i.kluster)}).AllPages()
+		if err != nil {
+			return result, fmt.Errorf("failed to list servers: %w", err)
+		}
+		allServers, err := servers.ExtractServers(allPages)
+		if err != nil {
+			return result, fmt.Errorf("failed to extract servers: %w", err)
+		}
+		for _, server := range allServers {
+			result = append(result, models.DebrisResource{Kind: models.DebrisResourceKindServer, ID: server.ID, Name: server.Name})
+			clusterDevices[server.ID] = true
+		}
+	}
+
+	if i.Network == nil {
+		return result, nil
+	}
+
+	allPages, err := ports.List(i.Network, ports.ListOpts{ProjectID: i.kluster.Account()}).AllPages()
+	if err != nil {
+		return result, fmt.Errorf("failed to list ports: %w", err)
+	}
+	allPorts, err := ports.ExtractPorts(allPages)
+	if err != nil {
+		return result, fmt.Errorf("failed to extract ports: %w", err)
+	}
+	for _, port := range allPorts {
+		if clusterPorts[port.ID] || clusterDevices[port.DeviceID] || hasTag(port.Tags, NodeResourceTag(i.kluster)) {
+			result = append(result, models.DebrisResource{Kind: models.DebrisResourceKindPort, ID: port.ID, Name: port.Name})
+		}
+	}
+	return result, nil
+}
+
+func (i *inventory) floatingIPs() ([]models.DebrisResource, error) {
+	result := []models.DebrisResource{}
+	if i.Network == nil {
+		return result, nil
+	}
+	allPages, err := floatingips.List(i.Network, floatingips.ListOpts{ProjectID: i.kluster.Account()}).AllPages()
+	if err != nil {
+		return result, fmt.Errorf("failed to list floating IPs: %w", err)
+	}
+	allFloatingIPs, err := floatingips.ExtractFloatingIPs(allPages)
+	if err != nil {
+		return result, fmt.Errorf("failed to extract floating IPs: %w", err)
+	}
+	for _, fip := range allFloatingIPs {
+		if IsClusterFloatingIP(i.kluster, fip) {
+			result = append(result, models.DebrisResource{Kind: models.DebrisResourceKindFloatingip, ID: fip.ID, Name: fip.FloatingIP})
+		}
+	}
+	return result, nil
+}
+
+// Routes are identified by their destination, the name is the nexthop
+func (i *inventory) routes() ([]models.DebrisResource, error) {
+	result := []models.DebrisResource{}
+	routerID := i.kluster.Spec.Openstack.RouterID
+	if i.Network == nil || routerID == "" {
+		return result, nil
+	}
+	_, clusterCIDR, err := net.ParseCIDR(i.kluster.ClusterCIDR())
+	if err != nil {
+		return result, nil
+	}
+	router, err := routers.Get(i.Network, routerID).Extract()
+	if err != nil {
+		return result, fmt.Errorf("failed to get router %s: %w", routerID, err)
+	}
+	for _, route := range router.Routes {
+		ip, _, err := net.ParseCIDR(route.DestinationCIDR)
+		if err != nil || !clusterCIDR.Contains(ip) {
+			continue
+		}
+		result = append(result, models.DebrisResource{Kind: models.DebrisResourceKindRoute, ID: route.DestinationCIDR, Name: route.NextHop})
+	}
+	return result, nil
+}
+
+func (i *inventory) serverGroups() ([]models.DebrisResource, error) {
+	result := []models.DebrisResource{}
+	if i.Compute == nil {
+		return result, nil
+	}
+	allPages, err := servergroups.List(i.Compute, servergroups.ListOpts{}).AllPages()
+	if err != nil {
+		return result, fmt.Errorf("failed to list server groups: %w", err)
+	}
+	allServerGroups, err := servergroups.ExtractServerGroups(allPages)
+	if err != nil {
+		return result, fmt.Errorf("failed to extract server groups: %w", err)
+	}
+	for _, sg := range allServerGroups {
+		if strings.HasPrefix(sg.Name, i.kluster.GetName()+"/") {
+			result = append(result, models.DebrisResource{Kind: models.DebrisResourceKindServergroup, ID: sg.ID, Name: sg.Name})
+		}
+	}
+	return result, nil
+}
+
+// Backup containers are never deleted automatically, they might still be
+// needed to restore the kluster
+func (i *inventory) containers() ([]models.DebrisResource, error) {
+	result := []models.DebrisResource{}
+	if i.Admin == nil {
+		return result, nil
+	}
+	containers := []etcd_util.SwiftContainer{{ProjectID: i.kluster.Account(), Name: i.kluster.GetName() + "-audit-log"}}
+	if backup, ok := etcd_util.SwiftBackupContainer(i.kluster); ok {
+		containers = append([]etcd_util.SwiftContainer{backup}, containers...)
+	} else {
+		// the backup might have been turned off since
+		containers = append([]etcd_util.SwiftContainer{{ProjectID: i.kluster.Account(), Name: etcd_util.DefaultStorageContainer(i.kluster)}}, containers...)
+	}
+	for _, container := range containers {
+		name := container.Name
+		meta, err := i.Admin.GetStorageContainerMeta(container.ProjectID, container.Region, name)
+		if err != nil {
+			return result, fmt.Errorf("failed to get container %s: %w", name, err)
+		}
+		if meta != nil {
+			result = append(result, models.DebrisResource{Kind: models.DebrisResourceKindContainer, ID: name, Name: name})
+		}
+	}
+	return result, nil
+}
+
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package inventory
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	openstack_fake "github.com/sapcc/kubernikus/pkg/client/openstack/fake"
+)
+
+func TestTake(t *testing.T) {
+	cloud := openstack_fake.NewCloud()
+	defer cloud.Close()
+	cloud.AddProject("project", "project", openstack_fake.DefaultDomain)
+	router := cloud.Routers("project")[0]
+
+	clusterCIDR := "100.100.0.0/16"
+	kluster := &v1.Kluster{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "test-project", Namespace: "test", UID: "uid", Labels: map[string]string{"account": "project"}},
+		Spec: models.KlusterSpec{
+			Name:        "test",
+			ClusterCIDR: &clusterCIDR,
+			Openstack:   models.OpenstackSpec{RouterID: router.ID},
+		},
+	}
+
+	volume := cloud.AddVolume(openstack_fake.Volume{ProjectID: "project", Name: "pv-1", Metadata: map[string]string{CinderCSIClusterMetadata: "test-project"}})
+	cloud.AddVolume(openstack_fake.Volume{ProjectID: "project", Name: "unrelated"})
+	snapshot := cloud.AddSnapshot(openstack_fake.Snapshot{ProjectID: "project", Name: "snapshot-1", Metadata: map[string]string{CinderCSIClusterMetadata: "test-project"}})
+	lb := cloud.AddLoadBalancer(openstack_fake.LoadBalancer{ProjectID: "project", Name: "kube_service_test_default_nginx"})
+	cloud.AddLoadBalancer(openstack_fake.LoadBalancer{ProjectID: "project", Name: "manual"})
+	fip := cloud.AddFloatingIP(openstack_fake.FloatingIP{ProjectID: "project", PortID: lb.VipPortID, Description: "Floating IP for Kubernetes external service default/nginx from cluster test"})
+	server := cloud.AddServer(openstack_fake.Server{ProjectID: "project", Name: "kks-test-pool-abcde", Tags: []string{"kubernikus:kluster=test"}})
+	cloud.AddServer(openstack_fake.Server{ProjectID: "project", Name: "unrelated"})
+	require.NoError(t, cloud.SetRoutes(router.ID, []openstack_fake.Route{
+		{DestinationCIDR: "100.100.0.0/24", NextHop: server.Address},
+		{DestinationCIDR: "192.168.0.0/24", NextHop: server.Address},
+	}))
+	sg := cloud.AddServerGroup(openstack_fake.ServerGroup{ProjectID: "project", Name: "test-project/pool"})
+	cloud.AddServerGroup(openstack_fake.ServerGroup{ProjectID: "project", Name: "other-project/pool"})
+
+	factory := openstack_fake.NewFactory(cloud)
+	adminClient, err := factory.AdminClient()
+	require.NoError(t, err)
+	require.NoError(t, adminClient.CreateKlusterServiceUser("kubernikus-test", "secret", openstack_fake.DefaultDomain, "project"))
+	require.NoError(t, adminClient.CreateStorageContainer("project", "", "kubernikus-etcd-backup-test-uid", "kubernikus-test", openstack_fake.DefaultDomain))
+
+	provider := cloud.ProviderClient("project")
+	blockStorageClient, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	require.NoError(t, err)
+	networkClient, err := openstack.NewNetworkV2(provider, gophercloud.EndpointOpts{})
+	require.NoError(t, err)
+	loadBalancerClient, err := openstack.NewLoadBalancerV2(provider, gophercloud.EndpointOpts{})
+	require.NoError(t, err)
+	computeClient, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{})
+	require.NoError(t, err)
+
+	resources, err := Take(kluster, Clients{blockStorageClient, networkClient, loadBalancerClient, computeClient, adminClient})
+	require.NoError(t, err)
+
+	serverPort := ""
+	for _, port := range cloud.Ports("project") {
+		if port.DeviceID == server.ID {
+			serverPort = port.ID
+		}
+	}
+
+	assert.ElementsMatch(t, []models.DebrisResource{
+		{Kind: models.DebrisResourceKindVolume, ID: volume.ID, Name: "pv-1"},
+		{Kind: models.DebrisResourceKindSnapshot, ID: snapshot.ID, Name: "snapshot-1"},
+		{Kind: models.DebrisResourceKindLoadbalancer, ID: lb.ID, Name: lb.Name},
+		{Kind: models.DebrisResourceKindPort, ID: lb.VipPortID, Name: "octavia-lb-" + lb.ID},
+		{Kind: models.DebrisResourceKindServer, ID: server.ID, Name: server.Name},
+		{Kind: models.DebrisResourceKindPort, ID: serverPort},
+		{Kind: models.DebrisResourceKindFloatingip, ID: fip.ID, Name: fip.Address},
+		{Kind: models.DebrisResourceKindRoute, ID: "100.100.0.0/24", Name: server.Address},
+		{Kind: models.DebrisResourceKindServergroup, ID: sg.ID, Name: sg.Name},
+		{Kind: models.DebrisResourceKindContainer, ID: "kubernikus-etcd-backup-test-uid", Name: "kubernikus-etcd-backup-test-uid"},
+	}, resources)
+}
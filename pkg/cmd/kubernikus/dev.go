@@ -0,0 +1,332 @@
+package kubernikus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"path"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	core_v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	apipkg "github.com/sapcc/kubernikus/pkg/api"
+	"github.com/sapcc/kubernikus/pkg/api/auth"
+	"github.com/sapcc/kubernikus/pkg/api/handlers"
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	"github.com/sapcc/kubernikus/pkg/api/rest"
+	"github.com/sapcc/kubernikus/pkg/api/rest/operations"
+	"github.com/sapcc/kubernikus/pkg/api/spec"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	kube "github.com/sapcc/kubernikus/pkg/client/kubernetes"
+	"github.com/sapcc/kubernikus/pkg/client/kubernikus"
+	openstack_fake "github.com/sapcc/kubernikus/pkg/client/openstack/fake"
+	"github.com/sapcc/kubernikus/pkg/client/openstack/inventory"
+	"github.com/sapcc/kubernikus/pkg/cmd"
+	"github.com/sapcc/kubernikus/pkg/controller"
+	"github.com/sapcc/kubernikus/pkg/util/envtest"
+	etcd_util "github.com/sapcc/kubernikus/pkg/util/etcd"
+	logutil "github.com/sapcc/kubernikus/pkg/util/log"
+	"github.com/sapcc/kubernikus/pkg/version"
+)
+
+func NewDevCommand() *cobra.Command {
+	o := NewDevOptions()
+
+	c := &cobra.Command{
+		Use:   "dev",
+		Short: "Runs the API and the operator against a local control plane and a fake OpenStack",
+		Long: `Runs the API server and the operator controllers in a single process for local development.
+
+Unless --kubeconfig is given a throwaway Kubernetes control plane (etcd and kube-apiserver) is started.
+The binaries are looked up in $KUBEBUILDER_ASSETS or PATH, use setup-envtest to install them.
+
+OpenStack is replaced by an in-memory fake and Keystone by an authenticator accepting any token.
+A token of the form "roles:<role1>,<role2>" can be used to request specific roles.`,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Validate(c, args))
+			cmd.CheckError(o.Run(c))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type DevOptions struct {
+	KubeConfig     string
+	AssetsDir      string
+	ChartDirectory string
+	Namespace      string
+	Region         string
+	Controllers    []string
+	APIPort        int
+	Project        string
+	ProjectName    string
+	Roles          []string
+	LogLevel       int
+}
+
+func NewDevOptions() *DevOptions {
+	return &DevOptions{
+		ChartDirectory: "charts/",
+		Namespace:      "kubernikus",
+		Region:         "eu-de-1",
+		Controllers:    NewOperatorOptions().Controllers,
+		APIPort:        1234,
+		Project:        "dev",
+		ProjectName:    "dev",
+		Roles:          []string{"kubernetes_admin", "member"},
+	}
+}
+
+func (o *DevOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.KubeConfig, "kubeconfig", o.KubeConfig, "Use an existing cluster (e.g. kind) instead of starting a local control plane")
+	flags.StringVar(&o.AssetsDir, "assets-dir", o.AssetsDir, fmt.Sprintf("Directory containing etcd and kube-apiserver binaries (defaults to $%s)", envtest.AssetsEnv))
+	flags.StringVar(&o.ChartDirectory, "chart-directory", o.ChartDirectory, "Directory containing the kubernikus related charts")
+	flags.StringVar(&o.Namespace, "namespace", o.Namespace, "Namespace for klusters")
+	flags.StringVar(&o.Region, "region", o.Region, "Region of the fake OpenStack")
+	flags.StringSliceVar(&o.Controllers, "controllers", o.Controllers, "A list of controllers to enable")
+	flags.IntVar(&o.APIPort, "api-port", o.APIPort, "Port the Kubernikus API listens on")
+	flags.StringVar(&o.Project, "project", o.Project, "ID of the project authenticated users are scoped to")
+	flags.StringVar(&o.ProjectName, "project-name", o.ProjectName, "Name of the project authenticated users are scoped to")
+	flags.StringSliceVar(&o.Roles, "roles", o.Roles, "Default roles of authenticated users")
+	flags.IntVar(&o.LogLevel, "v", 0, "log level")
+}
+
+func (o *DevOptions) Validate(c *cobra.Command, args []string) error {
+	if o.Project == "" {
+		return fmt.Errorf("you must specify the project flag")
+	}
+	return nil
+}
+
+func (o *DevOptions) Run(c *cobra.Command) error {
+	logger := logutil.NewLogger(o.LogLevel)
+
+	sigs := make(chan os.Signal, 1)
+	stop := make(chan struct{})
+	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+	wg := &sync.WaitGroup{}
+
+	kubeconfig := o.KubeConfig
+	if kubeconfig == "" {
+		env := &envtest.Environment{BinaryAssetsDirectory: o.AssetsDir, Logger: logger}
+		logger.Log("msg", "starting local control plane")
+		if err := env.Start(); err != nil {
+			return fmt.Errorf("failed to start local control plane: %s", err)
+		}
+		defer env.Stop()
+		kubeconfig = env.Kubeconfig
+		logger.Log("msg", "local control plane running", "url", env.URL, "kubeconfig", kubeconfig)
+	}
+
+	if err := o.ensureNamespace(kubeconfig, logger); err != nil {
+		return err
+	}
+
+	cloud := openstack_fake.NewCloud()
+	cloud.Region = o.Region
+	cloud.AddProject(o.Project, o.ProjectName, openstack_fake.DefaultDomain)
+	cloud.AddProject("kubernikus", "kubernikus", openstack_fake.DefaultDomain)
+	defer cloud.Close()
+	factory := openstack_fake.NewFactory(cloud)
+
+	operator, err := controller.NewKubernikusOperator(&controller.KubernikusOperatorOptions{
+		KubeConfig:          kubeconfig,
+		ChartDirectory:      o.ChartDirectory,
+		AuthDomain:          openstack_fake.DefaultDomain,
+		Region:              o.Region,
+		KubernikusDomain:    "kluster.localhost",
+		KubernikusProjectID: "kubernikus",
+		Namespace:           o.Namespace,
+		Controllers:         o.Controllers,
+		LogLevel:            o.LogLevel,
+		NodeUpdateHoldoff:   time.Minute,
+		HammertimeTimeout:   20 * time.Second,
+		Openstack:           factory,
+	}, logger)
+	if err != nil {
+		return err
+	}
+	go operator.Run(stop, wg)
+
+	server, err := o.apiServer(kubeconfig, factory, stop, logger)
+	if err != nil {
+		return err
+	}
+	go func() {
+		if err := server.Serve(); err != nil {
+			logger.Log("msg", "API server failed", "err", err)
+		}
+	}()
+	logger.Log(
+		"msg", "kubernikus is ready",
+		"api", fmt.Sprintf("http://127.0.0.1:%d", o.APIPort),
+		"project", o.Project,
+		"roles", strings.Join(o.Roles, ","),
+		"hint", "use any value as X-Auth-Token")
+
+	<-sigs
+	logger.Log("msg", "shutting down", "v", 1)
+	server.Shutdown()
+	close(stop)
+	wg.Wait()
+
+	return nil
+}
+
+func (o *DevOptions) ensureNamespace(kubeconfig string, logger log.Logger) error {
+	client, err := kube.NewClient(kubeconfig, "", logger)
+	if err != nil {
+		return fmt.Errorf("failed to create kubernetes client: %s", err)
+	}
+	_, err = client.CoreV1().Namespaces().Create(context.TODO(), &core_v1.Namespace{ObjectMeta: meta_v1.ObjectMeta{Name: o.Namespace}}, meta_v1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create namespace %s: %s", o.Namespace, err)
+	}
+	return nil
+}
+
+func (o *DevOptions) apiServer(kubeconfig string, factory *openstack_fake.Factory, stop <-chan struct{}, logger log.Logger) (*rest.Server, error) {
+	swaggerSpec, err := spec.Spec()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse swagger spec: %s", err)
+	}
+	api := operations.NewKubernikusAPI(swaggerSpec)
+
+	kubernikusClient, err := kubernikus.NewClient(kubeconfig, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kubernikus clients: %s", err)
+	}
+	kubernetesClient, err := kube.NewClient(kubeconfig, "", logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kubernetes clients: %s", err)
+	}
+
+	rt := apipkg.NewRuntime(o.Namespace, kubernikusClient, kubernetesClient, log.With(logger, "component", "api"))
+	if rt.Images, err = version.NewImageRegistry(path.Join(o.ChartDirectory, "images.yaml"), o.Region); err != nil {
+		return nil, fmt.Errorf("failed to load images: %s", err)
+	}
+	go rt.Informer.Run(stop)
+	if !cache.WaitForCacheSync(stop, rt.Informer.HasSynced) {
+		return nil, fmt.Errorf("cache not synced")
+	}
+
+	if err := rest.Configure(api, rt); err != nil {
+		return nil, fmt.Errorf("failed to configure API server: %s", err)
+	}
+	api.KeystoneAuth = auth.FakeKeystone(models.Principal{
+		ID:          "developer",
+		Name:        "developer",
+		Domain:      openstack_fake.DefaultDomain,
+		Account:     o.Project,
+		AccountName: o.ProjectName,
+		Roles:       o.Roles,
+	})
+	rt.FetchOpenstackMetadata = func(_ *http.Request, principal *models.Principal) (*models.OpenstackMetadata, error) {
+		client, err := factory.ProjectAdminClientFor(principal.Account)
+		if err != nil {
+			return nil, err
+		}
+		return client.GetMetadata()
+	}
+	rt.FetchTerminationInventory = func(_ *http.Request, _ *models.Principal, kluster *v1.Kluster) ([]models.DebrisResource, error) {
+		provider, err := factory.ProviderClientForKluster(kluster, logger)
+		if err != nil {
+			return nil, err
+		}
+		clients, err := inventory.NewClients(provider)
+		if err != nil {
+			return nil, err
+		}
+		return inventory.Take(kluster, clients)
+	}
+	rt.FetchClusterServers = func(_ *http.Request, _ *models.Principal, kluster *v1.Kluster) ([]servers.Server, error) {
+		provider, err := factory.ProviderClientForKluster(kluster, logger)
+		if err != nil {
+			return nil, err
+		}
+		return handlers.ListKlusterServers(provider, kluster)
+	}
+	// the fake cloud has no object store, snapshots are kept in memory
+	snapshots := newDevSnapshots()
+	rt.SnapshotStore = func(_ kubernetes.Interface, _ *http.Request, kluster *v1.Kluster) (etcd_util.SnapshotStore, error) {
+		return snapshots.store(kluster), nil
+	}
+	rt.TakeFullSnapshot = func(_ kubernetes.Interface, kluster *v1.Kluster, reason string) (models.EtcdSnapshotReference, error) {
+		if !etcd_util.BackupEnabled(kluster) {
+			return models.EtcdSnapshotReference{}, errors.New("etcd backup is off")
+		}
+		return snapshots.take(kluster, reason), nil
+	}
+	rt.VerifyBackupTarget = func(_ *http.Request, _ *models.Principal, _ *models.BackupTarget) error {
+		return nil
+	}
+
+	server := rest.NewServer(api)
+	server.EnabledListeners = []string{"http"}
+	server.Host = "127.0.0.1"
+	server.Port = o.APIPort
+	server.ConfigureAPI()
+	return server, nil
+}
+
+// devSnapshots fakes the etcd snapshots of the klusters for the API
+type devSnapshots struct {
+	mu       sync.Mutex
+	stores   map[string]*etcd_util.FakeSnapshotStore
+	revision int64
+}
+
+func newDevSnapshots() *devSnapshots {
+	return &devSnapshots{stores: map[string]*etcd_util.FakeSnapshotStore{}}
+}
+
+func (s *devSnapshots) store(kluster *v1.Kluster) *etcd_util.FakeSnapshotStore {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	store, ok := s.stores[kluster.GetName()]
+	if !ok {
+		store = &etcd_util.FakeSnapshotStore{}
+		s.stores[kluster.GetName()] = store
+	}
+	return store
+}
+
+// take adds a full snapshot named like the ones of etcdbr
+func (s *devSnapshots) take(kluster *v1.Kluster, reason string) models.EtcdSnapshotReference {
+	s.mu.Lock()
+	s.revision++
+	revision := s.revision
+	s.mu.Unlock()
+
+	now := time.Now().UTC()
+	snapshot := models.EtcdSnapshot{
+		Name:         fmt.Sprintf("Full-%08d-%08d-%d.gz", 0, revision, now.Unix()),
+		Kind:         models.EtcdSnapshotKindFull,
+		LastRevision: revision,
+		CreatedAt:    now.Format(time.RFC3339),
+	}
+	s.store(kluster).Add(snapshot)
+	return models.EtcdSnapshotReference{
+		Name:      snapshot.Name,
+		Revision:  revision,
+		CreatedAt: snapshot.CreatedAt,
+		Reason:    reason,
+	}
+}
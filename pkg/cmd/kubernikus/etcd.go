@@ -0,0 +1,21 @@
+package kubernikus
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/sapcc/kubernikus/pkg/cmd/kubernikus/etcd"
+)
+
+func NewEtcdCommand() *cobra.Command {
+
+	c := &cobra.Command{
+		Use:   "etcd",
+		Short: "Etcd of the klusters",
+	}
+
+	c.AddCommand(
+		etcd.NewSnapshotCommand(),
+	)
+
+	return c
+}
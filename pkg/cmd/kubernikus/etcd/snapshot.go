@@ -0,0 +1,116 @@
+package etcd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/user"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/sapcc/kubernikus/pkg/client/kubernetes"
+	"github.com/sapcc/kubernikus/pkg/client/kubernikus"
+	"github.com/sapcc/kubernikus/pkg/cmd"
+	etcd_util "github.com/sapcc/kubernikus/pkg/util/etcd"
+	logutil "github.com/sapcc/kubernikus/pkg/util/log"
+)
+
+func NewSnapshotCommand() *cobra.Command {
+	o := NewSnapshotOptions()
+
+	c := &cobra.Command{
+		Use:   "snapshot NAME",
+		Short: "Takes a full etcd snapshot of a kluster and records it in its status",
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Validate(c, args))
+			cmd.CheckError(o.Complete(args))
+			cmd.CheckError(o.Run(c))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type SnapshotOptions struct {
+	kubeConfig string
+	context    string
+	namespace  string
+	name       string
+	reason     string
+	LogLevel   int
+}
+
+func NewSnapshotOptions() *SnapshotOptions {
+	return &SnapshotOptions{
+		namespace: "kubernikus",
+	}
+}
+
+func (o *SnapshotOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.kubeConfig, "kubeconfig", o.kubeConfig, "Path to kubeconfig file with authorization information")
+	flags.StringVar(&o.context, "context", o.context, "Overwrite the current-context in kubeconfig")
+	flags.StringVar(&o.namespace, "namespace", o.namespace, "Namespace of the kluster")
+	flags.StringVar(&o.reason, "reason", o.reason, "Reason recorded with the snapshot (default: requested by the current user)")
+	flags.IntVar(&o.LogLevel, "v", 0, "log level")
+}
+
+func (o *SnapshotOptions) Validate(c *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return errors.New("you must specify the kluster name")
+	}
+	return nil
+}
+
+func (o *SnapshotOptions) Complete(args []string) error {
+	o.name = args[0]
+	if o.reason == "" {
+		o.reason = "requested"
+		if u, err := user.Current(); err == nil {
+			o.reason = "requested by " + u.Username
+		}
+	}
+	return nil
+}
+
+func (o *SnapshotOptions) Run(c *cobra.Command) error {
+	logger := logutil.NewLogger(o.LogLevel)
+	client, err := kubernetes.NewClient(o.kubeConfig, o.context, logger)
+	if err != nil {
+		return err
+	}
+	kubernikusClient, err := kubernikus.NewClient(o.kubeConfig, o.context)
+	if err != nil {
+		return err
+	}
+	klusters := kubernikusClient.KubernikusV1().Klusters(o.namespace)
+
+	kluster, err := klusters.Get(context.TODO(), o.name, meta_v1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	snapshot, err := etcd_util.TakeFullSnapshot(client, kluster, o.reason)
+	if err != nil {
+		return err
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		kluster, err := klusters.Get(context.TODO(), o.name, meta_v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		etcd_util.RecordSnapshot(kluster, snapshot)
+		_, err = klusters.Update(context.TODO(), kluster, meta_v1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("snapshot %s taken but not recorded: %s", snapshot.Name, err)
+	}
+
+	fmt.Printf("Snapshot %s (revision %d) taken at %s\n", snapshot.Name, snapshot.Revision, snapshot.CreatedAt)
+	return nil
+}
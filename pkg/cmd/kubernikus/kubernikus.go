@@ -15,6 +15,8 @@ func NewCommand(name string) *cobra.Command {
 
 	c.AddCommand(
 		NewCertificatesCommand(),
+		NewDevCommand(),
+		NewEtcdCommand(),
 		NewHelmCommand(),
 		NewOperatorCommand(),
 		NewSeedCommand(),
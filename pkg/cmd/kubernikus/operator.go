@@ -51,9 +51,10 @@ func NewOperatorOptions() *Options {
 	options.KubernikusDomain = "kluster.staging.cloud.sap"
 	options.Namespace = "kubernikus"
 	options.MetricPort = 9091
-	options.Controllers = []string{"groundctl", "launchctl", "deorbiter", "routegc", "flight", "migration", "hammertime", "servicing", "certs"}
+	options.Controllers = []string{"groundctl", "launchctl", "deorbiter", "routegc", "orphangc", "flight", "migration", "hammertime", "servicing", "certs", "nodelabels"}
 	options.Region = "eu-de-1"
 	options.NodeUpdateHoldoff = 7 * 24 * time.Hour
+	options.HammertimeTimeout = 20 * time.Second
 	return options
 }
 
@@ -78,6 +79,9 @@ func (o *Options) BindFlags(flags *pflag.FlagSet) {
 	flags.IntVar(&o.LogLevel, "v", 0, "log level")
 
 	flags.DurationVar(&o.NodeUpdateHoldoff, "node-update-holdoff", o.NodeUpdateHoldoff, "Holdoff duration before node update is applied.")
+	flags.DurationVar(&o.HammertimeTimeout, "hammertime-timeout", o.HammertimeTimeout, "Default duration without node heartbeats before hammertime intervenes. Can be overridden per kluster.")
+	flags.BoolVar(&o.RouteGCDryRun, "routegc-dry-run", o.RouteGCDryRun, "Only report orphaned and wrong nexthop routes instead of removing them")
+	flags.BoolVar(&o.OrphanGCDryRun, "orphangc-dry-run", o.OrphanGCDryRun, "Only report orphaned ports and volumes instead of deleting them")
 }
 
 func (o *Options) Validate(c *cobra.Command, args []string) error {
@@ -0,0 +1,30 @@
+package kubernikusctl
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/sapcc/kubernikus/pkg/cmd/kubernikusctl/apply"
+	"github.com/sapcc/kubernikus/pkg/cmd/kubernikusctl/common"
+)
+
+func NewApplyCommand() *cobra.Command {
+	o := apply.ApplyOptions{
+		Openstack: common.NewOpenstackClient(),
+	}
+
+	c := o.NewApplyCommand()
+	c.PersistentPreRun = o.PersistentPreRun
+	o.BindFlags(c.PersistentFlags())
+	return c
+}
+
+func NewDiffCommand() *cobra.Command {
+	o := apply.ApplyOptions{
+		Openstack: common.NewOpenstackClient(),
+	}
+
+	c := o.NewDiffCommand()
+	c.PersistentPreRun = o.PersistentPreRun
+	o.BindFlags(c.PersistentFlags())
+	return c
+}
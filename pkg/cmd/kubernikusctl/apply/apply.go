@@ -0,0 +1,68 @@
+package apply
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/sapcc/kubernikus/pkg/cmd"
+	"github.com/sapcc/kubernikus/pkg/cmd/kubernikusctl/common"
+)
+
+func (o *ApplyOptions) NewApplyCommand() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "apply",
+		Short: "Creates or updates a cluster defined in a YAML or JSON spec expected at stdin",
+		Long: `Creates the cluster if it doesn't exist, otherwise updates it to match the spec.
+Fields missing in the spec keep their current value, node pools missing in
+the spec are removed.`,
+		PreRun: o.preRun,
+		Run:    o.applyRun,
+	}
+	c.Flags().StringVarP(&o.ReadFile, "file", "f", "", "File to read spec from")
+	return c
+}
+
+func (o *ApplyOptions) preRun(c *cobra.Command, args []string) {
+	cmd.CheckError(validateArgs(args))
+	cmd.CheckError(o.SetupKubernikusClient())
+}
+
+func (o *ApplyOptions) applyRun(c *cobra.Command, args []string) {
+	current, desired, etag, err := o.plan()
+	cmd.CheckError(err)
+
+	if current == nil {
+		cmd.CheckError(o.Kubernikus.CreateCluster(desired))
+		fmt.Printf("Cluster %v created.\n", desired.Name)
+		return
+	}
+
+	changes, err := Diff(current, desired)
+	cmd.CheckError(err)
+	if len(changes) == 0 {
+		fmt.Printf("Cluster %v unchanged.\n", desired.Name)
+		return
+	}
+	updated, err := o.Kubernikus.UpdateClusterIfMatch(desired, etag)
+	if err == common.ErrClusterChanged {
+		err = errors.Errorf("Cluster %v was changed since it was read, run diff and apply again", desired.Name)
+	}
+	cmd.CheckError(err)
+
+	// report what the server took over, not what was sent
+	applied, ignored, err := Applied(current, desired, updated)
+	cmd.CheckError(err)
+	for _, change := range applied {
+		fmt.Println(change)
+	}
+	for _, change := range ignored {
+		fmt.Printf("Warning: %v was not applied by the server\n", change.Path)
+	}
+	if len(applied) == 0 {
+		fmt.Printf("Cluster %v unchanged.\n", desired.Name)
+		return
+	}
+	fmt.Printf("Cluster %v configured.\n", desired.Name)
+}
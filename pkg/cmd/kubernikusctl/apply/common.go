@@ -0,0 +1,89 @@
+package apply
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/klog"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	"github.com/sapcc/kubernikus/pkg/cmd"
+	"github.com/sapcc/kubernikus/pkg/cmd/kubernikusctl/common"
+)
+
+type ApplyOptions struct {
+	_url       string
+	url        *url.URL
+	Openstack  *common.OpenstackClient
+	Kubernikus *common.KubernikusClient
+	ReadFile   string
+}
+
+func (o *ApplyOptions) PersistentPreRun(c *cobra.Command, args []string) {
+	common.SetupLogger()
+	cmd.CheckError(o.Openstack.Validate(c, args))
+	cmd.CheckError(o.Openstack.Setup())
+	cmd.CheckError(o.Openstack.Authenticate())
+}
+
+func (o *ApplyOptions) BindFlags(flags *pflag.FlagSet) {
+	o.Openstack.BindFlags(flags)
+	common.BindLogFlags(flags)
+
+	flags.StringVar(&o._url, "url", o._url, "URL for Kubernikus API")
+}
+
+func (o *ApplyOptions) SetupKubernikusClient() error {
+	var err error
+	if o._url == "" {
+		fmt.Println("Auto-Detecting Kubernikus Host ...")
+		if o.url, err = o.Openstack.DefaultKubernikusURL(); err != nil {
+			klog.V(2).Infof("Error detecting kubernikus host: %+v", err)
+			return errors.Errorf("You need to provide --url. Auto-Detection failed.")
+		}
+	} else {
+		if o.url, err = url.Parse(o._url); err != nil {
+			klog.V(2).Infof("Error parsing url: %v", o._url)
+			return errors.Wrap(err, "Error parsing url")
+		}
+	}
+	klog.V(2).Infof("Setting up kubernikus client at %v.", o.url)
+	o.Kubernikus = common.NewKubernikusClient(o.url, o.Openstack.Provider.TokenID)
+	return nil
+}
+
+// plan reads the spec file and merges it onto the current cluster, which is
+// nil if the cluster doesn't exist yet. The ETag of the current cluster makes
+// the update fail if it was changed in the meantime.
+func (o *ApplyOptions) plan() (current, desired *models.Kluster, etag string, err error) {
+	spec, err := common.ReadSpec(o.ReadFile)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	var cluster models.Kluster
+	if err := cluster.UnmarshalBinary(spec); err != nil {
+		return nil, nil, "", errors.Wrap(err, "Error parsing spec")
+	}
+	if cluster.Name == "" {
+		return nil, nil, "", errors.Errorf("The spec needs a name")
+	}
+
+	if current, etag, err = o.Kubernikus.FindClusterWithETag(cluster.Name); err != nil {
+		return nil, nil, "", err
+	}
+	if desired, err = Merge(current, spec); err != nil {
+		return nil, nil, "", err
+	}
+	klog.V(2).Infof("cluster: %v", desired)
+	return current, desired, etag, nil
+}
+
+func validateArgs(args []string) error {
+	if len(args) != 0 {
+		return errors.Errorf("Unexpected Argument: %v", args)
+	}
+	return nil
+}
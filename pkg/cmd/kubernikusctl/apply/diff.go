@@ -0,0 +1,41 @@
+package apply
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sapcc/kubernikus/pkg/cmd"
+)
+
+func (o *ApplyOptions) NewDiffCommand() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "diff",
+		Short: "Shows the changes apply would make to a cluster",
+		Long: `Compares a YAML or JSON spec expected at stdin with the cluster. Status and
+fields missing in the spec, like defaulted CIDRs and availability zones, are
+ignored. Exits with 1 if there are differences.`,
+		PreRun: o.preRun,
+		Run:    o.diffRun,
+	}
+	c.Flags().StringVarP(&o.ReadFile, "file", "f", "", "File to read spec from")
+	return c
+}
+
+func (o *ApplyOptions) diffRun(c *cobra.Command, args []string) {
+	current, desired, _, err := o.plan()
+	cmd.CheckError(err)
+
+	if current == nil {
+		fmt.Printf("Cluster %v doesn't exist and will be created.\n", desired.Name)
+	}
+	changes, err := Diff(current, desired)
+	cmd.CheckError(err)
+	for _, change := range changes {
+		fmt.Println(change)
+	}
+	if len(changes) > 0 {
+		os.Exit(1)
+	}
+}
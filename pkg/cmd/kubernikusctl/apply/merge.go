@@ -0,0 +1,259 @@
+package apply
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// serverManaged are the top level fields never taken from a spec file
+var serverManaged = []string{"status"}
+
+// Merge applies the spec file onto the current cluster. Fields missing in the
+// file keep their current value so that server defaults (CIDRs, AVZ, ...) are
+// not reset. Lists of named objects like node pools are merged by name, pools
+// missing in the file are removed.
+func Merge(current *models.Kluster, spec []byte) (*models.Kluster, error) {
+	desired, err := toMap(spec)
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range serverManaged {
+		delete(desired, field)
+	}
+	if current == nil {
+		return fromMap(desired)
+	}
+
+	currentJSON, err := current.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	base, err := toMap(currentJSON)
+	if err != nil {
+		return nil, err
+	}
+	merged, _ := mergeValue(base, desired).(map[string]interface{})
+	return fromMap(merged)
+}
+
+// Change is a single field that differs between two clusters
+type Change struct {
+	Path string
+	From interface{}
+	To   interface{}
+}
+
+func (c Change) String() string {
+	switch {
+	case c.From == nil:
+		return fmt.Sprintf("+ %s: %s", c.Path, compact(c.To))
+	case c.To == nil:
+		return fmt.Sprintf("- %s: %s", c.Path, compact(c.From))
+	default:
+		return fmt.Sprintf("~ %s: %s -> %s", c.Path, compact(c.From), compact(c.To))
+	}
+}
+
+// Diff lists the changed fields between the current and the desired cluster,
+// server managed fields are ignored. A nil current cluster is compared as empty.
+func Diff(current, desired *models.Kluster) ([]Change, error) {
+	from := map[string]interface{}{}
+	if current != nil {
+		raw, err := current.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		if from, err = toMap(raw); err != nil {
+			return nil, err
+		}
+	}
+	raw, err := desired.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	to, err := toMap(raw)
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range serverManaged {
+		delete(from, field)
+		delete(to, field)
+	}
+
+	var changes []Change
+	diffValue("", from, to, &changes)
+	return changes, nil
+}
+
+// Applied compares the cluster returned by the server with the state before
+// the update. Changes lists what the server actually changed, ignored what was
+// requested but not taken over, e.g. because the server rejected or reset it.
+func Applied(current, desired, updated *models.Kluster) (changes, ignored []Change, err error) {
+	if changes, err = Diff(current, updated); err != nil {
+		return nil, nil, err
+	}
+	if ignored, err = Diff(updated, desired); err != nil {
+		return nil, nil, err
+	}
+	return changes, ignored, nil
+}
+
+func mergeValue(current, desired interface{}) interface{} {
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		c, ok := current.(map[string]interface{})
+		if !ok {
+			return d
+		}
+		merged := make(map[string]interface{}, len(c))
+		for k, v := range c {
+			merged[k] = v
+		}
+		for k, v := range d {
+			if v == nil {
+				delete(merged, k)
+				continue
+			}
+			merged[k] = mergeValue(c[k], v)
+		}
+		return merged
+	case []interface{}:
+		c, ok := current.([]interface{})
+		if !ok || !named(d) || !named(c) {
+			return d
+		}
+		byName := indexByName(c)
+		merged := make([]interface{}, 0, len(d))
+		for _, item := range d {
+			name := item.(map[string]interface{})["name"].(string)
+			merged = append(merged, mergeValue(byName[name], item))
+		}
+		return merged
+	default:
+		return desired
+	}
+}
+
+func diffValue(path string, from, to interface{}, changes *[]Change) {
+	fromMap, fromIsMap := from.(map[string]interface{})
+	toMap, toIsMap := to.(map[string]interface{})
+	// added and removed objects are listed field by field
+	if from == nil && toIsMap {
+		fromMap, fromIsMap = map[string]interface{}{}, true
+	}
+	if to == nil && fromIsMap {
+		toMap, toIsMap = map[string]interface{}{}, true
+	}
+	if fromIsMap && toIsMap {
+		for _, k := range sortedKeys(fromMap, toMap) {
+			diffValue(join(path, k), fromMap[k], toMap[k], changes)
+		}
+		return
+	}
+
+	fromList, fromIsList := from.([]interface{})
+	toList, toIsList := to.([]interface{})
+	if fromIsList && toIsList && named(fromList) && named(toList) {
+		fromByName, toByName := indexByName(fromList), indexByName(toList)
+		var names []string
+		for _, item := range append(append([]interface{}{}, fromList...), toList...) {
+			names = appendUnique(names, item.(map[string]interface{})["name"].(string))
+		}
+		for _, name := range names {
+			// added and removed items are listed as a whole
+			if fromByName[name] == nil || toByName[name] == nil {
+				*changes = append(*changes, Change{Path: fmt.Sprintf("%s[%s]", path, name), From: fromByName[name], To: toByName[name]})
+				continue
+			}
+			diffValue(fmt.Sprintf("%s[%s]", path, name), fromByName[name], toByName[name], changes)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(from, to) {
+		*changes = append(*changes, Change{Path: path, From: from, To: to})
+	}
+}
+
+// named tells if all items of the list are objects with a name
+func named(list []interface{}) bool {
+	for _, item := range list {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		if _, ok := m["name"].(string); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func indexByName(list []interface{}) map[string]interface{} {
+	index := make(map[string]interface{}, len(list))
+	for _, item := range list {
+		index[item.(map[string]interface{})["name"].(string)] = item
+	}
+	return index
+}
+
+func sortedKeys(maps ...map[string]interface{}) []string {
+	var keys []string
+	for _, m := range maps {
+		for k := range m {
+			keys = appendUnique(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func appendUnique(list []string, value string) []string {
+	for _, v := range list {
+		if v == value {
+			return list
+		}
+	}
+	return append(list, value)
+}
+
+func join(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+func compact(value interface{}) string {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(raw)
+}
+
+func toMap(raw []byte) (map[string]interface{}, error) {
+	m := map[string]interface{}{}
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, errors.Wrap(err, "Error parsing spec")
+	}
+	return m, nil
+}
+
+func fromMap(m map[string]interface{}) (*models.Kluster, error) {
+	raw, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	var cluster models.Kluster
+	if err := cluster.UnmarshalBinary(raw); err != nil {
+		return nil, errors.Wrap(err, "Error parsing spec")
+	}
+	return &cluster, nil
+}
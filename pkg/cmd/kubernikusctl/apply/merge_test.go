@@ -0,0 +1,93 @@
+package apply
+
+import (
+	"testing"
+
+	"github.com/go-openapi/swag/conv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+func currentCluster() *models.Kluster {
+	return &models.Kluster{
+		Name: "test",
+		Spec: models.KlusterSpec{
+			Name:         "test",
+			ClusterCIDR:  conv.Pointer("100.100.0.0/16"),
+			Version:      "1.30.1",
+			SSHPublicKey: "ssh-rsa AAAA",
+			NodePools: []models.NodePool{
+				{Name: "default", Flavor: "m1.small", Size: 2, AvailabilityZone: "eu-de-1a", Image: "flatcar-stable-amd64"},
+				{Name: "gpu", Flavor: "g1.large", Size: 1, AvailabilityZone: "eu-de-1b", Image: "flatcar-stable-amd64"},
+			},
+		},
+		Status: models.KlusterStatus{Phase: models.KlusterPhaseRunning, ApiserverVersion: "1.30.1"},
+	}
+}
+
+func TestMergeIgnoresServerManagedFields(t *testing.T) {
+	spec := []byte(`{"name":"test","spec":{"version":"1.30.1","sshPublicKey":"ssh-rsa AAAA","nodePools":[{"name":"default","flavor":"m1.small","size":2,"image":"flatcar-stable-amd64"},{"name":"gpu","flavor":"g1.large","size":1,"image":"flatcar-stable-amd64"}]},"status":{"phase":"Pending"}}`)
+
+	desired, err := Merge(currentCluster(), spec)
+	require.NoError(t, err)
+	assert.Equal(t, "100.100.0.0/16", conv.Value(desired.Spec.ClusterCIDR))
+	assert.Equal(t, "eu-de-1a", desired.Spec.NodePools[0].AvailabilityZone)
+
+	changes, err := Diff(currentCluster(), desired)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestMergeNodePools(t *testing.T) {
+	spec := []byte(`{"name":"test","spec":{"nodePools":[{"name":"default","flavor":"m1.small","size":3,"image":"flatcar-stable-amd64"},{"name":"big","flavor":"m1.xlarge","size":1,"image":"flatcar-stable-amd64"}]}}`)
+
+	desired, err := Merge(currentCluster(), spec)
+	require.NoError(t, err)
+	require.Len(t, desired.Spec.NodePools, 2)
+	assert.Equal(t, int64(3), desired.Spec.NodePools[0].Size)
+	assert.Equal(t, "eu-de-1a", desired.Spec.NodePools[0].AvailabilityZone)
+	assert.Equal(t, "big", desired.Spec.NodePools[1].Name)
+
+	changes, err := Diff(currentCluster(), desired)
+	require.NoError(t, err)
+	if assert.Len(t, changes, 3) {
+		assert.Equal(t, "~ spec.nodePools[default].size: 2 -> 3", changes[0].String())
+		assert.Equal(t, "spec.nodePools[gpu]", changes[1].Path)
+		assert.Nil(t, changes[1].To)
+		assert.Equal(t, "spec.nodePools[big]", changes[2].Path)
+		assert.Nil(t, changes[2].From)
+	}
+}
+
+func TestMergeNewCluster(t *testing.T) {
+	desired, err := Merge(nil, []byte(`{"name":"test","spec":{"version":"1.30.1"},"status":{"phase":"Running"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, "1.30.1", desired.Spec.Version)
+	assert.Empty(t, desired.Status.Phase)
+
+	changes, err := Diff(nil, desired)
+	require.NoError(t, err)
+	assert.Contains(t, changes, Change{Path: "spec.version", To: "1.30.1"})
+}
+
+func TestApplied(t *testing.T) {
+	desired, err := Merge(currentCluster(), []byte(`{"name":"test","spec":{"version":"1.31.2","sshPublicKey":"ssh-rsa BBBB"}}`))
+	require.NoError(t, err)
+
+	// the server takes over the key but keeps the version
+	updated := currentCluster()
+	updated.Spec.SSHPublicKey = "ssh-rsa BBBB"
+	updated.Status.Phase = models.KlusterPhaseUpgrading
+
+	changes, ignored, err := Applied(currentCluster(), desired, updated)
+	require.NoError(t, err)
+	assert.Equal(t, []Change{{Path: "spec.sshPublicKey", From: "ssh-rsa AAAA", To: "ssh-rsa BBBB"}}, changes)
+	assert.Equal(t, []Change{{Path: "spec.version", From: "1.30.1", To: "1.31.2"}}, ignored)
+
+	changes, ignored, err = Applied(currentCluster(), desired, currentCluster())
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+	assert.Len(t, ignored, 2)
+}
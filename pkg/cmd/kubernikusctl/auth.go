@@ -15,6 +15,7 @@ func NewAuthCommand() *cobra.Command {
 	c.AddCommand(
 		auth.NewInitCommand(),
 		auth.NewRefreshCommand(),
+		auth.NewExecCredentialCommand(),
 	)
 
 	return c
@@ -0,0 +1,197 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/howeyc/gopass"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	keyring "github.com/zalando/go-keyring"
+	clientauthv1 "k8s.io/client-go/pkg/apis/clientauthentication/v1"
+	"k8s.io/klog"
+
+	"github.com/sapcc/kubernikus/pkg/cmd/kubernikusctl/common"
+)
+
+type ExecCredentialOptions struct {
+	_url string
+
+	url      *url.URL
+	name     string
+	cacheDir string
+
+	openstack *common.OpenstackClient
+}
+
+func NewExecCredentialCommand() *cobra.Command {
+	o := &ExecCredentialOptions{
+		name:      os.Getenv("KUBERNIKUS_NAME"),
+		_url:      os.Getenv("KUBERNIKUS_URL"),
+		cacheDir:  common.DefaultExecCredentialCacheDir,
+		openstack: common.NewOpenstackClient(),
+	}
+	// the kubeconfig passes all flags, switching profiles mustn't change them
+	o.openstack.IgnoreProfiles = true
+
+	c := &cobra.Command{
+		Use:   "exec-credential",
+		Short: "Provides Kubernikus credentials to kubectl as exec credential plugin",
+		Run: func(c *cobra.Command, args []string) {
+			common.SetupLogger()
+			common.CheckError(o.Validate(c, args))
+			common.CheckError(o.Complete(args))
+			common.CheckError(o.Run(c))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+func (o *ExecCredentialOptions) BindFlags(flags *pflag.FlagSet) {
+	o.openstack.BindFlags(flags)
+	common.BindLogFlags(flags)
+
+	flags.StringVar(&o._url, "url", o._url, "URL for Kubernikus API")
+	flags.StringVar(&o.name, "name", o.name, "Cluster Name")
+	flags.StringVar(&o.cacheDir, "cache-dir", o.cacheDir, "Directory for caching tokens and certificates")
+}
+
+func (o *ExecCredentialOptions) Validate(c *cobra.Command, args []string) (err error) {
+	if o._url == "" {
+		return errors.Errorf("You need to provide --url")
+	}
+	if o.url, err = url.Parse(o._url); err != nil {
+		return errors.Errorf("Parsing the Kubernikus URL failed")
+	}
+	if o.name == "" {
+		return errors.Errorf("You need to provide --name")
+	}
+	return o.openstack.Validate(c, args)
+}
+
+func (o *ExecCredentialOptions) Complete(args []string) (err error) {
+	return o.openstack.Complete(args)
+}
+
+func (o *ExecCredentialOptions) Run(c *cobra.Command) error {
+	apiVersion, interactive := execInfo()
+
+	cache, err := common.LoadExecCredentialCache(o.cacheDir, o.name,
+		o.url.String(),
+		o.openstack.IdentityEndpoint,
+		o.openstack.Scope.ProjectID,
+		o.openstack.Scope.ProjectName,
+		o.openstack.UserID,
+		o.openstack.Username,
+		o.openstack.DomainName,
+		o.openstack.ApplicationCredentialID,
+		o.openstack.ApplicationCredentialName,
+	)
+	if err != nil {
+		klog.V(2).Infof("Ignoring credential cache: %v", err)
+	}
+
+	now := time.Now()
+	if cache.CertificateValid(now) {
+		klog.V(2).Infof("Using cached certificate valid until %v", cache.ExpiresAt)
+	} else {
+		if err := o.refresh(cache, now, interactive); err != nil {
+			return err
+		}
+		if err := cache.Save(); err != nil {
+			klog.Warningf("Failed to cache credentials: %v", err)
+		}
+	}
+
+	return json.NewEncoder(os.Stdout).Encode(cache.ExecCredential(apiVersion))
+}
+
+func (o *ExecCredentialOptions) refresh(cache *common.ExecCredentialCache, now time.Time, interactive bool) error {
+	if o.openstack.TokenID == "" && cache.TokenValid(now) {
+		klog.V(2).Infof("Fetching credentials for %v from %v with cached token", o.name, o.url)
+		kubeconfig, err := common.NewKubernikusClient(o.url, cache.Token).GetCredentials(o.name)
+		if err == nil {
+			return cache.SetCredentials(kubeconfig)
+		}
+		klog.V(2).Infof("Fetching credentials with cached token failed: %v", err)
+		cache.Token = ""
+	}
+
+	if err := o.authenticate(cache, interactive); err != nil {
+		return err
+	}
+
+	klog.V(2).Infof("Fetching credentials for %v from %v", o.name, o.url)
+	kubeconfig, err := common.NewKubernikusClient(o.url, o.openstack.Provider.TokenID).GetCredentials(o.name)
+	if err != nil {
+		return errors.Wrap(err, "Couldn't fetch credentials from Kubernikus API")
+	}
+	return cache.SetCredentials(kubeconfig)
+}
+
+func (o *ExecCredentialOptions) authenticate(cache *common.ExecCredentialCache, interactive bool) error {
+	storePasswordInKeyRing := false
+	if o.openstack.Password == "" && o.openstack.ApplicationCredentialSecret == "" && o.openstack.TokenID == "" {
+		//stdout is reserved for the credentials, kubectl passes the terminal on stdin if there is one
+		if !interactive {
+			return errors.Errorf("No password found in keyring or OS_PASSWORD. Run kubernikusctl auth init first")
+		}
+		password, err := gopass.GetPasswdPrompt("Password: ", true, os.Stdin, os.Stderr)
+		if err != nil {
+			return err
+		}
+		o.openstack.Password = string(password)
+		storePasswordInKeyRing = true
+	}
+
+	klog.V(2).Info(o.openstack.PrintDebugAuthInfo())
+	if err := o.openstack.Authenticate(); err != nil {
+		if _, ok := errors.Cause(err).(gophercloud.ErrDefault401); o.openstack.Username != "" && ok {
+			klog.V(2).Infof("Deleting password from keyring")
+			keyring.Delete("kubernikus", strings.ToLower(o.openstack.Username))
+		}
+		return errors.Wrapf(err, "Authentication failed")
+	}
+
+	if storePasswordInKeyRing {
+		klog.V(2).Infof("Storing password in keyring")
+		keyring.Set("kubernikus", strings.ToLower(o.openstack.Username), o.openstack.Password)
+	}
+
+	//tokens given on the command line are not cached
+	if o.openstack.TokenID == "" {
+		expiresAt, err := o.openstack.TokenExpiresAt()
+		if err != nil {
+			klog.V(2).Infof("Not caching token: %v", err)
+			return nil
+		}
+		cache.Token = o.openstack.Provider.TokenID
+		cache.TokenExpiresAt = expiresAt
+	}
+
+	return nil
+}
+
+// execInfo returns the ExecCredential version requested by kubectl and if the
+// plugin may prompt the user. Without KUBERNETES_EXEC_INFO it was run by hand.
+func execInfo() (string, bool) {
+	env := os.Getenv("KUBERNETES_EXEC_INFO")
+	if env == "" {
+		return common.ExecCredentialAPIVersion, true
+	}
+
+	info := clientauthv1.ExecCredential{}
+	if err := json.Unmarshal([]byte(env), &info); err != nil || info.APIVersion == "" {
+		klog.V(2).Infof("Ignoring unparsable KUBERNETES_EXEC_INFO: %v", err)
+		return common.ExecCredentialAPIVersion, false
+	}
+	return info.APIVersion, info.Spec.Interactive
+}
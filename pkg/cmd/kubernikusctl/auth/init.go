@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"os/exec"
 	"strings"
 
 	"github.com/gophercloud/gophercloud"
@@ -24,6 +25,8 @@ type InitOptions struct {
 	name           string
 	kubeconfigPath string
 	authType       string
+	execPlugin     bool
+	all            bool
 
 	openstack  *common.OpenstackClient
 	kubernikus *common.KubernikusClient
@@ -60,15 +63,34 @@ func (o *InitOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.name, "name", o.name, "Cluster Name")
 	flags.StringVar(&o.kubeconfigPath, "kubeconfig", o.kubeconfigPath, "Overwrites kubeconfig auto-detection with explicit path")
 	flags.StringVar(&o.authType, "auth-type", o.authType, "Authentication type")
+	flags.BoolVar(&o.execPlugin, "exec-plugin", o.execPlugin, "Obtain credentials with kubernikusctl auth exec-credential instead of embedding certificates")
+	flags.BoolVar(&o.all, "all", o.all, "Fetch credentials for all clusters of the project")
 }
 
 func (o *InitOptions) Validate(c *cobra.Command, args []string) (err error) {
+	if err := o.openstack.Validate(c, args); err != nil {
+		return err
+	}
+	if profile := o.openstack.Profile; profile != nil {
+		if !c.Flags().Changed("auth-type") {
+			o.authType = profile.AuthType
+		}
+		if !c.Flags().Changed("exec-plugin") {
+			o.execPlugin = profile.ExecPlugin
+		}
+	}
+	if o.execPlugin && o.authType == "oidc" {
+		return errors.Errorf("--exec-plugin can't be used with OIDC credentials")
+	}
+	if o.all && c.Flags().Changed("name") {
+		return errors.Errorf("--all can't be used with --name")
+	}
 	if o._url != "" {
 		if o.url, err = url.Parse(o._url); err != nil {
 			return errors.Errorf("Parsing the Kubernikus URL failed")
 		}
 	}
-	return o.openstack.Validate(c, args)
+	return nil
 }
 
 func (o *InitOptions) Complete(args []string) (err error) {
@@ -101,47 +123,83 @@ func (o *InitOptions) Run(c *cobra.Command) (err error) {
 		return err
 	}
 
-	if o.name == "" {
+	names := []string{o.name}
+	if o.all {
+		clusters, err := o.kubernikus.ListAllClusters()
+		if err != nil {
+			return errors.Wrap(err, "Couldn't list clusters")
+		}
+		if len(clusters) == 0 {
+			return errors.Errorf("There are no clusters in the project")
+		}
+		names = names[:0]
+		for _, cluster := range clusters {
+			names = append(names, cluster.Name)
+		}
+	} else if o.name == "" {
 		if cluster, err := o.kubernikus.GetDefaultCluster(); err != nil {
 			return errors.Wrapf(err, "You need to provide --name. Cluster Auto-Detection failed")
 		} else {
-			o.name = cluster.Name
-			klog.V(2).Infof("Detected cluster name: %v", o.name)
+			names[0] = cluster.Name
+			klog.V(2).Infof("Detected cluster name: %v", cluster.Name)
 		}
 	}
 
-	var kubeconfig string
-	var errCredentials error
-	if o.authType == "oidc" {
-		fmt.Printf("Fetching OIDC credentials for %v from %v\n", o.name, o.url)
-		kubeconfig, errCredentials = o.kubernikus.GetCredentialsOIDC(o.name)
-	} else {
-		fmt.Printf("Fetching credentials for %v from %v\n", o.name, o.url)
-		kubeconfig, errCredentials = o.kubernikus.GetCredentials(o.name)
-	}
-	if errCredentials != nil {
-		return errors.Wrap(errCredentials, "Couldn't fetch credentials from Kubernikus API")
-	}
-
-	if storePasswordInKeyRing {
-		fmt.Println("Storing password in keyring")
-		keyring.Set("kubernikus", strings.ToLower(o.openstack.Username), o.openstack.Password)
-	}
-
 	ktx, err := common.NewKubernikusContext(o.kubeconfigPath, "")
 	if err != nil {
 		return errors.Wrapf(err, "Failed to load kubeconfig")
 	}
 
-	if err := ktx.MergeAndPersist(kubeconfig); err != nil {
-		return errors.Wrapf(err, "Couldn't merge existing kubeconfig with fetched credentials")
+	failed := []string{}
+	for _, name := range names {
+		kubeconfig, err := o.fetchKubeconfig(name)
+		if err == nil {
+			err = ktx.MergeAndPersist(kubeconfig)
+			err = errors.Wrapf(err, "Couldn't merge existing kubeconfig with fetched credentials")
+		}
+		if err != nil {
+			if !o.all {
+				return err
+			}
+			fmt.Fprintf(os.Stderr, "Skipping %s: %s\n", name, err)
+			failed = append(failed, name)
+			continue
+		}
+		if storePasswordInKeyRing {
+			fmt.Println("Storing password in keyring")
+			keyring.Set("kubernikus", strings.ToLower(o.openstack.Username), o.openstack.Password)
+			storePasswordInKeyRing = false
+		}
 	}
 
 	fmt.Printf("Updated kubeconfig at %s\n", ktx.PathOptions.GetDefaultFilename())
 
+	if len(failed) > 0 {
+		return errors.Errorf("Couldn't fetch credentials for %s", strings.Join(failed, ", "))
+	}
 	return nil
 }
 
+func (o *InitOptions) fetchKubeconfig(name string) (kubeconfig string, err error) {
+	if o.authType == "oidc" {
+		fmt.Printf("Fetching OIDC credentials for %v from %v\n", name, o.url)
+		kubeconfig, err = o.kubernikus.GetCredentialsOIDC(name)
+	} else {
+		fmt.Printf("Fetching credentials for %v from %v\n", name, o.url)
+		kubeconfig, err = o.kubernikus.GetCredentials(name)
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "Couldn't fetch credentials from Kubernikus API")
+	}
+
+	if o.execPlugin {
+		if kubeconfig, err = common.UseExecPlugin(kubeconfig, execPluginCommand(), o.execPluginArgs()...); err != nil {
+			return "", errors.Wrap(err, "Couldn't configure exec credential plugin")
+		}
+	}
+	return kubeconfig, nil
+}
+
 func (o *InitOptions) setup() error {
 	klog.V(2).Info(o.openstack.PrintDebugAuthInfo())
 	fmt.Println(o.openstack.PrintAuthInfo())
@@ -162,3 +220,27 @@ func (o *InitOptions) setup() error {
 	o.kubernikus = common.NewKubernikusClient(o.url, o.openstack.Provider.TokenID)
 	return nil
 }
+
+// execPluginArgs returns the credentials given to init which can't be taken
+// from the certificate
+func (o *InitOptions) execPluginArgs() []string {
+	if o.openstack.ApplicationCredentialID != "" {
+		return []string{"--application-credential-id", o.openstack.ApplicationCredentialID}
+	}
+	if o.openstack.ApplicationCredentialName != "" {
+		return []string{"--application-credential-name", o.openstack.ApplicationCredentialName}
+	}
+	return nil
+}
+
+// execPluginCommand prefers kubernikusctl from the PATH, so that the kubeconfig
+// survives upgrades which move the binary
+func execPluginCommand() string {
+	if _, err := exec.LookPath("kubernikusctl"); err == nil {
+		return "kubernikusctl"
+	}
+	if path, err := os.Executable(); err == nil {
+		return path
+	}
+	return "kubernikusctl"
+}
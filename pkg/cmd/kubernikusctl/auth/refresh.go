@@ -83,6 +83,11 @@ func (o *RefreshOptions) Run(c *cobra.Command) error {
 		return nil
 	}
 
+	if ktx.UsesExecPlugin() {
+		klog.V(2).Infof("%s obtains credentials with the exec plugin. Doing nothing.", ktx.Context())
+		return nil
+	}
+
 	if ok, err := ktx.UserCertificateValid(); err != nil {
 		return errors.Wrap(err, "Verification of certificate failed.")
 	} else {
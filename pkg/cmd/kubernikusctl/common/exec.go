@@ -0,0 +1,187 @@
+package common
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientauthv1 "k8s.io/client-go/pkg/apis/clientauthentication/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	ExecCredentialAPIVersion = "client.authentication.k8s.io/v1"
+
+	// certificates are refreshed a bit before they expire
+	execCertificateMinValidity = 5 * time.Minute
+	execTokenMinValidity       = 1 * time.Minute
+)
+
+// DefaultExecCredentialCacheDir is where the exec credential plugin caches
+// tokens and certificates
+var DefaultExecCredentialCacheDir = filepath.Join(clientcmd.RecommendedConfigDir, "cache", "kubernikus")
+
+// ExecCredentialCache holds the Keystone token and the client certificate of
+// a kluster for the exec credential plugin
+type ExecCredentialCache struct {
+	Token                 string    `json:"token,omitempty"`
+	TokenExpiresAt        time.Time `json:"tokenExpiresAt,omitempty"`
+	ClientCertificateData string    `json:"clientCertificateData,omitempty"`
+	ClientKeyData         string    `json:"clientKeyData,omitempty"`
+	ExpiresAt             time.Time `json:"expiresAt,omitempty"`
+
+	path string
+}
+
+// LoadExecCredentialCache reads the cache entry for the given identity. A
+// missing or unreadable entry yields an empty cache.
+func LoadExecCredentialCache(dir, name string, identity ...string) (*ExecCredentialCache, error) {
+	hash := sha256.Sum256([]byte(strings.Join(append([]string{name}, identity...), "\n")))
+	cache := &ExecCredentialCache{path: filepath.Join(dir, name+"-"+hex.EncodeToString(hash[:8])+".json")}
+
+	data, err := os.ReadFile(cache.path)
+	if os.IsNotExist(err) {
+		return cache, nil
+	}
+	if err != nil {
+		return cache, errors.Wrapf(err, "Couldn't read credential cache %v", cache.path)
+	}
+	if err := json.Unmarshal(data, cache); err != nil {
+		return &ExecCredentialCache{path: cache.path}, errors.Wrapf(err, "Couldn't parse credential cache %v", cache.path)
+	}
+	return cache, nil
+}
+
+// Save writes the cache entry, it is only readable by the user
+func (c *ExecCredentialCache) Save() error {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0700); err != nil {
+		return errors.Wrapf(err, "Couldn't create credential cache directory")
+	}
+	tmp := c.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return errors.Wrapf(err, "Couldn't write credential cache %v", c.path)
+	}
+	return os.Rename(tmp, c.path)
+}
+
+func (c *ExecCredentialCache) TokenValid(now time.Time) bool {
+	return c.Token != "" && now.Add(execTokenMinValidity).Before(c.TokenExpiresAt)
+}
+
+func (c *ExecCredentialCache) CertificateValid(now time.Time) bool {
+	return c.ClientCertificateData != "" && c.ClientKeyData != "" && now.Add(execCertificateMinValidity).Before(c.ExpiresAt)
+}
+
+// SetCredentials takes the client certificate of the current context of a
+// kubeconfig fetched from the Kubernikus API
+func (c *ExecCredentialCache) SetCredentials(rawConfig string) error {
+	config, err := clientcmd.Load([]byte(rawConfig))
+	if err != nil {
+		return errors.Wrap(err, "Couldn't load kubernikus kubeconfig")
+	}
+	ktx := &KubernikusContext{Config: config, context: config.CurrentContext}
+	cert, err := ktx.getClientCertificate()
+	if err != nil {
+		return err
+	}
+	authInfo := config.AuthInfos[config.Contexts[ktx.context].AuthInfo]
+	if len(authInfo.ClientKeyData) == 0 {
+		return errors.Errorf("Couldn't find client key for context %v", ktx.context)
+	}
+
+	c.ClientCertificateData = string(authInfo.ClientCertificateData)
+	c.ClientKeyData = string(authInfo.ClientKeyData)
+	c.ExpiresAt = cert.NotAfter
+	return nil
+}
+
+// ExecCredential returns the cached certificate in the format expected by
+// kubectl
+func (c *ExecCredentialCache) ExecCredential(apiVersion string) *clientauthv1.ExecCredential {
+	expiresAt := metav1.NewTime(c.ExpiresAt)
+	return &clientauthv1.ExecCredential{
+		TypeMeta: metav1.TypeMeta{APIVersion: apiVersion, Kind: "ExecCredential"},
+		Status: &clientauthv1.ExecCredentialStatus{
+			ClientCertificateData: c.ClientCertificateData,
+			ClientKeyData:         c.ClientKeyData,
+			ExpirationTimestamp:   &expiresAt,
+		},
+	}
+}
+
+// UseExecPlugin rewrites a kubeconfig fetched from the Kubernikus API to obtain
+// the client certificate from `kubernikusctl auth exec-credential` instead of
+// embedding it. The plugin arguments are taken from the certificate.
+func UseExecPlugin(rawConfig, command string, extraArgs ...string) (string, error) {
+	config, err := clientcmd.Load([]byte(rawConfig))
+	if err != nil {
+		return "", errors.Wrap(err, "Couldn't load kubernikus kubeconfig")
+	}
+	ktx := &KubernikusContext{Config: config, context: config.CurrentContext}
+
+	kubernikusURL, err := ktx.KubernikusURL()
+	if err != nil {
+		return "", err
+	}
+	authURL, err := ktx.AuthURL()
+	if err != nil {
+		return "", err
+	}
+	projectID, err := ktx.ProjectID()
+	if err != nil {
+		return "", err
+	}
+	args := []string{"auth", "exec-credential",
+		"--url", kubernikusURL,
+		"--name", ktx.context,
+		"--auth-url", authURL,
+		"--project-id", projectID,
+	}
+	username, err := ktx.Username()
+	if err != nil {
+		return "", err
+	}
+	domain, err := ktx.UserDomainname()
+	if err != nil {
+		return "", err
+	}
+	args = append(args, "--username", username, "--user-domain-name", domain)
+	args = append(args, extraArgs...)
+
+	authInfo := config.AuthInfos[config.Contexts[ktx.context].AuthInfo]
+	authInfo.ClientCertificateData = nil
+	authInfo.ClientKeyData = nil
+	authInfo.Exec = &clientcmdapi.ExecConfig{
+		APIVersion:      ExecCredentialAPIVersion,
+		Command:         command,
+		Args:            args,
+		InteractiveMode: clientcmdapi.IfAvailableExecInteractiveMode,
+	}
+
+	data, err := clientcmd.Write(*config)
+	if err != nil {
+		return "", errors.Wrap(err, "Couldn't serialize kubeconfig")
+	}
+	return string(data), nil
+}
+
+// UsesExecPlugin tells if the context obtains its credentials from a plugin
+func (ktx *KubernikusContext) UsesExecPlugin() bool {
+	context := ktx.Config.Contexts[ktx.context]
+	if context == nil {
+		return false
+	}
+	authInfo := ktx.Config.AuthInfos[context.AuthInfo]
+	return authInfo != nil && authInfo.Exec != nil
+}
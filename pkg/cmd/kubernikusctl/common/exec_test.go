@@ -0,0 +1,114 @@
+package common
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	"github.com/sapcc/kubernikus/pkg/util"
+)
+
+func testKubeconfig(t *testing.T) (string, time.Time) {
+	kluster := &v1.Kluster{ObjectMeta: metav1.ObjectMeta{Name: "kluster-1", Labels: map[string]string{"account": "12345678"}}, Spec: models.KlusterSpec{AdvertiseAddress: "1.1.1.1", ServiceCIDR: "192.168.0.0/24"}}
+	certs := new(v1.Certificates)
+	flag.Lookup("auth-url").Value.Set("http://auth.url")
+	factory := util.NewCertificateFactory(kluster, certs, "test.local")
+	_, err := factory.Ensure()
+	require.NoError(t, err)
+	bundle, err := factory.UserCert(&models.Principal{Name: "exampleuser", Domain: "exampledomain"}, "http://kubernikus.url", 24*time.Hour)
+	require.NoError(t, err)
+	key, err := util.EncodePrivateKeyPEM(bundle.PrivateKey)
+	require.NoError(t, err)
+
+	config := clientcmdapi.Config{
+		CurrentContext: "kluster-1",
+		Clusters: map[string]*clientcmdapi.Cluster{
+			"kluster-1": {Server: "https://kluster-1.test.local", CertificateAuthorityData: []byte(certs.ApiserverClientsCACertifcate)},
+		},
+		AuthInfos: map[string]*clientcmdapi.AuthInfo{
+			"exampleuser": {ClientCertificateData: util.EncodeCertPEM(bundle.Certificate), ClientKeyData: key},
+		},
+		Contexts: map[string]*clientcmdapi.Context{
+			"kluster-1": {Cluster: "kluster-1", AuthInfo: "exampleuser"},
+		},
+	}
+	data, err := clientcmd.Write(config)
+	require.NoError(t, err)
+	return string(data), bundle.Certificate.NotAfter
+}
+
+func TestExecCredentialCache(t *testing.T) {
+	kubeconfig, notAfter := testKubeconfig(t)
+	dir := t.TempDir()
+	now := time.Now()
+
+	cache, err := LoadExecCredentialCache(dir, "kluster-1", "http://kubernikus.url", "exampleuser")
+	require.NoError(t, err)
+	assert.False(t, cache.CertificateValid(now))
+	assert.False(t, cache.TokenValid(now))
+
+	require.NoError(t, cache.SetCredentials(kubeconfig))
+	cache.Token = "token"
+	cache.TokenExpiresAt = now.Add(time.Hour)
+	assert.True(t, cache.CertificateValid(now))
+	assert.False(t, cache.CertificateValid(notAfter), "certificates are refreshed before they expire")
+	assert.True(t, cache.TokenValid(now))
+	require.NoError(t, cache.Save())
+
+	info, err := os.Stat(cache.path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	loaded, err := LoadExecCredentialCache(dir, "kluster-1", "http://kubernikus.url", "exampleuser")
+	require.NoError(t, err)
+	assert.Equal(t, cache.ClientCertificateData, loaded.ClientCertificateData)
+	assert.Equal(t, "token", loaded.Token)
+	assert.True(t, loaded.CertificateValid(now))
+
+	other, err := LoadExecCredentialCache(dir, "kluster-1", "http://kubernikus.url", "otheruser")
+	require.NoError(t, err)
+	assert.False(t, other.CertificateValid(now), "identities don't share cache entries")
+
+	credential := loaded.ExecCredential(ExecCredentialAPIVersion)
+	assert.Equal(t, ExecCredentialAPIVersion, credential.APIVersion)
+	assert.Equal(t, "ExecCredential", credential.Kind)
+	assert.Equal(t, cache.ClientKeyData, credential.Status.ClientKeyData)
+	assert.True(t, notAfter.Equal(credential.Status.ExpirationTimestamp.Time))
+}
+
+func TestUseExecPlugin(t *testing.T) {
+	kubeconfig, _ := testKubeconfig(t)
+
+	result, err := UseExecPlugin(kubeconfig, "kubernikusctl", "--application-credential-id", "appcred")
+	require.NoError(t, err)
+	config, err := clientcmd.Load([]byte(result))
+	require.NoError(t, err)
+
+	authInfo := config.AuthInfos["exampleuser"]
+	require.NotNil(t, authInfo.Exec)
+	assert.Empty(t, authInfo.ClientCertificateData)
+	assert.Empty(t, authInfo.ClientKeyData)
+	assert.Equal(t, "kubernikusctl", authInfo.Exec.Command)
+	assert.Equal(t, ExecCredentialAPIVersion, authInfo.Exec.APIVersion)
+	assert.Equal(t, []string{"auth", "exec-credential",
+		"--url", "http://kubernikus.url",
+		"--name", "kluster-1",
+		"--auth-url", "http://auth.url/v3",
+		"--project-id", "12345678",
+		"--username", "exampleuser",
+		"--user-domain-name", "exampledomain",
+		"--application-credential-id", "appcred",
+	}, authInfo.Exec.Args)
+
+	ktx := &KubernikusContext{Config: config, context: config.CurrentContext}
+	assert.True(t, ktx.UsesExecPlugin())
+}
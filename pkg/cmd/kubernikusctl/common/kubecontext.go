@@ -21,15 +21,14 @@ func NewKubernikusContext(kubeconfig, context string) (*KubernikusContext, error
 	pathOptions.LoadingRules.ExplicitPath = kubeconfig
 
 	config, err := pathOptions.GetStartingConfig()
+	if err != nil {
+		return nil, err
+	}
 
 	if context == "" { // The user did not set the --context flag
 		context = config.CurrentContext
 	}
 
-	if err != nil {
-		return nil, err
-	}
-
 	ktx := &KubernikusContext{Config: config, PathOptions: pathOptions, context: context}
 
 	return ktx, err
@@ -67,6 +66,16 @@ func (ktx *KubernikusContext) UserCertificateValid() (bool, error) {
 	return time.Now().After(cert.NotBefore) && time.Now().Before(cert.NotAfter), nil
 }
 
+// UserCertificateExpiresAt returns when the client certificate of the context expires
+func (ktx *KubernikusContext) UserCertificateExpiresAt() (time.Time, error) {
+	cert, err := ktx.getClientCertificate()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return cert.NotAfter, nil
+}
+
 func (ktx *KubernikusContext) Username() (string, error) {
 	cert, err := ktx.getClientCertificate()
 	if err != nil {
@@ -147,6 +156,10 @@ func (ktx *KubernikusContext) MergeAndPersist(rawConfig string) error {
 	if ktx.Config.CurrentContext != "" {
 		config.CurrentContext = ""
 	}
+	//replace credentials instead of merging certificates and exec plugins
+	for name := range config.AuthInfos {
+		delete(ktx.Config.AuthInfos, name)
+	}
 	if err := mergo.MergeWithOverwrite(ktx.Config, config); err != nil {
 		return errors.Wrap(err, "Couldn't merge kubeconfigs")
 	}
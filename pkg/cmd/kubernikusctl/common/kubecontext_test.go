@@ -2,6 +2,7 @@ package common
 
 import (
 	"testing"
+	"time"
 
 	flag "github.com/spf13/pflag"
 	"github.com/stretchr/testify/assert"
@@ -25,7 +26,7 @@ func TestKubernikusContext(t *testing.T) {
 	factory := util.NewCertificateFactory(kluster, certs, "test.local")
 	_, err := factory.Ensure()
 	require.NoError(t, err)
-	bundle, err := factory.UserCert(&models.Principal{Name: "exampleuser", Domain: "exampledomain"}, "http://kubernikus.url")
+	bundle, err := factory.UserCert(&models.Principal{Name: "exampleuser", Domain: "exampledomain"}, "http://kubernikus.url", 24*time.Hour)
 	require.NoError(t, err)
 
 	config := &clientcmdapi.Config{
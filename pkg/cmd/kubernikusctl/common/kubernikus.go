@@ -1,6 +1,7 @@
 package common
 
 import (
+	"net/http"
 	"net/url"
 
 	"github.com/go-openapi/runtime"
@@ -12,6 +13,10 @@ import (
 	"github.com/sapcc/kubernikus/pkg/api/models"
 )
 
+// ErrClusterChanged is returned by UpdateClusterIfMatch if the cluster was
+// changed since its ETag was read
+var ErrClusterChanged = errors.New("cluster was changed in the meantime")
+
 type KubernikusClient struct {
 	token  string
 	client *kubernikus.Kubernikus
@@ -85,9 +90,14 @@ func (k *KubernikusClient) CreateCluster(cluster *models.Kluster) error {
 	return nil
 }
 
-func (k *KubernikusClient) DeleteCluster(name string) error {
+// DeleteCluster terminates the cluster. With a grace period the cluster is
+// scaled to zero and deleted once the grace period is over.
+func (k *KubernikusClient) DeleteCluster(name string, gracePeriod string) error {
 	params := operations.NewTerminateClusterParams().WithName(name)
-	_, err := k.client.Operations.TerminateCluster(params, k.authFunc())
+	if gracePeriod != "" {
+		params = params.WithGracePeriod(&gracePeriod)
+	}
+	_, _, err := k.client.Operations.TerminateCluster(params, k.authFunc())
 	switch result := err.(type) {
 	case *operations.TerminateClusterDefault:
 		return errors.Errorf("Error while terminating cluster: %s", result.Payload.Message)
@@ -97,6 +107,37 @@ func (k *KubernikusClient) DeleteCluster(name string) error {
 	return nil
 }
 
+func (k *KubernikusClient) DeleteClusterDryRun(name string, gracePeriod string) (*models.TerminationPlan, error) {
+	dryRun := true
+	params := operations.NewTerminateClusterParams().WithName(name).WithDryRun(&dryRun)
+	if gracePeriod != "" {
+		params = params.WithGracePeriod(&gracePeriod)
+	}
+	ok, _, err := k.client.Operations.TerminateCluster(params, k.authFunc())
+	switch result := err.(type) {
+	case *operations.TerminateClusterDefault:
+		return nil, errors.Errorf("Error while terminating cluster: %s", result.Payload.Message)
+	case error:
+		return nil, errors.Wrap(err, "Error deleting cluster")
+	}
+	if ok == nil {
+		return nil, errors.Errorf("Dry run not supported by the server")
+	}
+	return ok.Payload, nil
+}
+
+func (k *KubernikusClient) UndeleteCluster(name string) (*models.Kluster, error) {
+	params := operations.NewUndeleteClusterParams().WithName(name)
+	ok, err := k.client.Operations.UndeleteCluster(params, k.authFunc())
+	switch result := err.(type) {
+	case *operations.UndeleteClusterDefault:
+		return nil, errors.Errorf("Error while undeleting cluster: %s", result.Payload.Message)
+	case error:
+		return nil, errors.Wrap(err, "Error undeleting cluster")
+	}
+	return ok.Payload, nil
+}
+
 func (k *KubernikusClient) ShowCluster(name string) (*models.Kluster, error) {
 	params := operations.NewShowClusterParams()
 	params.Name = name
@@ -110,6 +151,88 @@ func (k *KubernikusClient) ShowCluster(name string) (*models.Kluster, error) {
 	return ok.Payload, nil
 }
 
+// UpdateCluster replaces the updatable parts of the cluster spec
+func (k *KubernikusClient) UpdateCluster(cluster *models.Kluster) (*models.Kluster, error) {
+	return k.UpdateClusterIfMatch(cluster, "")
+}
+
+// UpdateClusterIfMatch is UpdateCluster only applied to the version of the
+// cluster with the given ETag, it fails with ErrClusterChanged otherwise. An
+// empty ETag updates unconditionally.
+func (k *KubernikusClient) UpdateClusterIfMatch(cluster *models.Kluster, etag string) (*models.Kluster, error) {
+	params := operations.NewUpdateClusterParams().WithName(cluster.Name).WithBody(cluster)
+	if etag != "" {
+		params.SetIfMatch(&etag)
+	}
+	ok, err := k.client.Operations.UpdateCluster(params, k.authFunc())
+	switch result := err.(type) {
+	case *operations.UpdateClusterDefault:
+		if result.Code() == http.StatusPreconditionFailed {
+			return nil, ErrClusterChanged
+		}
+		return nil, errors.Errorf("Error while updating cluster: %s", result.Payload.Message)
+	case error:
+		return nil, errors.Wrap(err, "Error updating cluster")
+	}
+	return ok.Payload, nil
+}
+
+// FindCluster is ShowCluster returning nil if the cluster doesn't exist
+func (k *KubernikusClient) FindCluster(name string) (*models.Kluster, error) {
+	cluster, _, err := k.FindClusterWithETag(name)
+	return cluster, err
+}
+
+// FindClusterWithETag is FindCluster also returning the ETag of the cluster
+// version for UpdateClusterIfMatch
+func (k *KubernikusClient) FindClusterWithETag(name string) (*models.Kluster, string, error) {
+	params := operations.NewShowClusterParams()
+	params.Name = name
+	ok, err := k.client.Operations.ShowCluster(params, k.authFunc())
+	switch result := err.(type) {
+	case *operations.ShowClusterDefault:
+		if result.Code() == 404 {
+			return nil, "", nil
+		}
+		return nil, "", errors.Errorf("Error while showing cluster: %s", result.Payload.Message)
+	case error:
+		return nil, "", errors.Wrap(err, "Getting cluster failed")
+	}
+	return ok.Payload, ok.ETag, nil
+}
+
+func (k *KubernikusClient) GetClusterEvents(name string) ([]*models.Event, error) {
+	params := operations.NewGetClusterEventsParams().WithName(name)
+	ok, err := k.client.Operations.GetClusterEvents(params, k.authFunc())
+	switch result := err.(type) {
+	case *operations.GetClusterEventsDefault:
+		return nil, errors.Errorf("Error while getting cluster events: %s", result.Payload.Message)
+	case error:
+		return nil, errors.Wrap(err, "Getting cluster events failed")
+	}
+	return ok.Payload, nil
+}
+
+func (k *KubernikusClient) ListClusterNodes(name string) ([]models.ClusterNode, error) {
+	params := operations.NewListClusterNodesParams().WithName(name)
+	ok, err := k.client.Operations.ListClusterNodes(params, k.authFunc())
+	switch result := err.(type) {
+	case *operations.ListClusterNodesDefault:
+		return nil, errors.Errorf("Error while listing cluster nodes: %s", result.Payload.Message)
+	case error:
+		return nil, errors.Wrap(err, "Listing cluster nodes failed")
+	}
+	return ok.Payload, nil
+}
+
+func (k *KubernikusClient) Info() (*models.Info, error) {
+	ok, err := k.client.Operations.Info(operations.NewInfoParams())
+	if err != nil {
+		return nil, errors.Wrap(err, "Getting info failed")
+	}
+	return ok.Payload, nil
+}
+
 func (k *KubernikusClient) GetClusterValues(account, name string) (string, error) {
 	params := operations.NewGetClusterValuesParams()
 	params.Name = name
@@ -0,0 +1,39 @@
+package common
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+func TestUpdateClusterIfMatch(t *testing.T) {
+	fake := &fakeKubernikus{cluster: models.Kluster{Name: "test", Spec: models.KlusterSpec{Version: "1.30.1"}}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	u, _ := url.Parse(server.URL)
+	client := NewKubernikusClient(u, "token")
+
+	cluster, etag, err := client.FindClusterWithETag("test")
+	require.NoError(t, err)
+	assert.Equal(t, `"0"`, etag)
+
+	cluster.Spec.Version = "1.31.2"
+	updated, err := client.UpdateClusterIfMatch(cluster, etag)
+	require.NoError(t, err)
+	assert.Equal(t, "1.31.2", updated.Spec.Version)
+
+	// the cluster changed with the first update
+	cluster.Spec.Version = "1.32.0"
+	_, err = client.UpdateClusterIfMatch(cluster, etag)
+	assert.Equal(t, ErrClusterChanged, err)
+	assert.Equal(t, "1.31.2", fake.cluster.Spec.Version)
+
+	_, err = client.UpdateCluster(cluster)
+	require.NoError(t, err)
+	assert.Equal(t, "1.32.0", fake.cluster.Spec.Version)
+}
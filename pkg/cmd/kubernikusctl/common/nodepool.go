@@ -0,0 +1,152 @@
+package common
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog"
+
+	"github.com/sapcc/kubernikus/pkg/api/client/operations"
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// NodePoolPollInterval is the interval in which --wait checks the node pool status
+var NodePoolPollInterval = 10 * time.Second
+
+// nodePoolUpdateAttempts limits how often a node pool update is retried
+// when the cluster changes concurrently
+const nodePoolUpdateAttempts = 5
+
+// NodePoolOptions are the flags to configure a node pool
+type NodePoolOptions struct {
+	flags        *pflag.FlagSet
+	Labels       []string
+	Taints       []string
+	AllowReboot  bool
+	AllowReplace bool
+	Wait         bool
+	Timeout      time.Duration
+}
+
+func (o *NodePoolOptions) BindFlags(flags *pflag.FlagSet) {
+	o.flags = flags
+	flags.StringSliceVar(&o.Labels, "labels", o.Labels, "Labels of the nodes as key=value, replaces the current labels")
+	flags.StringSliceVar(&o.Taints, "taints", o.Taints, "Taints of the nodes as key=value:Effect, replaces the current taints")
+	flags.BoolVar(&o.AllowReboot, "allow-reboot", true, "Allow automatic drain and reboot of nodes for OS updates")
+	flags.BoolVar(&o.AllowReplace, "allow-replace", true, "Allow automatic drain and replacement of nodes for Kubernetes upgrades")
+	BindWaitFlags(flags, &o.Wait, &o.Timeout)
+}
+
+func BindWaitFlags(flags *pflag.FlagSet, wait *bool, timeout *time.Duration) {
+	flags.BoolVar(wait, "wait", false, "Wait until all nodes of the pool are running and healthy")
+	flags.DurationVar(timeout, "timeout", 30*time.Minute, "How long to wait with --wait")
+}
+
+// Apply sets the flags given on the command line on the node pool
+func (o *NodePoolOptions) Apply(pool *models.NodePool) {
+	if o.changed("labels") {
+		pool.Labels = o.Labels
+	}
+	if o.changed("taints") {
+		pool.Taints = o.Taints
+	}
+	if pool.Config == nil {
+		pool.Config = &models.NodePoolConfig{}
+	}
+	if o.changed("allow-reboot") || pool.Config.AllowReboot == nil {
+		allowReboot := o.AllowReboot
+		pool.Config.AllowReboot = &allowReboot
+	}
+	if o.changed("allow-replace") || pool.Config.AllowReplace == nil {
+		allowReplace := o.AllowReplace
+		pool.Config.AllowReplace = &allowReplace
+	}
+}
+
+func (o *NodePoolOptions) changed(flag string) bool {
+	return o.flags != nil && o.flags.Changed(flag)
+}
+
+// UpdateNodePools modifies the node pools of the cluster and updates it. The
+// update is only applied to the version of the cluster it was made for,
+// concurrent changes make it start over.
+func (k *KubernikusClient) UpdateNodePools(clusterName string, update func([]models.NodePool) ([]models.NodePool, error)) error {
+	for attempt := 1; ; attempt++ {
+		show, err := k.client.Operations.ShowCluster(operations.NewShowClusterParams().WithName(clusterName), k.authFunc())
+		switch result := err.(type) {
+		case *operations.ShowClusterDefault:
+			return errors.Errorf("Error while showing cluster: %s", result.Payload.Message)
+		case error:
+			return errors.Wrap(err, "Getting cluster failed")
+		}
+		cluster := show.Payload
+		if cluster.Spec.NodePools, err = update(cluster.Spec.NodePools); err != nil {
+			return err
+		}
+
+		params := operations.NewUpdateClusterParams().WithName(cluster.Name).WithBody(cluster)
+		if show.ETag != "" {
+			params.SetIfMatch(&show.ETag)
+		}
+		_, err = k.client.Operations.UpdateCluster(params, k.authFunc())
+		switch result := err.(type) {
+		case *operations.UpdateClusterDefault:
+			if result.Code() == http.StatusPreconditionFailed && attempt < nodePoolUpdateAttempts {
+				klog.V(2).Infof("Cluster %v changed concurrently, retrying", clusterName)
+				continue
+			}
+			return errors.Errorf("Error while updating cluster: %s", result.Payload.Message)
+		case error:
+			return errors.Wrap(err, "Error updating cluster")
+		}
+		return nil
+	}
+}
+
+// WaitForNodePool polls the cluster until the nodes of the pool are running
+// and healthy. A size of -1 waits for the pool to be removed.
+func (k *KubernikusClient) WaitForNodePool(clusterName, poolName string, size int64, timeout time.Duration) error {
+	err := wait.PollImmediate(NodePoolPollInterval, timeout, func() (bool, error) { //nolint:staticcheck
+		cluster, err := k.ShowCluster(clusterName)
+		if err != nil {
+			return false, err
+		}
+		info, found := nodePoolInfo(cluster, poolName)
+		if size < 0 {
+			return !found, nil
+		}
+		if !found {
+			klog.V(2).Infof("Nodepool %v not in status yet", poolName)
+			return false, nil
+		}
+		fmt.Printf("Nodepool %v: %d/%d running, %d/%d healthy\n", poolName, info.Running, size, info.Healthy, size)
+		return info.Size == size && info.Running == size && info.Healthy == size, nil
+	})
+	if err == wait.ErrWaitTimeout { //nolint:staticcheck
+		return errors.Errorf("Nodepool %v not ready after %v", poolName, timeout)
+	}
+	return err
+}
+
+func nodePoolInfo(cluster *models.Kluster, poolName string) (models.NodePoolInfo, bool) {
+	for _, info := range cluster.Status.NodePools {
+		if info.Name == poolName {
+			return info, true
+		}
+	}
+	return models.NodePoolInfo{}, false
+}
+
+// FindNodePool returns the index of the pool, -1 if it doesn't exist
+func FindNodePool(pools []models.NodePool, name string) int {
+	for i, pool := range pools {
+		if pool.Name == name {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,141 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// fakeKubernikus serves ShowCluster and UpdateCluster for a single cluster,
+// the pools are reported ready once ready is set
+type fakeKubernikus struct {
+	sync.Mutex
+	cluster models.Kluster
+	version int
+	ready   bool
+}
+
+func (f *fakeKubernikus) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+	if r.URL.Path != "/api/v1/clusters/test" {
+		http.NotFound(w, r)
+		return
+	}
+	etag := fmt.Sprintf(`"%d"`, f.version)
+	if r.Method == http.MethodPut {
+		if match := r.Header.Get("If-Match"); match != "" && match != etag {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusPreconditionFailed)
+			json.NewEncoder(w).Encode(models.Error{Code: http.StatusPreconditionFailed, Message: "cluster was changed in the meantime"}) //nolint:errcheck
+			return
+		}
+		f.version++
+		etag = fmt.Sprintf(`"%d"`, f.version)
+		var cluster models.Kluster
+		if err := json.NewDecoder(r.Body).Decode(&cluster); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		f.cluster.Spec = cluster.Spec
+	}
+	f.cluster.Status.NodePools = nil
+	for _, pool := range f.cluster.Spec.NodePools {
+		info := models.NodePoolInfo{Name: pool.Name, Size: pool.Size}
+		if f.ready {
+			info.Running, info.Healthy = pool.Size, pool.Size
+		}
+		f.cluster.Status.NodePools = append(f.cluster.Status.NodePools, info)
+	}
+	f.ready = true
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", etag)
+	json.NewEncoder(w).Encode(f.cluster) //nolint:errcheck
+}
+
+func TestNodePoolLifecycle(t *testing.T) {
+	NodePoolPollInterval = 10 * time.Millisecond
+	fake := &fakeKubernikus{cluster: models.Kluster{Name: "test", Spec: models.KlusterSpec{NodePools: []models.NodePool{{Name: "default", Flavor: "m1.small", AvailabilityZone: "az-a", Size: 1}}}}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	u, _ := url.Parse(server.URL)
+	client := NewKubernikusClient(u, "token")
+
+	err := client.UpdateNodePools("test", func(pools []models.NodePool) ([]models.NodePool, error) {
+		pools[FindNodePool(pools, "default")].Size = 3
+		return pools, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), fake.cluster.Spec.NodePools[0].Size)
+	assert.NoError(t, client.WaitForNodePool("test", "default", 3, time.Second))
+
+	err = client.UpdateNodePools("test", func(pools []models.NodePool) ([]models.NodePool, error) {
+		return pools[:0], nil
+	})
+	require.NoError(t, err)
+	assert.NoError(t, client.WaitForNodePool("test", "default", -1, time.Second))
+
+	// the pool never reaches the size
+	fake.cluster.Spec.NodePools = []models.NodePool{{Name: "default", Size: 2}}
+	assert.Error(t, client.WaitForNodePool("test", "default", 3, 50*time.Millisecond))
+}
+
+func TestUpdateNodePoolsConflict(t *testing.T) {
+	fake := &fakeKubernikus{cluster: models.Kluster{Name: "test", Spec: models.KlusterSpec{NodePools: []models.NodePool{{Name: "default", Size: 1}}}}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	u, _ := url.Parse(server.URL)
+	client := NewKubernikusClient(u, "token")
+
+	attempts := 0
+	err := client.UpdateNodePools("test", func(pools []models.NodePool) ([]models.NodePool, error) {
+		attempts++
+		if attempts == 1 {
+			// another client adds a pool in the meantime
+			fake.Lock()
+			fake.cluster.Spec.NodePools = append(fake.cluster.Spec.NodePools, models.NodePool{Name: "other", Size: 2})
+			fake.version++
+			fake.Unlock()
+		}
+		pools[FindNodePool(pools, "default")].Size = 3
+		return pools, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, []models.NodePool{{Name: "default", Size: 3}, {Name: "other", Size: 2}}, fake.cluster.Spec.NodePools, "the concurrent change is kept")
+
+	// an update which never gets through gives up
+	err = client.UpdateNodePools("test", func(pools []models.NodePool) ([]models.NodePool, error) {
+		fake.Lock()
+		fake.version++
+		fake.Unlock()
+		return pools, nil
+	})
+	assert.EqualError(t, err, "Error while updating cluster: cluster was changed in the meantime")
+}
+
+func TestNodePoolOptionsApply(t *testing.T) {
+	var o NodePoolOptions
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	o.BindFlags(flags)
+	require.NoError(t, flags.Parse([]string{"--labels", "a=b,c=d", "--allow-replace=false"}))
+
+	allowReboot := false
+	pool := models.NodePool{Taints: []string{"x=y:NoSchedule"}, Config: &models.NodePoolConfig{AllowReboot: &allowReboot}}
+	o.Apply(&pool)
+	assert.Equal(t, []string{"a=b", "c=d"}, pool.Labels)
+	assert.Equal(t, []string{"x=y:NoSchedule"}, pool.Taints)
+	assert.False(t, *pool.Config.AllowReboot)
+	assert.False(t, *pool.Config.AllowReplace)
+}
@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack"
@@ -27,6 +28,14 @@ type OpenstackClient struct {
 	Identity *gophercloud.ServiceClient
 	CertFile string
 	KeyFile  string
+
+	// ProfileName selects a profile of the kubernikusctl config, the
+	// current one is used if empty
+	ProfileName string
+	// Profile is the profile applied by Validate
+	Profile *Profile
+	// IgnoreProfiles skips the profiles, e.g. when all flags are given
+	IgnoreProfiles bool
 }
 
 func NewOpenstackClient() *OpenstackClient {
@@ -35,7 +44,7 @@ func NewOpenstackClient() *OpenstackClient {
 			IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
 			Password:         os.Getenv("OS_PASSWORD"),
 			AllowReauth:      true,
-		}, nil, nil, "", "",
+		}, nil, nil, "", "", "", nil, false,
 	}
 }
 
@@ -56,9 +65,16 @@ func (o *OpenstackClient) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.CertFile, "client-cert", "", "client tls certificate [OS_CERT]")
 	flags.StringVar(&o.KeyFile, "client-key", "", "client tls private key [OS_KEY]")
 	flags.StringVar(&o.TokenID, "token", "", "Token to authenticate with [OS_TOKEN]")
+	if !o.IgnoreProfiles {
+		flags.StringVar(&o.ProfileName, "profile", o.ProfileName, "Profile of the kubernikusctl config to use [KUBERNIKUS_PROFILE]")
+	}
 }
 
 func (o *OpenstackClient) Validate(c *cobra.Command, args []string) error {
+	if err := o.applyProfile(c.Flags()); err != nil {
+		return err
+	}
+
 	if o.TokenID == "" {
 		o.TokenID = os.Getenv("OS_TOKEN")
 	}
@@ -284,7 +300,30 @@ func (o *OpenstackClient) Authenticate() error {
 	return openstack.AuthenticateV3(o.Provider, o, gophercloud.EndpointOpts{})
 }
 
+// TokenExpiresAt returns when the token obtained by Authenticate expires
+func (o *OpenstackClient) TokenExpiresAt() (time.Time, error) {
+	var token *tokens.Token
+	var err error
+	switch r := o.Provider.GetAuthResult().(type) {
+	case tokens.CreateResult:
+		token, err = r.ExtractToken()
+	case tokens.GetResult:
+		token, err = r.ExtractToken()
+	default:
+		return time.Time{}, errors.Errorf("got unexpected AuthResult type %T", r)
+	}
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "Couldn't extract token")
+	}
+	return token.ExpiresAt, nil
+}
+
 func (o *OpenstackClient) DefaultKubernikusURL() (*url.URL, error) {
+	if o.Profile != nil && o.Profile.URL != "" {
+		url, err := url.Parse(o.Profile.URL)
+		return url, errors.Wrapf(err, "The URL for the Kubernikus API of profile %s is not parsable", o.Profile.Name)
+	}
+
 	r := o.Provider.GetAuthResult()
 	if r == nil {
 		return nil, errors.Errorf("Couldn't fetch service catalog")
@@ -299,7 +338,7 @@ func (o *OpenstackClient) DefaultKubernikusURL() (*url.URL, error) {
 	case tokens.GetResult:
 		catalog, err = r.ExtractServiceCatalog()
 	default:
-		return nil, errors.Errorf("got unexpected AuthResult type %t", r)
+		return nil, errors.Errorf("got unexpected AuthResult type %T", r)
 	}
 	if catalog == nil {
 		return nil, errors.Errorf("Couldn't fetch service catalog")
@@ -308,11 +347,15 @@ func (o *OpenstackClient) DefaultKubernikusURL() (*url.URL, error) {
 		return nil, errors.Wrap(err, "Couldn't fetch service catalog")
 	}
 
+	region := ""
+	if o.Profile != nil {
+		region = o.Profile.Region
+	}
 	result := ""
 	for _, service := range catalog.Entries {
 		if service.Type == "kubernikus" {
 			for _, endpoint := range service.Endpoints {
-				if endpoint.Interface == "public" {
+				if endpoint.Interface == "public" && (region == "" || endpoint.Region == region) {
 					result = endpoint.URL
 				}
 			}
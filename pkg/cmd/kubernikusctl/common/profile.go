@@ -0,0 +1,152 @@
+package common
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/yaml"
+)
+
+// DefaultConfigPath is where kubernikusctl keeps its profiles unless
+// KUBERNIKUSCTL_CONFIG points elsewhere
+var DefaultConfigPath = filepath.Join(clientcmd.RecommendedConfigDir, "kubernikusctl.yaml")
+
+// Profile holds the flags needed to authenticate against a region and
+// project. Passwords and secrets are never stored.
+type Profile struct {
+	Name                      string `json:"name"`
+	Region                    string `json:"region,omitempty"`
+	AuthURL                   string `json:"authURL,omitempty"`
+	ProjectID                 string `json:"projectID,omitempty"`
+	ProjectName               string `json:"projectName,omitempty"`
+	ProjectDomainID           string `json:"projectDomainID,omitempty"`
+	ProjectDomainName         string `json:"projectDomainName,omitempty"`
+	Username                  string `json:"username,omitempty"`
+	UserDomainName            string `json:"userDomainName,omitempty"`
+	ApplicationCredentialID   string `json:"applicationCredentialID,omitempty"`
+	ApplicationCredentialName string `json:"applicationCredentialName,omitempty"`
+	URL                       string `json:"url,omitempty"`
+	AuthType                  string `json:"authType,omitempty"`
+	ExecPlugin                bool   `json:"execPlugin,omitempty"`
+}
+
+type Config struct {
+	CurrentProfile string    `json:"currentProfile,omitempty"`
+	Profiles       []Profile `json:"profiles"`
+}
+
+func ConfigPath() string {
+	if path := os.Getenv("KUBERNIKUSCTL_CONFIG"); path != "" {
+		return path
+	}
+	return DefaultConfigPath
+}
+
+// LoadConfig reads the config file, a missing file is an empty config
+func LoadConfig(path string) (*Config, error) {
+	config := &Config{}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return config, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "Couldn't read %s", path)
+	}
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, errors.Wrapf(err, "Couldn't parse %s", path)
+	}
+	return config, nil
+}
+
+func (c *Config) Save(path string) error {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return errors.Wrapf(err, "Couldn't create %s", filepath.Dir(path))
+	}
+	return errors.Wrapf(os.WriteFile(path, data, 0600), "Couldn't write %s", path)
+}
+
+// Profile returns the profile with the given name or nil
+func (c *Config) Profile(name string) *Profile {
+	for i := range c.Profiles {
+		if c.Profiles[i].Name == name {
+			return &c.Profiles[i]
+		}
+	}
+	return nil
+}
+
+// SetProfile adds the profile or replaces the one with the same name
+func (c *Config) SetProfile(profile Profile) {
+	if existing := c.Profile(profile.Name); existing != nil {
+		*existing = profile
+		return
+	}
+	c.Profiles = append(c.Profiles, profile)
+}
+
+// applyProfile fills the options from the selected profile. Values given on
+// the command line win, environment variables are only used for what the
+// profile leaves empty.
+func (o *OpenstackClient) applyProfile(flags *pflag.FlagSet) error {
+	if o.IgnoreProfiles {
+		return nil
+	}
+	config, err := LoadConfig(ConfigPath())
+	if err != nil {
+		return err
+	}
+	name := o.ProfileName
+	if name == "" {
+		name = os.Getenv("KUBERNIKUS_PROFILE")
+	}
+	if name == "" {
+		name = config.CurrentProfile
+	}
+	if name == "" {
+		return nil
+	}
+	profile := config.Profile(name)
+	if profile == nil {
+		return errors.Errorf("Profile %s doesn't exist in %s", name, ConfigPath())
+	}
+	o.Profile = profile
+
+	changed := func(names ...string) bool {
+		for _, name := range names {
+			if f := flags.Lookup(name); f != nil && f.Changed {
+				return true
+			}
+		}
+		return false
+	}
+
+	if profile.AuthURL != "" && !changed("auth-url") {
+		o.IdentityEndpoint = profile.AuthURL
+	}
+	// application credentials are scoped already
+	appCred := profile.ApplicationCredentialID != "" || profile.ApplicationCredentialName != "" ||
+		changed("application-credential-id", "application-credential-name")
+	if !changed("username", "user-id", "user-domain-name", "user-domain-id", "application-credential-id", "application-credential-name") {
+		if profile.ApplicationCredentialID != "" || profile.ApplicationCredentialName != "" {
+			o.ApplicationCredentialID = profile.ApplicationCredentialID
+			o.ApplicationCredentialName = profile.ApplicationCredentialName
+		} else if profile.Username != "" {
+			o.Username, o.UserID = profile.Username, ""
+			o.DomainName, o.DomainID = profile.UserDomainName, ""
+		}
+	}
+	if !appCred && (profile.ProjectID != "" || profile.ProjectName != "") && !changed("project-id", "project-name", "project-domain-id", "project-domain-name") {
+		o.Scope.ProjectID = profile.ProjectID
+		o.Scope.ProjectName = profile.ProjectName
+		o.Scope.DomainID = profile.ProjectDomainID
+		o.Scope.DomainName = profile.ProjectDomainName
+	}
+	return nil
+}
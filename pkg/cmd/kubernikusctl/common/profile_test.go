@@ -0,0 +1,99 @@
+package common
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "kubernikusctl.yaml")
+
+	config, err := LoadConfig(path)
+	require.NoError(t, err, "a missing config is empty")
+	assert.Empty(t, config.Profiles)
+
+	config.SetProfile(Profile{Name: "eu-de-1", AuthURL: "https://identity.eu-de-1/v3"})
+	config.SetProfile(Profile{Name: "na-us-1", AuthURL: "https://identity.na-us-1/v3"})
+	config.SetProfile(Profile{Name: "eu-de-1", AuthURL: "https://keystone.eu-de-1/v3"})
+	config.CurrentProfile = "na-us-1"
+	require.NoError(t, config.Save(path))
+
+	config, err = LoadConfig(path)
+	require.NoError(t, err)
+	assert.Len(t, config.Profiles, 2)
+	assert.Equal(t, "na-us-1", config.CurrentProfile)
+	assert.Equal(t, "https://keystone.eu-de-1/v3", config.Profile("eu-de-1").AuthURL)
+	assert.Nil(t, config.Profile("ap-jp-1"))
+}
+
+func TestApplyProfile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "kubernikusctl.yaml")
+	t.Setenv("KUBERNIKUSCTL_CONFIG", path)
+	t.Setenv("KUBERNIKUS_PROFILE", "")
+	t.Setenv("OS_USERNAME", "envuser")
+	t.Setenv("OS_AUTH_URL", "https://identity.env/v3")
+
+	config := &Config{
+		CurrentProfile: "user",
+		Profiles: []Profile{
+			{Name: "user", AuthURL: "https://identity.eu-de-1/v3", Username: "d012345", UserDomainName: "monsoon3", ProjectName: "myproject", ProjectDomainName: "monsoon3", URL: "https://kubernikus.eu-de-1"},
+			{Name: "appcred", AuthURL: "https://identity.na-us-1/v3", ApplicationCredentialID: "cred-id", ProjectID: "ignored"},
+		},
+	}
+	require.NoError(t, config.Save(path))
+
+	newClient := func(args ...string) (*OpenstackClient, *pflag.FlagSet) {
+		o := NewOpenstackClient()
+		flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		o.BindFlags(flags)
+		require.NoError(t, flags.Parse(args))
+		return o, flags
+	}
+
+	o, flags := newClient()
+	require.NoError(t, o.applyProfile(flags))
+	assert.Equal(t, "https://identity.eu-de-1/v3", o.IdentityEndpoint, "the profile wins over the environment")
+	assert.Equal(t, "d012345", o.Username)
+	assert.Equal(t, "monsoon3", o.DomainName)
+	assert.Equal(t, "myproject", o.Scope.ProjectName)
+	url, err := o.DefaultKubernikusURL()
+	require.NoError(t, err)
+	assert.Equal(t, "https://kubernikus.eu-de-1", url.String())
+
+	o, flags = newClient("--auth-url", "https://identity.flag/v3", "--project-id", "12345678", "--username", "other")
+	require.NoError(t, o.applyProfile(flags))
+	assert.Equal(t, "https://identity.flag/v3", o.IdentityEndpoint, "flags win over the profile")
+	assert.Equal(t, "other", o.Username)
+	assert.Equal(t, "", o.DomainName)
+	assert.Equal(t, "12345678", o.Scope.ProjectID)
+	assert.Equal(t, "", o.Scope.ProjectName)
+
+	o, flags = newClient("--username", "other")
+	require.NoError(t, o.applyProfile(flags))
+	assert.Equal(t, "other", o.Username)
+	assert.Equal(t, "myproject", o.Scope.ProjectName, "the project scope is kept for other users")
+	assert.Equal(t, "monsoon3", o.Scope.DomainName)
+
+	o, flags = newClient("--application-credential-id", "other-cred")
+	require.NoError(t, o.applyProfile(flags))
+	assert.Equal(t, "other-cred", o.ApplicationCredentialID)
+	assert.Equal(t, "", o.Scope.ProjectName)
+
+	o, flags = newClient("--profile", "appcred")
+	require.NoError(t, o.applyProfile(flags))
+	assert.Equal(t, "cred-id", o.ApplicationCredentialID)
+	assert.Equal(t, "", o.Scope.ProjectID, "application credentials are scoped already")
+
+	o, flags = newClient("--profile", "missing")
+	assert.Error(t, o.applyProfile(flags))
+
+	o, flags = newClient()
+	o.IgnoreProfiles = true
+	require.NoError(t, o.applyProfile(flags))
+	assert.Nil(t, o.Profile)
+	assert.Equal(t, "https://identity.env/v3", o.IdentityEndpoint)
+}
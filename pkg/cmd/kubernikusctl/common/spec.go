@@ -0,0 +1,36 @@
+package common
+
+import (
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"k8s.io/klog"
+	"sigs.k8s.io/yaml"
+)
+
+// ReadSpec reads a cluster spec in YAML or JSON from the file or from stdin
+// if no file is given. The spec is returned as JSON.
+func ReadSpec(file string) ([]byte, error) {
+	var raw []byte
+	var err error
+	klog.V(2).Infof("ReadFile: %v", file)
+	if file != "" {
+		if raw, err = os.ReadFile(file); err != nil {
+			klog.V(2).Infof("error reading spec file: %v", err)
+			return nil, errors.Wrap(err, "Error reading from spec file")
+		}
+	} else {
+		if raw, err = io.ReadAll(os.Stdin); err != nil {
+			klog.V(2).Infof("error reading from stdin: %v", err)
+			return nil, errors.Wrap(err, "Error reading from Stdin")
+		}
+	}
+	klog.V(2).Infof("Raw read: \n%v", string(raw))
+
+	spec, err := yaml.YAMLToJSON(raw)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error parsing spec")
+	}
+	return spec, nil
+}
@@ -0,0 +1,25 @@
+package kubernikusctl
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/sapcc/kubernikus/pkg/cmd/kubernikusctl/config"
+)
+
+func NewConfigCommand() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "config",
+		Short: "Manages the profiles of kubernikusctl",
+		Long: `Profiles store the flags for authenticating against a region and project.
+Commands use the current profile unless --profile or KUBERNIKUS_PROFILE selects another one.
+Flags given on the command line take precedence over the profile.`,
+	}
+
+	c.AddCommand(
+		config.NewSetProfileCommand(),
+		config.NewUseProfileCommand(),
+		config.NewGetProfilesCommand(),
+	)
+
+	return c
+}
@@ -0,0 +1,186 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/sapcc/kubernikus/pkg/cmd/kubernikusctl/common"
+)
+
+type ProfileOptions struct {
+	path    string
+	profile common.Profile
+	use     bool
+}
+
+func (o *ProfileOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.path, "config", common.ConfigPath(), "Path of the kubernikusctl config [KUBERNIKUSCTL_CONFIG]")
+}
+
+func NewSetProfileCommand() *cobra.Command {
+	o := &ProfileOptions{}
+	c := &cobra.Command{
+		Use:   "set-profile NAME",
+		Short: "Creates or changes a profile",
+		Long: `Creates a profile or changes the given fields of an existing one.
+The fields are used as defaults for the flags of the same name.`,
+		Example: `  kubernikusctl config set-profile eu-de-1 --auth-url https://identity-3.eu-de-1.cloud.sap/v3 \
+    --username d012345 --user-domain-name monsoon3 --project-name myproject --project-domain-name monsoon3`,
+		Run: func(c *cobra.Command, args []string) {
+			common.CheckError(o.setProfile(c.Flags(), args))
+		},
+	}
+	o.BindFlags(c.Flags())
+	p := &o.profile
+	c.Flags().StringVar(&p.Region, "region", "", "Region to pick the Kubernikus API from the service catalog")
+	c.Flags().StringVar(&p.AuthURL, "auth-url", "", "Openstack Keystone Endpoint URL")
+	c.Flags().StringVar(&p.ProjectID, "project-id", "", "Scope to this project")
+	c.Flags().StringVar(&p.ProjectName, "project-name", "", "Scope to this project. Also requires --project-domain-name/--project-domain-id")
+	c.Flags().StringVar(&p.ProjectDomainID, "project-domain-id", "", "Domain of the project")
+	c.Flags().StringVar(&p.ProjectDomainName, "project-domain-name", "", "Domain of the project")
+	c.Flags().StringVar(&p.Username, "username", "", "User name")
+	c.Flags().StringVar(&p.UserDomainName, "user-domain-name", "", "User domain")
+	c.Flags().StringVar(&p.ApplicationCredentialID, "application-credential-id", "", "Project application credential id, the secret is taken from OS_APPLICATION_CREDENTIAL_SECRET")
+	c.Flags().StringVar(&p.ApplicationCredentialName, "application-credential-name", "", "Project application credential name")
+	c.Flags().StringVar(&p.URL, "url", "", "URL for Kubernikus API, auto-detected if empty")
+	c.Flags().StringVar(&p.AuthType, "auth-type", "", "Authentication type for auth init")
+	c.Flags().BoolVar(&p.ExecPlugin, "exec-plugin", false, "Use the exec credential plugin for auth init")
+	c.Flags().BoolVar(&o.use, "use", false, "Make it the current profile")
+	return c
+}
+
+func NewUseProfileCommand() *cobra.Command {
+	o := &ProfileOptions{}
+	c := &cobra.Command{
+		Use:   "use-profile NAME",
+		Short: "Sets the current profile",
+		Run: func(c *cobra.Command, args []string) {
+			common.CheckError(o.useProfile(args))
+		},
+	}
+	o.BindFlags(c.Flags())
+	return c
+}
+
+func NewGetProfilesCommand() *cobra.Command {
+	o := &ProfileOptions{}
+	c := &cobra.Command{
+		Use:   "get-profiles",
+		Short: "Lists the profiles",
+		Run: func(c *cobra.Command, args []string) {
+			common.CheckError(o.getProfiles())
+		},
+	}
+	o.BindFlags(c.Flags())
+	return c
+}
+
+func (o *ProfileOptions) setProfile(flags *pflag.FlagSet, args []string) error {
+	if len(args) != 1 {
+		return errors.Errorf("Please supply the name of the profile")
+	}
+	config, err := common.LoadConfig(o.path)
+	if err != nil {
+		return err
+	}
+
+	profile := common.Profile{Name: args[0]}
+	if existing := config.Profile(args[0]); existing != nil {
+		profile = *existing
+	}
+	mergeProfile(&profile, o.profile, flags.Changed)
+	config.SetProfile(profile)
+	if o.use || config.CurrentProfile == "" {
+		config.CurrentProfile = profile.Name
+	}
+	if err := config.Save(o.path); err != nil {
+		return err
+	}
+	fmt.Printf("Profile %s saved in %s\n", profile.Name, o.path)
+	return nil
+}
+
+// mergeProfile copies the fields given on the command line
+func mergeProfile(profile *common.Profile, from common.Profile, changed func(string) bool) {
+	fields := []struct {
+		flag     string
+		to, from *string
+	}{
+		{"region", &profile.Region, &from.Region},
+		{"auth-url", &profile.AuthURL, &from.AuthURL},
+		{"project-id", &profile.ProjectID, &from.ProjectID},
+		{"project-name", &profile.ProjectName, &from.ProjectName},
+		{"project-domain-id", &profile.ProjectDomainID, &from.ProjectDomainID},
+		{"project-domain-name", &profile.ProjectDomainName, &from.ProjectDomainName},
+		{"username", &profile.Username, &from.Username},
+		{"user-domain-name", &profile.UserDomainName, &from.UserDomainName},
+		{"application-credential-id", &profile.ApplicationCredentialID, &from.ApplicationCredentialID},
+		{"application-credential-name", &profile.ApplicationCredentialName, &from.ApplicationCredentialName},
+		{"url", &profile.URL, &from.URL},
+		{"auth-type", &profile.AuthType, &from.AuthType},
+	}
+	for _, field := range fields {
+		if changed(field.flag) {
+			*field.to = *field.from
+		}
+	}
+	if changed("exec-plugin") {
+		profile.ExecPlugin = from.ExecPlugin
+	}
+}
+
+func (o *ProfileOptions) useProfile(args []string) error {
+	if len(args) != 1 {
+		return errors.Errorf("Please supply the name of the profile")
+	}
+	config, err := common.LoadConfig(o.path)
+	if err != nil {
+		return err
+	}
+	if config.Profile(args[0]) == nil {
+		return errors.Errorf("Profile %s doesn't exist in %s", args[0], o.path)
+	}
+	config.CurrentProfile = args[0]
+	if err := config.Save(o.path); err != nil {
+		return err
+	}
+	fmt.Printf("Switched to profile %s\n", args[0])
+	return nil
+}
+
+func (o *ProfileOptions) getProfiles() error {
+	config, err := common.LoadConfig(o.path)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "CURRENT\tNAME\tREGION\tPROJECT\tUSER")
+	for _, profile := range config.Profiles {
+		current := ""
+		if profile.Name == config.CurrentProfile {
+			current = "*"
+		}
+		project := profile.ProjectID
+		if project == "" {
+			project = inDomain(profile.ProjectName, profile.ProjectDomainName)
+		}
+		user := inDomain(profile.Username, profile.UserDomainName)
+		if profile.ApplicationCredentialID != "" || profile.ApplicationCredentialName != "" {
+			user = "appcred:" + profile.ApplicationCredentialID + profile.ApplicationCredentialName
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", current, profile.Name, profile.Region, project, user)
+	}
+	return w.Flush()
+}
+
+func inDomain(name, domain string) string {
+	if name == "" || domain == "" {
+		return name
+	}
+	return name + "@" + domain
+}
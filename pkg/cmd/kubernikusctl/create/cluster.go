@@ -2,8 +2,6 @@ package create
 
 import (
 	"fmt"
-	"io"
-	"os"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -11,12 +9,13 @@ import (
 
 	"github.com/sapcc/kubernikus/pkg/api/models"
 	"github.com/sapcc/kubernikus/pkg/cmd"
+	"github.com/sapcc/kubernikus/pkg/cmd/kubernikusctl/common"
 )
 
 func (o *CreateOptions) NewClusterCommand() *cobra.Command {
 	c := &cobra.Command{
 		Use:     "cluster",
-		Short:   "Creates a cluster defined in a spec expected at stdin",
+		Short:   "Creates a cluster defined in a YAML or JSON spec expected at stdin",
 		Aliases: []string{"clusters"},
 		PreRun:  o.clusterPreRun,
 		Run:     o.clusterRun,
@@ -31,23 +30,8 @@ func (o *CreateOptions) clusterPreRun(c *cobra.Command, args []string) {
 }
 
 func (o *CreateOptions) clusterRun(c *cobra.Command, args []string) {
-	var raw []byte
-	var err error
-	klog.V(2).Infof("ReadFile: %v", o.ReadFile)
-	if o.ReadFile != "" {
-		raw, err = os.ReadFile(o.ReadFile)
-		if err != nil {
-			klog.V(2).Infof("error reading spec file: %v", err)
-			cmd.CheckError(errors.Wrap(err, "Error reading from spec file"))
-		}
-	} else {
-		raw, err = io.ReadAll(os.Stdin)
-		if err != nil {
-			klog.V(2).Infof("error reading from stdin: %v", err)
-			cmd.CheckError(errors.Wrap(err, "Error reading from Stdin"))
-		}
-	}
-	klog.V(2).Infof("Raw read: \n%v", string(raw))
+	raw, err := common.ReadSpec(o.ReadFile)
+	cmd.CheckError(err)
 	var cluster models.Kluster
 	cmd.CheckError(cluster.UnmarshalBinary(raw))
 	klog.V(2).Infof("cluster: %v", cluster)
@@ -19,6 +19,12 @@ type CreateOptions struct {
 	Openstack  *common.OpenstackClient
 	Kubernikus *common.KubernikusClient
 	ReadFile   string
+
+	nodePool         common.NodePoolOptions
+	flavor           string
+	size             int64
+	image            string
+	availabilityZone string
 }
 
 func (o *CreateOptions) PersistentPreRun(c *cobra.Command, args []string) {
@@ -0,0 +1,73 @@
+package create
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	"github.com/sapcc/kubernikus/pkg/cmd"
+	"github.com/sapcc/kubernikus/pkg/cmd/kubernikusctl/common"
+)
+
+func (o *CreateOptions) NewNodePoolCommand() *cobra.Command {
+	c := &cobra.Command{
+		Use:     "nodepool [cluster] [name]",
+		Short:   "Adds a nodepool to a cluster",
+		Aliases: []string{"nodepools", "np"},
+		PreRun:  o.nodePoolPreRun,
+		Run:     o.nodePoolRun,
+	}
+	c.Flags().StringVar(&o.flavor, "flavor", "", "Flavor of the nodes")
+	c.Flags().Int64Var(&o.size, "size", 0, "Number of nodes")
+	c.Flags().StringVar(&o.image, "image", "", "Image of the nodes (default: server default)")
+	c.Flags().StringVar(&o.availabilityZone, "availability-zone", "", "Availability zone of the nodes (default: the one of the first nodepool)")
+	o.nodePool.BindFlags(c.Flags())
+	return c
+}
+
+func (o *CreateOptions) nodePoolPreRun(c *cobra.Command, args []string) {
+	cmd.CheckError(validateNodePoolCommandArgs(args))
+	if o.flavor == "" {
+		cmd.CheckError(errors.Errorf("--flavor is required"))
+	}
+	cmd.CheckError(o.SetupKubernikusClient())
+}
+
+func (o *CreateOptions) nodePoolRun(c *cobra.Command, args []string) {
+	cluster, name := args[0], args[1]
+	err := o.Kubernikus.UpdateNodePools(cluster, func(pools []models.NodePool) ([]models.NodePool, error) {
+		if common.FindNodePool(pools, name) >= 0 {
+			return nil, errors.Errorf("Nodepool %v already exists", name)
+		}
+		pool := models.NodePool{
+			Name:             name,
+			Flavor:           o.flavor,
+			Size:             o.size,
+			Image:            o.image,
+			AvailabilityZone: o.availabilityZone,
+		}
+		if pool.AvailabilityZone == "" {
+			if len(pools) == 0 {
+				return nil, errors.Errorf("--availability-zone is required for the first nodepool")
+			}
+			pool.AvailabilityZone = pools[0].AvailabilityZone
+		}
+		o.nodePool.Apply(&pool)
+		return append(pools, pool), nil
+	})
+	cmd.CheckError(err)
+	fmt.Printf("Nodepool %v created in cluster %v.\n", name, cluster)
+
+	if o.nodePool.Wait {
+		cmd.CheckError(o.Kubernikus.WaitForNodePool(cluster, name, o.size, o.nodePool.Timeout))
+	}
+}
+
+func validateNodePoolCommandArgs(args []string) error {
+	if len(args) != 2 {
+		return errors.Errorf("Please supply the name of the cluster and the nodepool, %v", args)
+	}
+	return nil
+}
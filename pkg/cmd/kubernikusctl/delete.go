@@ -24,5 +24,6 @@ func NewDeleteCommand() *cobra.Command {
 	}
 	o.BindFlags(c.PersistentFlags())
 	c.AddCommand(o.NewClusterCommand())
+	c.AddCommand(o.NewNodePoolCommand())
 	return c
 }
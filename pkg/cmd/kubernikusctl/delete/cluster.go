@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/sapcc/kubernikus/pkg/cmd"
+	"github.com/sapcc/kubernikus/pkg/cmd/printers"
 )
 
 func (o *DeleteOptions) NewClusterCommand() *cobra.Command {
@@ -17,6 +18,8 @@ func (o *DeleteOptions) NewClusterCommand() *cobra.Command {
 		PreRun:  o.clusterPreRun,
 		Run:     o.clusterRun,
 	}
+	c.Flags().BoolVar(&o.dryRun, "dry-run", false, "Only list the resources that would be deleted")
+	c.Flags().StringVar(&o.gracePeriod, "grace-period", "", "Scale the cluster to zero and delete it after this duration (e.g. 72h). Use undelete to restore it in the meantime")
 
 	return c
 }
@@ -27,10 +30,38 @@ func (o *DeleteOptions) clusterPreRun(c *cobra.Command, args []string) {
 }
 
 func (o *DeleteOptions) clusterRun(c *cobra.Command, args []string) {
-	cmd.CheckError(o.Kubernikus.DeleteCluster(args[0]))
+	if o.dryRun {
+		plan, err := o.Kubernikus.DeleteClusterDryRun(args[0], o.gracePeriod)
+		cmd.CheckError(err)
+		cmd.CheckError(plan.Print(printers.Human, printers.PrintOptions{}))
+		return
+	}
+	cmd.CheckError(o.Kubernikus.DeleteCluster(args[0], o.gracePeriod))
+	if o.gracePeriod != "" {
+		fmt.Printf("Cluster %v scaled to zero and scheduled for deletion in %v. Use `undelete cluster %v` to restore it.\n", args[0], o.gracePeriod, args[0])
+		return
+	}
 	fmt.Printf("Cluster %v scheduled for deletion.", args[0])
 }
 
+func (o *DeleteOptions) NewUndeleteClusterCommand() *cobra.Command {
+	c := &cobra.Command{
+		Use:     "cluster [name]",
+		Short:   "Cancels the scheduled deletion of the cluster with the given name",
+		Aliases: []string{"clusters"},
+		PreRun:  o.clusterPreRun,
+		Run:     o.undeleteClusterRun,
+	}
+
+	return c
+}
+
+func (o *DeleteOptions) undeleteClusterRun(c *cobra.Command, args []string) {
+	_, err := o.Kubernikus.UndeleteCluster(args[0])
+	cmd.CheckError(err)
+	fmt.Printf("Cluster %v restored.\n", args[0])
+}
+
 func validateClusterCommandArgs(args []string) error {
 	if len(args) > 1 {
 		return errors.Errorf("Surplus arguments to cluster delete.")
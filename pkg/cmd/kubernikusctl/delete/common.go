@@ -3,6 +3,7 @@ package delete
 import (
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -18,6 +19,11 @@ type DeleteOptions struct {
 	url        *url.URL
 	Openstack  *common.OpenstackClient
 	Kubernikus *common.KubernikusClient
+
+	dryRun      bool
+	gracePeriod string
+	wait        bool
+	timeout     time.Duration
 }
 
 func (o *DeleteOptions) PersistentPreRun(c *cobra.Command, args []string) {
@@ -0,0 +1,72 @@
+package delete
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	"github.com/sapcc/kubernikus/pkg/cmd"
+	"github.com/sapcc/kubernikus/pkg/cmd/kubernikusctl/common"
+)
+
+func (o *DeleteOptions) NewNodePoolCommand() *cobra.Command {
+	c := &cobra.Command{
+		Use:     "nodepool [cluster] [name]",
+		Short:   "Deletes a nodepool and its nodes",
+		Long:    `Scales the nodepool to 0 and waits for its nodes to be deleted, then removes it.`,
+		Aliases: []string{"nodepools", "np"},
+		PreRun:  o.nodePoolPreRun,
+		Run:     o.nodePoolRun,
+	}
+	c.Flags().BoolVar(&o.wait, "wait", false, "Wait until the nodepool is gone")
+	c.Flags().DurationVar(&o.timeout, "timeout", 30*time.Minute, "How long to wait for the nodes to be deleted")
+	return c
+}
+
+func (o *DeleteOptions) nodePoolPreRun(c *cobra.Command, args []string) {
+	if len(args) != 2 {
+		cmd.CheckError(errors.Errorf("Please supply the name of the cluster and the nodepool to be deleted."))
+	}
+	cmd.CheckError(o.SetupKubernikusClient())
+}
+
+func (o *DeleteOptions) nodePoolRun(c *cobra.Command, args []string) {
+	cluster, name := args[0], args[1]
+	pool, err := o.Kubernikus.ShowNodePool(cluster, name)
+	cmd.CheckError(err)
+	if pool == nil {
+		cmd.CheckError(errors.Errorf("Nodepool %v not found", name))
+	}
+
+	// only empty pools can be removed
+	if pool.Size > 0 {
+		err = o.Kubernikus.UpdateNodePools(cluster, func(pools []models.NodePool) ([]models.NodePool, error) {
+			i := common.FindNodePool(pools, name)
+			if i < 0 {
+				return nil, errors.Errorf("Nodepool %v not found", name)
+			}
+			pools[i].Size = 0
+			return pools, nil
+		})
+		cmd.CheckError(err)
+		fmt.Printf("Nodepool %v scaled to 0, waiting for its nodes to be deleted.\n", name)
+		cmd.CheckError(o.Kubernikus.WaitForNodePool(cluster, name, 0, o.timeout))
+	}
+
+	err = o.Kubernikus.UpdateNodePools(cluster, func(pools []models.NodePool) ([]models.NodePool, error) {
+		i := common.FindNodePool(pools, name)
+		if i < 0 {
+			return nil, errors.Errorf("Nodepool %v not found", name)
+		}
+		return append(pools[:i], pools[i+1:]...), nil
+	})
+	cmd.CheckError(err)
+	fmt.Printf("Nodepool %v deleted from cluster %v.\n", name, cluster)
+
+	if o.wait {
+		cmd.CheckError(o.Kubernikus.WaitForNodePool(cluster, name, -1, o.timeout))
+	}
+}
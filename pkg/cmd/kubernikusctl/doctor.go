@@ -0,0 +1,24 @@
+package kubernikusctl
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/sapcc/kubernikus/pkg/cmd/kubernikusctl/doctor"
+)
+
+func NewDoctorCommand() *cobra.Command {
+	o := doctor.NewDoctorOptions()
+
+	c := &cobra.Command{
+		Use:   "doctor [cluster]",
+		Short: "Checks the kubeconfig, credentials and connections of a cluster",
+		Long: `Checks the kubeconfig context of a cluster, the validity of its credentials and
+whether Keystone, the Kubernikus API and the apiserver of the cluster can be reached.
+The current context is checked unless a cluster or --context is given.
+
+Exits with 0 if all checks passed, 1 if there were warnings and 2 if a check failed.`,
+		Run: o.Run,
+	}
+	o.BindFlags(c.Flags())
+	return c
+}
@@ -0,0 +1,355 @@
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/klog"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	"github.com/sapcc/kubernikus/pkg/cmd/kubernikusctl/common"
+)
+
+type Status string
+
+const (
+	OK      Status = "OK"
+	Warning Status = "WARN"
+	Failed  Status = "FAIL"
+	Skipped Status = "SKIP"
+)
+
+// CertificateRenewWindow is how long before its expiry a client certificate is reported
+const CertificateRenewWindow = time.Hour
+
+// Finding is the result of a single check. The hint tells how to fix a problem.
+type Finding struct {
+	Check   string
+	Status  Status
+	Message string
+	Hint    string
+}
+
+type check struct {
+	name     string
+	requires []string
+	run      func() Finding
+}
+
+// metadata is what the credentials of a Kubernikus context tell about the kluster
+type metadata struct {
+	name          string
+	kubernikusURL string
+	authURL       string
+	projectID     string
+	username      string
+	domain        string
+}
+
+type doctor struct {
+	kubeconfigPath string
+	context        string
+	url            *url.URL
+	timeout        time.Duration
+	openstack      *common.OpenstackClient
+
+	ktx      *common.KubernikusContext
+	metadata metadata
+	token    string
+	kluster  *models.Kluster
+	findings map[string]Status
+}
+
+func (d *doctor) checks() []check {
+	return []check{
+		{name: "kubeconfig", run: d.checkKubeconfig},
+		{name: "context", requires: []string{"kubeconfig"}, run: d.checkContext},
+		{name: "credentials", requires: []string{"context"}, run: d.checkCredentials},
+		{name: "api", requires: []string{"context"}, run: d.checkAPI},
+		{name: "keystone", requires: []string{"context"}, run: d.checkKeystone},
+		{name: "kluster", requires: []string{"api", "keystone"}, run: d.checkKluster},
+		{name: "apiserver", requires: []string{"credentials"}, run: d.checkApiserver},
+	}
+}
+
+// run executes the checks in order. Checks whose prerequisites didn't pass are skipped.
+func (d *doctor) run() []Finding {
+	d.findings = map[string]Status{}
+	result := []Finding{}
+	for _, c := range d.checks() {
+		var finding Finding
+		if missing := d.unmet(c.requires); missing != "" {
+			finding = Finding{Status: Skipped, Message: fmt.Sprintf("requires a passing %s check", missing)}
+		} else {
+			finding = c.run()
+		}
+		finding.Check = c.name
+		d.findings[c.name] = finding.Status
+		result = append(result, finding)
+	}
+	return result
+}
+
+func (d *doctor) unmet(requires []string) string {
+	for _, name := range requires {
+		if status := d.findings[name]; status != OK && status != Warning {
+			return name
+		}
+	}
+	return ""
+}
+
+func (d *doctor) checkKubeconfig() Finding {
+	var err error
+	if d.ktx, err = common.NewKubernikusContext(d.kubeconfigPath, d.context); err != nil {
+		return Finding{Status: Failed, Message: fmt.Sprintf("couldn't load kubeconfig: %s", err), Hint: "check $KUBECONFIG and --kubeconfig"}
+	}
+	filename := d.ktx.PathOptions.GetDefaultFilename()
+	if d.ktx.Context() == "" {
+		return Finding{Status: Failed, Message: fmt.Sprintf("no current context in %s", filename), Hint: "pass the name of the kluster or --context"}
+	}
+	if _, found := d.ktx.Config.Contexts[d.ktx.Context()]; !found {
+		return Finding{
+			Status:  Failed,
+			Message: fmt.Sprintf("context %s doesn't exist in %s", d.ktx.Context(), filename),
+			Hint:    fmt.Sprintf("run kubernikusctl auth init --name %s", d.ktx.Context()),
+		}
+	}
+	return Finding{Status: OK, Message: fmt.Sprintf("using context %s from %s", d.ktx.Context(), filename)}
+}
+
+func (d *doctor) checkContext() Finding {
+	if ok, err := d.ktx.IsKubernikusContext(); err != nil || !ok {
+		message := fmt.Sprintf("%s is not a Kubernikus context", d.ktx.Context())
+		if err != nil {
+			message = fmt.Sprintf("%s: %s", message, err)
+		}
+		return Finding{Status: Failed, Message: message, Hint: "select a kluster with --context or run kubernikusctl auth init"}
+	}
+
+	var err error
+	if d.ktx.UsesExecPlugin() {
+		d.metadata, err = execPluginMetadata(d.ktx)
+	} else {
+		d.metadata, err = certificateMetadata(d.ktx)
+	}
+	if err != nil {
+		return Finding{Status: Failed, Message: fmt.Sprintf("credentials lack Kubernikus metadata: %s", err), Hint: "run kubernikusctl auth init again"}
+	}
+	return Finding{Status: OK, Message: fmt.Sprintf("kluster %s in project %s", d.metadata.name, d.metadata.projectID)}
+}
+
+func (d *doctor) checkCredentials() Finding {
+	if d.ktx.UsesExecPlugin() {
+		command := d.authInfoExecCommand()
+		if _, err := exec.LookPath(command); err != nil {
+			return Finding{Status: Failed, Message: fmt.Sprintf("exec plugin %s not found", command), Hint: "put kubernikusctl into your PATH or run kubernikusctl auth init --exec-plugin again"}
+		}
+		return Finding{Status: OK, Message: fmt.Sprintf("obtained by exec plugin %s", command)}
+	}
+
+	expiresAt, err := d.ktx.UserCertificateExpiresAt()
+	if err != nil {
+		return Finding{Status: Failed, Message: fmt.Sprintf("couldn't read client certificate: %s", err), Hint: "run kubernikusctl auth init again"}
+	}
+	if valid, err := d.ktx.UserCertificateValid(); err != nil || !valid {
+		if time.Now().After(expiresAt) {
+			return Finding{Status: Failed, Message: fmt.Sprintf("client certificate expired at %s", expiresAt.Local().Format(time.RFC1123)), Hint: "run kubernikusctl auth refresh"}
+		}
+		return Finding{Status: Failed, Message: "client certificate is not valid yet", Hint: "check the clock of this machine"}
+	}
+	if time.Until(expiresAt) < CertificateRenewWindow {
+		return Finding{Status: Warning, Message: fmt.Sprintf("client certificate expires at %s", expiresAt.Local().Format(time.RFC1123)), Hint: "run kubernikusctl auth refresh --force"}
+	}
+	return Finding{Status: OK, Message: fmt.Sprintf("client certificate valid until %s", expiresAt.Local().Format(time.RFC1123))}
+}
+
+func (d *doctor) checkAPI() Finding {
+	if err := d.probe(strings.TrimSuffix(d.metadata.kubernikusURL, "/") + "/info"); err != nil {
+		return Finding{Status: Failed, Message: fmt.Sprintf("Kubernikus API %s isn't reachable: %s", d.metadata.kubernikusURL, err), Hint: "check your network connection and proxy settings"}
+	}
+	if d.url != nil && !sameURL(d.url.String(), d.metadata.kubernikusURL) {
+		return Finding{
+			Status:  Warning,
+			Message: fmt.Sprintf("--url %s differs from %s which issued the credentials", d.url, d.metadata.kubernikusURL),
+			Hint:    fmt.Sprintf("drop --url or use --url %s", d.metadata.kubernikusURL),
+		}
+	}
+	return Finding{Status: OK, Message: fmt.Sprintf("Kubernikus API %s is reachable", d.metadata.kubernikusURL)}
+}
+
+func (d *doctor) checkKeystone() Finding {
+	if err := d.probe(d.metadata.authURL); err != nil {
+		return Finding{Status: Failed, Message: fmt.Sprintf("Keystone %s isn't reachable: %s", d.metadata.authURL, err), Hint: "check your network connection and proxy settings"}
+	}
+
+	o := d.openstack
+	o.IdentityEndpoint = d.metadata.authURL
+	if o.ApplicationCredentialID == "" {
+		o.ApplicationCredentialID = os.Getenv("OS_APPLICATION_CREDENTIAL_ID")
+	}
+	if o.ApplicationCredentialSecret == "" {
+		o.ApplicationCredentialSecret = os.Getenv("OS_APPLICATION_CREDENTIAL_SECRET")
+	}
+	//Ignore conflicting values from environment, like auth refresh
+	o.UserID = ""
+	o.DomainID = ""
+	o.Scope.ProjectName = ""
+	o.Scope.DomainID = ""
+	o.Scope.DomainName = ""
+	if o.ApplicationCredentialID == "" {
+		o.Username = d.metadata.username
+		o.DomainName = d.metadata.domain
+		o.Scope.ProjectID = d.metadata.projectID
+	}
+	if err := o.Setup(); err != nil {
+		return Finding{Status: Failed, Message: err.Error()}
+	}
+	if o.Password == "" && o.TokenID == "" && o.ApplicationCredentialSecret == "" {
+		return Finding{Status: Skipped, Message: "Keystone is reachable, no password in OS_PASSWORD or the keyring to authenticate with", Hint: "pass --password to check authentication"}
+	}
+	o.Provider.HTTPClient.Timeout = d.timeout
+
+	if err := o.Authenticate(); err != nil {
+		klog.V(2).Info(o.PrintDebugAuthInfo())
+		return Finding{Status: Failed, Message: fmt.Sprintf("authentication failed: %s", err), Hint: "check your password or run kubernikusctl auth refresh --force to enter it again"}
+	}
+	d.token = o.Provider.TokenID
+	if o.ApplicationCredentialID != "" {
+		return Finding{Status: OK, Message: fmt.Sprintf("authenticated with application credential %s", o.ApplicationCredentialID)}
+	}
+	return Finding{Status: OK, Message: fmt.Sprintf("authenticated as %s@%s", d.metadata.username, d.metadata.domain)}
+}
+
+func (d *doctor) checkKluster() Finding {
+	kurl, err := url.Parse(d.metadata.kubernikusURL)
+	if err != nil {
+		return Finding{Status: Failed, Message: fmt.Sprintf("couldn't parse %s: %s", d.metadata.kubernikusURL, err)}
+	}
+	kluster, err := common.NewKubernikusClient(kurl, d.token).FindCluster(d.metadata.name)
+	if err != nil {
+		return Finding{Status: Failed, Message: fmt.Sprintf("couldn't get kluster %s: %s", d.metadata.name, err)}
+	}
+	if kluster == nil {
+		return Finding{
+			Status:  Failed,
+			Message: fmt.Sprintf("kluster %s doesn't exist in project %s", d.metadata.name, d.metadata.projectID),
+			Hint:    "check kubernikusctl get cluster, the kluster might have been deleted",
+		}
+	}
+	d.kluster = kluster
+	if kluster.Status.Phase != models.KlusterPhaseRunning {
+		return Finding{
+			Status:  Warning,
+			Message: fmt.Sprintf("kluster %s is %s", kluster.Name, kluster.Status.Phase),
+			Hint:    fmt.Sprintf("check kubernikusctl get events %s", kluster.Name),
+		}
+	}
+	return Finding{Status: OK, Message: fmt.Sprintf("kluster %s is %s with version %s", kluster.Name, kluster.Status.Phase, kluster.Status.ApiserverVersion)}
+}
+
+func (d *doctor) checkApiserver() Finding {
+	config, err := clientcmd.NewNonInteractiveClientConfig(*d.ktx.Config, d.ktx.Context(), &clientcmd.ConfigOverrides{}, nil).ClientConfig()
+	if err != nil {
+		return Finding{Status: Failed, Message: fmt.Sprintf("couldn't configure client: %s", err), Hint: "run kubernikusctl auth init again"}
+	}
+	config.Timeout = d.timeout
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return Finding{Status: Failed, Message: fmt.Sprintf("couldn't create client: %s", err)}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+	defer cancel()
+	if _, err := client.Discovery().RESTClient().Get().AbsPath("/readyz").DoRaw(ctx); err != nil {
+		hint := "check your network connection and the kluster with kubernikusctl get events " + d.metadata.name
+		if d.kluster != nil && d.kluster.Status.Phase != models.KlusterPhaseRunning {
+			hint = fmt.Sprintf("the kluster is %s, wait until it is Running", d.kluster.Status.Phase)
+		}
+		return Finding{Status: Failed, Message: fmt.Sprintf("apiserver %s isn't ready: %s", config.Host, err), Hint: hint}
+	}
+	return Finding{Status: OK, Message: fmt.Sprintf("apiserver %s is ready", config.Host)}
+}
+
+// probe tells if the endpoint answers. Only server errors count as failure,
+// most endpoints refuse unauthenticated requests.
+func (d *doctor) probe(endpoint string) error {
+	client := http.Client{Timeout: d.timeout}
+	response, err := client.Get(endpoint)
+	if err != nil {
+		return err
+	}
+	response.Body.Close()
+	if response.StatusCode >= http.StatusInternalServerError {
+		return errors.Errorf("responded with %s", response.Status)
+	}
+	return nil
+}
+
+func (d *doctor) authInfoExecCommand() string {
+	context := d.ktx.Config.Contexts[d.ktx.Context()]
+	return d.ktx.Config.AuthInfos[context.AuthInfo].Exec.Command
+}
+
+func certificateMetadata(ktx *common.KubernikusContext) (m metadata, err error) {
+	m.name = ktx.Context()
+	if m.kubernikusURL, err = ktx.KubernikusURL(); err != nil {
+		return m, err
+	}
+	if m.authURL, err = ktx.AuthURL(); err != nil {
+		return m, err
+	}
+	if m.projectID, err = ktx.ProjectID(); err != nil {
+		return m, err
+	}
+	if m.username, err = ktx.Username(); err != nil {
+		return m, err
+	}
+	if m.domain, err = ktx.UserDomainname(); err != nil {
+		return m, err
+	}
+	return m, nil
+}
+
+// execPluginMetadata reads the arguments common.UseExecPlugin passes to the plugin
+func execPluginMetadata(ktx *common.KubernikusContext) (metadata, error) {
+	context := ktx.Config.Contexts[ktx.Context()]
+	args := ktx.Config.AuthInfos[context.AuthInfo].Exec.Args
+	m := metadata{
+		name:          execArg(args, "--name"),
+		kubernikusURL: execArg(args, "--url"),
+		authURL:       execArg(args, "--auth-url"),
+		projectID:     execArg(args, "--project-id"),
+		username:      execArg(args, "--username"),
+		domain:        execArg(args, "--user-domain-name"),
+	}
+	if m.name == "" || m.kubernikusURL == "" || m.authURL == "" || m.projectID == "" {
+		return m, errors.Errorf("exec plugin arguments are missing --name, --url, --auth-url or --project-id")
+	}
+	return m, nil
+}
+
+func execArg(args []string, flag string) string {
+	for i, arg := range args {
+		if arg == flag && i+1 < len(args) {
+			return args[i+1]
+		}
+		if strings.HasPrefix(arg, flag+"=") {
+			return strings.TrimPrefix(arg, flag+"=")
+		}
+	}
+	return ""
+}
+
+func sameURL(a, b string) bool {
+	return strings.TrimSuffix(a, "/") == strings.TrimSuffix(b, "/")
+}
@@ -0,0 +1,144 @@
+package doctor
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"testing"
+	"time"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	"github.com/sapcc/kubernikus/pkg/cmd/kubernikusctl/common"
+	"github.com/sapcc/kubernikus/pkg/util"
+)
+
+// writeKubeconfig writes a kubeconfig for kluster-1 with a client certificate
+// issued by server and valid for the given duration
+func writeKubeconfig(t *testing.T, server string, validFor time.Duration) string {
+	kluster := &v1.Kluster{ObjectMeta: metav1.ObjectMeta{Name: "kluster-1", Labels: map[string]string{"account": "12345678"}}, Spec: models.KlusterSpec{AdvertiseAddress: "1.1.1.1", ServiceCIDR: "192.168.0.0/24"}}
+	certs := new(v1.Certificates)
+	require.NoError(t, flag.Lookup("auth-url").Value.Set(server))
+
+	factory := util.NewCertificateFactory(kluster, certs, "test.local")
+	_, err := factory.Ensure()
+	require.NoError(t, err)
+	bundle, err := factory.UserCert(&models.Principal{Name: "exampleuser", Domain: "exampledomain"}, server, validFor)
+	require.NoError(t, err)
+	key, err := util.EncodePrivateKeyPEM(bundle.PrivateKey)
+	require.NoError(t, err)
+
+	config := &clientcmdapi.Config{
+		Clusters: map[string]*clientcmdapi.Cluster{
+			"kluster-1": {Server: server, CertificateAuthorityData: []byte(certs.ApiserverClientsCACertifcate)},
+		},
+		AuthInfos: map[string]*clientcmdapi.AuthInfo{
+			"kluster-1": {ClientCertificateData: util.EncodeCertPEM(bundle.Certificate), ClientKeyData: key},
+		},
+		Contexts: map[string]*clientcmdapi.Context{
+			"kluster-1": {Cluster: "kluster-1", AuthInfo: "kluster-1"},
+		},
+		CurrentContext: "kluster-1",
+	}
+	path := filepath.Join(t.TempDir(), "kubeconfig")
+	require.NoError(t, clientcmd.WriteToFile(*config, path))
+	return path
+}
+
+func statuses(findings []Finding) map[string]Status {
+	result := map[string]Status{}
+	for _, f := range findings {
+		result[f.Check] = f.Status
+	}
+	return result
+}
+
+func newDoctor(kubeconfig, context string) *doctor {
+	return &doctor{kubeconfigPath: kubeconfig, context: context, timeout: 5 * time.Second, openstack: common.NewOpenstackClient()}
+}
+
+func TestDoctor(t *testing.T) {
+	t.Setenv("OS_PASSWORD", "")
+	t.Setenv("OS_TOKEN", "")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	t.Run("healthy", func(t *testing.T) {
+		findings := newDoctor(writeKubeconfig(t, server.URL, 24*time.Hour), "").run()
+		assert.Equal(t, map[string]Status{
+			"kubeconfig":  OK,
+			"context":     OK,
+			"credentials": OK,
+			"api":         OK,
+			"keystone":    Skipped,
+			"kluster":     Skipped,
+			"apiserver":   OK,
+		}, statuses(findings))
+		assert.Equal(t, 0, exitCode(findings))
+	})
+
+	t.Run("missing context", func(t *testing.T) {
+		findings := newDoctor(writeKubeconfig(t, server.URL, 24*time.Hour), "kluster-2").run()
+		assert.Equal(t, Failed, findings[0].Status)
+		assert.Equal(t, "run kubernikusctl auth init --name kluster-2", findings[0].Hint)
+		for _, f := range findings[1:] {
+			assert.Equal(t, Skipped, f.Status, f.Check)
+		}
+		assert.Equal(t, 2, exitCode(findings))
+	})
+
+	t.Run("expired certificate", func(t *testing.T) {
+		findings := newDoctor(writeKubeconfig(t, server.URL, -time.Hour), "").run()
+		result := statuses(findings)
+		assert.Equal(t, Failed, result["credentials"])
+		assert.Equal(t, Skipped, result["apiserver"])
+		assert.Equal(t, OK, result["api"])
+		assert.Equal(t, 2, exitCode(findings))
+	})
+
+	t.Run("wrong url", func(t *testing.T) {
+		d := newDoctor(writeKubeconfig(t, server.URL, 24*time.Hour), "")
+		d.url, _ = url.Parse("https://kubernikus.other.region")
+		findings := d.run()
+		assert.Equal(t, Warning, statuses(findings)["api"])
+		assert.Equal(t, 1, exitCode(findings))
+
+		var out bytes.Buffer
+		printFindings(&out, findings)
+		assert.Contains(t, out.String(), "-> drop --url or use --url "+server.URL)
+	})
+
+	t.Run("unreachable apiserver", func(t *testing.T) {
+		down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer down.Close()
+		kubeconfig := writeKubeconfig(t, server.URL, 24*time.Hour)
+		config, err := clientcmd.LoadFromFile(kubeconfig)
+		require.NoError(t, err)
+		config.Clusters["kluster-1"].Server = down.URL
+		require.NoError(t, clientcmd.WriteToFile(*config, kubeconfig))
+
+		result := statuses(newDoctor(kubeconfig, "").run())
+		assert.Equal(t, OK, result["api"])
+		assert.Equal(t, Failed, result["apiserver"])
+	})
+}
+
+func TestExecArg(t *testing.T) {
+	args := []string{"auth", "exec-credential", "--url", "https://kubernikus", "--name=kluster-1"}
+	assert.Equal(t, "https://kubernikus", execArg(args, "--url"))
+	assert.Equal(t, "kluster-1", execArg(args, "--name"))
+	assert.Equal(t, "", execArg(args, "--auth-url"))
+}
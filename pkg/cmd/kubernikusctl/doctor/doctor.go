@@ -0,0 +1,95 @@
+package doctor
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/sapcc/kubernikus/pkg/cmd/kubernikusctl/common"
+)
+
+type DoctorOptions struct {
+	_url           string
+	kubeconfigPath string
+	context        string
+	timeout        time.Duration
+
+	Openstack *common.OpenstackClient
+}
+
+func NewDoctorOptions() *DoctorOptions {
+	return &DoctorOptions{
+		timeout:   10 * time.Second,
+		Openstack: common.NewOpenstackClient(),
+	}
+}
+
+func (o *DoctorOptions) BindFlags(flags *pflag.FlagSet) {
+	common.BindLogFlags(flags)
+	flags.StringVar(&o._url, "url", o._url, "URL for Kubernikus API, checked against the one the credentials were issued by")
+	flags.StringVar(&o.kubeconfigPath, "kubeconfig", o.kubeconfigPath, "Overwrites kubeconfig auto-detection with explicit path")
+	flags.StringVar(&o.context, "context", o.context, "Overwrites current-context in kubeconfig")
+	flags.DurationVar(&o.timeout, "timeout", o.timeout, "Timeout for each connection check")
+	flags.StringVar(&o.Openstack.Password, "password", "", "User password [OS_PASSWORD]")
+	flags.StringVar(&o.Openstack.TokenID, "token", "", "Token to authenticate with [OS_TOKEN]")
+	flags.StringVar(&o.Openstack.ApplicationCredentialID, "application-credential-id", "", "Project application credential id [OS_APPLICATION_CREDENTIAL_ID]")
+	flags.StringVar(&o.Openstack.ApplicationCredentialSecret, "application-credential-secret", "", "Project application credential secret [OS_APPLICATION_CREDENTIAL_SECRET]")
+}
+
+// Run checks the kubeconfig and the connections of a kluster and exits with
+// the code of the worst finding
+func (o *DoctorOptions) Run(c *cobra.Command, args []string) {
+	common.SetupLogger()
+	if len(args) > 1 {
+		common.CheckError(fmt.Errorf("Surplus arguments: %v", args[1:]))
+	}
+	context := o.context
+	if context == "" && len(args) == 1 {
+		context = args[0]
+	}
+
+	d := &doctor{
+		kubeconfigPath: o.kubeconfigPath,
+		context:        context,
+		timeout:        o.timeout,
+		openstack:      o.Openstack,
+	}
+	if o._url != "" {
+		var err error
+		if d.url, err = url.Parse(o._url); err != nil {
+			common.CheckError(fmt.Errorf("Couldn't parse --url: %s", err))
+		}
+	}
+
+	findings := d.run()
+	printFindings(os.Stdout, findings)
+	os.Exit(exitCode(findings))
+}
+
+func printFindings(w io.Writer, findings []Finding) {
+	for _, f := range findings {
+		fmt.Fprintf(w, "%-6s %-12s %s\n", "["+string(f.Status)+"]", f.Check, f.Message)
+		if f.Hint != "" && f.Status != OK {
+			fmt.Fprintf(w, "%-19s -> %s\n", "", f.Hint)
+		}
+	}
+}
+
+// exitCode is 0 if all checks passed, 1 for warnings and 2 for failures
+func exitCode(findings []Finding) int {
+	code := 0
+	for _, f := range findings {
+		switch f.Status {
+		case Failed:
+			return 2
+		case Warning:
+			code = 1
+		}
+	}
+	return code
+}
@@ -27,5 +27,7 @@ func NewGetCommand() *cobra.Command {
 	c.AddCommand(o.NewClusterCommand())
 	c.AddCommand(o.NewNodePoolCommand())
 	c.AddCommand(o.NewClusterValuesCommand())
+	c.AddCommand(o.NewEventsCommand())
+	c.AddCommand(o.NewNodesCommand())
 	return c
 }
@@ -5,6 +5,7 @@ import (
 	"github.com/spf13/cobra"
 	"k8s.io/klog"
 
+	"github.com/sapcc/kubernikus/pkg/api/models"
 	"github.com/sapcc/kubernikus/pkg/cmd"
 	"github.com/sapcc/kubernikus/pkg/cmd/printers"
 )
@@ -37,26 +38,35 @@ func (o *GetOptions) clusterRun(c *cobra.Command, args []string) {
 }
 
 func (o *GetOptions) clusterList() error {
-	clusters, err := o.Kubernikus.ListAllClusters()
-	if err != nil {
-		klog.V(2).Infof("Error listing clusters: %v", err)
-		return errors.Wrap(err, "Error listing clusters")
-	}
-	printme := make([]printers.Printable, len(clusters))
-	for i, cluster := range clusters {
-		tmp := cluster
-		printme[i] = tmp
-	}
-	return printers.PrintTable(printme)
+	return o.render(func() (interface{}, error) {
+		clusters, err := o.Kubernikus.ListAllClusters()
+		if err != nil {
+			klog.V(2).Infof("Error listing clusters: %v", err)
+			return nil, errors.Wrap(err, "Error listing clusters")
+		}
+		return clusters, nil
+	}, func(obj interface{}) error {
+		clusters := obj.([]*models.Kluster)
+		printme := make([]printers.Printable, len(clusters))
+		for i, cluster := range clusters {
+			tmp := cluster
+			printme[i] = tmp
+		}
+		return o.output.PrintList(printme)
+	})
 }
 
 func (o *GetOptions) clusterShow(name string) error {
-	cluster, err := o.Kubernikus.ShowCluster(name)
-	if err != nil {
-		klog.V(2).Infof("Error getting cluster %v: %v", name, err)
-		return errors.Wrap(err, "Error getting cluster")
-	}
-	return cluster.Print(printers.Human, printers.PrintOptions{})
+	return o.render(func() (interface{}, error) {
+		cluster, err := o.Kubernikus.ShowCluster(name)
+		if err != nil {
+			klog.V(2).Infof("Error getting cluster %v: %v", name, err)
+			return nil, errors.Wrap(err, "Error getting cluster")
+		}
+		return cluster, nil
+	}, func(obj interface{}) error {
+		return o.output.PrintObject(obj.(*models.Kluster))
+	})
 }
 
 func validateClusterCommandArgs(args []string) error {
@@ -1,8 +1,13 @@
 package get
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"net/url"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -11,6 +16,7 @@ import (
 
 	"github.com/sapcc/kubernikus/pkg/cmd"
 	"github.com/sapcc/kubernikus/pkg/cmd/kubernikusctl/common"
+	"github.com/sapcc/kubernikus/pkg/cmd/printers"
 )
 
 type GetOptions struct {
@@ -18,16 +24,30 @@ type GetOptions struct {
 	url        *url.URL
 	Openstack  *common.OpenstackClient
 	Kubernikus *common.KubernikusClient
+
+	_output       string
+	output        printers.Output
+	watch         bool
+	watchInterval time.Duration
+
+	sortBy     string
+	eventTypes []string
 }
 
 func (o *GetOptions) BindFlags(flags *pflag.FlagSet) {
 	o.Openstack.BindFlags(flags)
 	common.BindLogFlags(flags)
 	flags.StringVar(&o._url, "url", o._url, "URL for Kubernikus API")
+	flags.StringVarP(&o._output, "output", "o", o._output, fmt.Sprintf("Output format, one of: %s", strings.Join(printers.OutputFormats, ", ")))
+	flags.BoolVarP(&o.watch, "watch", "w", false, "Print again whenever the object changes")
+	flags.DurationVar(&o.watchInterval, "watch-interval", 5*time.Second, "How often to check for changes with --watch")
 }
 
 func (o *GetOptions) PersistentPreRun(c *cobra.Command, args []string) {
 	common.SetupLogger()
+	var err error
+	o.output, err = printers.ParseOutput(o._output)
+	cmd.CheckError(err)
 	cmd.CheckError(o.Openstack.Validate(c, args))
 	cmd.CheckError(o.Openstack.Setup())
 	cmd.CheckError(o.Openstack.Authenticate())
@@ -37,7 +57,8 @@ func (o *GetOptions) SetupKubernikusClient() error {
 	var err error
 	klog.V(2).Infof("SetupKubernikusClient called with url: %v", o._url)
 	if o._url == "" {
-		fmt.Println("Auto-Detecting Kubernikus Host ...")
+		// stdout is kept clean for the output formats
+		fmt.Fprintln(os.Stderr, "Auto-Detecting Kubernikus Host ...")
 		if o.url, err = o.Openstack.DefaultKubernikusURL(); err != nil {
 			klog.V(2).Infof("Error detecting kubernikus host: %+v", err)
 			return errors.Errorf("You need to provide --url. Auto-Detection failed.")
@@ -52,3 +73,35 @@ func (o *GetOptions) SetupKubernikusClient() error {
 	o.Kubernikus = common.NewKubernikusClient(o.url, o.Openstack.Provider.TokenID)
 	return nil
 }
+
+// render prints the object returned by fetch. With --watch it is fetched
+// again periodically and printed whenever it changed, failed fetches are
+// logged and retried with the next poll.
+func (o *GetOptions) render(fetch func() (interface{}, error), print func(interface{}) error) error {
+	var last []byte
+	for {
+		obj, err := fetch()
+		if err != nil {
+			if !o.watch {
+				return err
+			}
+			klog.Errorf("Error fetching, retrying in %v: %v", o.watchInterval, err)
+			time.Sleep(o.watchInterval)
+			continue
+		}
+		current, err := json.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(current, last) {
+			if err := print(obj); err != nil {
+				return err
+			}
+			last = current
+		}
+		if !o.watch {
+			return nil
+		}
+		time.Sleep(o.watchInterval)
+	}
+}
@@ -0,0 +1,37 @@
+package get
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderWatchRetriesFetchErrors(t *testing.T) {
+	o := &GetOptions{watch: true, watchInterval: time.Millisecond}
+	stop := errors.New("stop")
+
+	fetches := 0
+	fetch := func() (interface{}, error) {
+		fetches++
+		if fetches < 3 {
+			return nil, errors.New("connection refused")
+		}
+		return "cluster", nil
+	}
+	var printed []interface{}
+	print := func(obj interface{}) error {
+		printed = append(printed, obj)
+		return stop
+	}
+
+	assert.Equal(t, stop, o.render(fetch, print))
+	assert.Equal(t, 3, fetches)
+	assert.Equal(t, []interface{}{"cluster"}, printed)
+
+	o.watch = false
+	fetches = 0
+	assert.EqualError(t, o.render(fetch, print), "connection refused")
+	assert.Equal(t, 1, fetches)
+}
@@ -0,0 +1,107 @@
+package get
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"k8s.io/klog"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	"github.com/sapcc/kubernikus/pkg/cmd"
+	"github.com/sapcc/kubernikus/pkg/cmd/printers"
+)
+
+// eventTimeLayout is how the API formats event timestamps
+const eventTimeLayout = "2006-01-02 15:04:05 -0700 MST"
+
+var eventSortKeys = []string{"lastTimestamp", "firstTimestamp", "count", "type"}
+
+func (o *GetOptions) NewEventsCommand() *cobra.Command {
+	c := &cobra.Command{
+		Use:     "events [cluster]",
+		Short:   "Gets the recent events of a cluster",
+		Aliases: []string{"event", "ev"},
+		PreRun:  o.eventsPreRun,
+		Run:     o.eventsRun,
+	}
+	c.Flags().StringVar(&o.sortBy, "sort-by", "lastTimestamp", "Sort the events by one of: "+strings.Join(eventSortKeys, ", "))
+	c.Flags().StringSliceVar(&o.eventTypes, "types", nil, "Only show events of these types, e.g. Warning")
+	return c
+}
+
+func (o *GetOptions) eventsPreRun(c *cobra.Command, args []string) {
+	if len(args) != 1 {
+		cmd.CheckError(errors.Errorf("Please supply the name of the cluster, %v", args))
+	}
+	valid := false
+	for _, key := range eventSortKeys {
+		valid = valid || key == o.sortBy
+	}
+	if !valid {
+		cmd.CheckError(errors.Errorf("Unknown --sort-by %q, use one of: %s", o.sortBy, strings.Join(eventSortKeys, ", ")))
+	}
+	cmd.CheckError(o.SetupKubernikusClient())
+}
+
+func (o *GetOptions) eventsRun(c *cobra.Command, args []string) {
+	cmd.CheckError(o.render(func() (interface{}, error) {
+		events, err := o.Kubernikus.GetClusterEvents(args[0])
+		if err != nil {
+			klog.V(2).Infof("Error getting events: %v", err)
+			return nil, errors.Wrap(err, "Error getting events")
+		}
+		events = filterEvents(events, o.eventTypes)
+		sortEvents(events, o.sortBy)
+		return events, nil
+	}, func(obj interface{}) error {
+		events := obj.([]*models.Event)
+		printme := make([]printers.Printable, len(events))
+		for i, event := range events {
+			printme[i] = *event
+		}
+		return o.output.PrintList(printme)
+	}))
+}
+
+func filterEvents(events []*models.Event, types []string) []*models.Event {
+	if len(types) == 0 {
+		return events
+	}
+	filtered := events[:0]
+	for _, event := range events {
+		for _, t := range types {
+			if strings.EqualFold(event.Type, t) {
+				filtered = append(filtered, event)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// sortEvents sorts ascending like kubectl, the latest event is printed last
+func sortEvents(events []*models.Event, by string) {
+	sort.SliceStable(events, func(i, j int) bool {
+		switch by {
+		case "firstTimestamp":
+			return eventTime(events[i].FirstTimestamp).Before(eventTime(events[j].FirstTimestamp))
+		case "count":
+			return events[i].Count < events[j].Count
+		case "type":
+			return events[i].Type < events[j].Type
+		default:
+			return eventTime(events[i].LastTimestamp).Before(eventTime(events[j].LastTimestamp))
+		}
+	})
+}
+
+func eventTime(timestamp string) time.Time {
+	if t, err := time.Parse(eventTimeLayout, timestamp); err == nil {
+		return t
+	}
+	t, _ := time.Parse(time.RFC3339, timestamp)
+	return t
+}
@@ -0,0 +1,33 @@
+package get
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+func TestFilterAndSortEvents(t *testing.T) {
+	events := []*models.Event{
+		{Reason: "b", Type: "Warning", Count: 3, LastTimestamp: "2026-10-19 10:00:00 +0000 UTC", FirstTimestamp: "2026-10-19 08:00:00 +0000 UTC"},
+		{Reason: "a", Type: "Normal", Count: 1, LastTimestamp: "2026-10-19 09:00:00 +0000 UTC", FirstTimestamp: "2026-10-19 09:00:00 +0000 UTC"},
+		{Reason: "c", Type: "Warning", Count: 2, LastTimestamp: "2026-10-19 11:00:00 +0200 CEST", FirstTimestamp: "2026-10-19 07:00:00 +0000 UTC"},
+	}
+	reasons := func(events []*models.Event) []string {
+		r := []string{}
+		for _, e := range events {
+			r = append(r, e.Reason)
+		}
+		return r
+	}
+
+	sortEvents(events, "lastTimestamp")
+	assert.Equal(t, []string{"a", "c", "b"}, reasons(events))
+	sortEvents(events, "firstTimestamp")
+	assert.Equal(t, []string{"c", "b", "a"}, reasons(events))
+	sortEvents(events, "count")
+	assert.Equal(t, []string{"a", "c", "b"}, reasons(events))
+
+	assert.Equal(t, []string{"c", "b"}, reasons(filterEvents(events, []string{"warning"})))
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/spf13/cobra"
 	"k8s.io/klog"
 
+	"github.com/sapcc/kubernikus/pkg/api/models"
 	"github.com/sapcc/kubernikus/pkg/cmd"
 	"github.com/sapcc/kubernikus/pkg/cmd/printers"
 )
@@ -36,26 +37,38 @@ func (o *GetOptions) nodePoolRun(c *cobra.Command, args []string) {
 }
 
 func (o *GetOptions) nodePoolList(cluster string) error {
-	nodePools, err := o.Kubernikus.ListNodePools(cluster)
-	if err != nil {
-		klog.V(2).Infof("Error listing nodepools: %v", err)
-		return errors.Wrap(err, "Error listing nodepools")
-	}
-	printme := make([]printers.Printable, len(nodePools))
-	for i, nodePool := range nodePools {
-		tmp := nodePool
-		printme[i] = tmp
-	}
-	return printers.PrintTable(printme)
+	return o.render(func() (interface{}, error) {
+		nodePools, err := o.Kubernikus.ListNodePools(cluster)
+		if err != nil {
+			klog.V(2).Infof("Error listing nodepools: %v", err)
+			return nil, errors.Wrap(err, "Error listing nodepools")
+		}
+		return nodePools, nil
+	}, func(obj interface{}) error {
+		nodePools := obj.([]models.NodePool)
+		printme := make([]printers.Printable, len(nodePools))
+		for i, nodePool := range nodePools {
+			tmp := nodePool
+			printme[i] = tmp
+		}
+		return o.output.PrintList(printme)
+	})
 }
 
 func (o *GetOptions) nodePoolShow(cluster string, nodePoolName string) error {
-	nodePool, err := o.Kubernikus.ShowNodePool(cluster, nodePoolName)
-	if err != nil {
-		klog.V(2).Infof("Error getting nodepool %v from cluster %v: %v", nodePoolName, cluster, err)
-		return errors.Wrap(err, "Error getting nodepool")
-	}
-	return nodePool.Print(printers.Human, printers.PrintOptions{})
+	return o.render(func() (interface{}, error) {
+		nodePool, err := o.Kubernikus.ShowNodePool(cluster, nodePoolName)
+		if err != nil {
+			klog.V(2).Infof("Error getting nodepool %v from cluster %v: %v", nodePoolName, cluster, err)
+			return nil, errors.Wrap(err, "Error getting nodepool")
+		}
+		if nodePool == nil {
+			return nil, errors.Errorf("Nodepool %v not found", nodePoolName)
+		}
+		return nodePool, nil
+	}, func(obj interface{}) error {
+		return o.output.PrintObject(*obj.(*models.NodePool))
+	})
 }
 
 func validateNodePoolCommandArgs(args []string) error {
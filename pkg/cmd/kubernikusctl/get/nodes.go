@@ -0,0 +1,49 @@
+package get
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"k8s.io/klog"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	"github.com/sapcc/kubernikus/pkg/cmd"
+	"github.com/sapcc/kubernikus/pkg/cmd/printers"
+)
+
+func (o *GetOptions) NewNodesCommand() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "nodes [cluster]",
+		Short: "Gets the servers and Kubernetes nodes of a cluster",
+		Long: `Shows the OpenStack servers and the Kubernetes nodes of a cluster side by side.
+Servers that didn't register as node and nodes without server are listed as well.`,
+		Aliases: []string{"node", "no"},
+		PreRun:  o.nodesPreRun,
+		Run:     o.nodesRun,
+	}
+	return c
+}
+
+func (o *GetOptions) nodesPreRun(c *cobra.Command, args []string) {
+	if len(args) != 1 {
+		cmd.CheckError(errors.Errorf("Please supply the name of the cluster, %v", args))
+	}
+	cmd.CheckError(o.SetupKubernikusClient())
+}
+
+func (o *GetOptions) nodesRun(c *cobra.Command, args []string) {
+	cmd.CheckError(o.render(func() (interface{}, error) {
+		nodes, err := o.Kubernikus.ListClusterNodes(args[0])
+		if err != nil {
+			klog.V(2).Infof("Error listing nodes: %v", err)
+			return nil, errors.Wrap(err, "Error listing nodes")
+		}
+		return nodes, nil
+	}, func(obj interface{}) error {
+		nodes := obj.([]models.ClusterNode)
+		printme := make([]printers.Printable, len(nodes))
+		for i, node := range nodes {
+			printme[i] = node
+		}
+		return o.output.PrintList(printme)
+	}))
+}
@@ -9,6 +9,7 @@ import (
 	"k8s.io/klog"
 
 	"github.com/sapcc/kubernikus/pkg/cmd"
+	"github.com/sapcc/kubernikus/pkg/cmd/printers"
 )
 
 func (o *GetOptions) NewClusterValuesCommand() *cobra.Command {
@@ -24,6 +25,9 @@ func (o *GetOptions) NewClusterValuesCommand() *cobra.Command {
 func (o *GetOptions) valuesPreRun(c *cobra.Command, args []string) {
 	klog.V(2).Infof("Get Cluster PR: %v", o)
 	cmd.CheckError(validateClusterValuesCommandArgs(args))
+	if o.output.Format != "" || o.watch {
+		cmd.CheckError(errors.New("values are always printed as YAML, --output and --watch are not supported"))
+	}
 	cmd.CheckError(o.SetupKubernikusClient())
 }
 
@@ -34,7 +38,7 @@ func (o *GetOptions) valuesRun(c *cobra.Command, args []string) {
 	name, account := args[0][:idx], args[0][idx+1:]
 	yamlData, err := o.Kubernikus.GetClusterValues(account, name)
 	cmd.CheckError(err)
-	fmt.Println(yamlData)
+	fmt.Fprintln(printers.Out, yamlData)
 }
 
 func validateClusterValuesCommandArgs(args []string) error {
@@ -13,9 +13,17 @@ func NewCommand(name string) *cobra.Command {
 
 	c.AddCommand(
 		NewAuthCommand(),
+		NewConfigCommand(),
 		NewGetCommand(),
 		NewCreateCommand(),
+		NewApplyCommand(),
+		NewDiffCommand(),
+		NewUpdateCommand(),
+		NewScaleCommand(),
+		NewUpgradeCommand(),
 		NewDeleteCommand(),
+		NewUndeleteCommand(),
+		NewDoctorCommand(),
 		NewVersionCommand(),
 	)
 
@@ -0,0 +1,28 @@
+package kubernikusctl
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/sapcc/kubernikus/pkg/cmd/kubernikusctl/common"
+	"github.com/sapcc/kubernikus/pkg/cmd/kubernikusctl/delete"
+)
+
+func undeleteRun(c *cobra.Command, args []string) {
+	c.Help()
+}
+
+func NewUndeleteCommand() *cobra.Command {
+	o := delete.DeleteOptions{
+		Openstack: common.NewOpenstackClient(),
+	}
+
+	c := &cobra.Command{
+		Use:              "undelete [object]",
+		Short:            "Restores an object scheduled for deletion",
+		PersistentPreRun: o.PersistentPreRun,
+		Run:              undeleteRun,
+	}
+	o.BindFlags(c.PersistentFlags())
+	c.AddCommand(o.NewUndeleteClusterCommand())
+	return c
+}
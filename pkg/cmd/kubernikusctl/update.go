@@ -0,0 +1,44 @@
+package kubernikusctl
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/sapcc/kubernikus/pkg/cmd/kubernikusctl/common"
+	"github.com/sapcc/kubernikus/pkg/cmd/kubernikusctl/update"
+)
+
+func updateRun(c *cobra.Command, args []string) {
+	c.Help()
+}
+
+func NewUpdateCommand() *cobra.Command {
+	o := update.UpdateOptions{
+		Openstack: common.NewOpenstackClient(),
+	}
+
+	c := &cobra.Command{
+		Use:              "update [object]",
+		Short:            "Updates an object",
+		PersistentPreRun: o.PersistentPreRun,
+		Run:              updateRun,
+	}
+	o.BindFlags(c.PersistentFlags())
+	c.AddCommand(o.NewNodePoolCommand())
+	return c
+}
+
+func NewScaleCommand() *cobra.Command {
+	o := update.UpdateOptions{
+		Openstack: common.NewOpenstackClient(),
+	}
+
+	c := &cobra.Command{
+		Use:              "scale [object]",
+		Short:            "Scales an object",
+		PersistentPreRun: o.PersistentPreRun,
+		Run:              updateRun,
+	}
+	o.BindFlags(c.PersistentFlags())
+	c.AddCommand(o.NewScaleNodePoolCommand())
+	return c
+}
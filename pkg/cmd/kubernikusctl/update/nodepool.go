@@ -0,0 +1,97 @@
+package update
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	"github.com/sapcc/kubernikus/pkg/cmd"
+	"github.com/sapcc/kubernikus/pkg/cmd/kubernikusctl/common"
+)
+
+func (o *UpdateOptions) NewNodePoolCommand() *cobra.Command {
+	c := &cobra.Command{
+		Use:     "nodepool [cluster] [name]",
+		Short:   "Updates labels, taints and the config of a nodepool",
+		Long:    `Updates the nodepool with the given flags only, the other settings are kept.`,
+		Aliases: []string{"nodepools", "np"},
+		PreRun:  o.nodePoolPreRun,
+		Run:     o.nodePoolRun,
+	}
+	o.nodePool.BindFlags(c.Flags())
+	return c
+}
+
+func (o *UpdateOptions) NewScaleNodePoolCommand() *cobra.Command {
+	c := &cobra.Command{
+		Use:     "nodepool [cluster] [name]",
+		Short:   "Sets the number of nodes of a nodepool",
+		Aliases: []string{"nodepools", "np"},
+		PreRun:  o.scaleNodePoolPreRun,
+		Run:     o.scaleNodePoolRun,
+	}
+	c.Flags().Int64Var(&o.size, "size", -1, "Number of nodes")
+	common.BindWaitFlags(c.Flags(), &o.nodePool.Wait, &o.nodePool.Timeout)
+	return c
+}
+
+func (o *UpdateOptions) nodePoolPreRun(c *cobra.Command, args []string) {
+	cmd.CheckError(validateNodePoolCommandArgs(args))
+	cmd.CheckError(o.SetupKubernikusClient())
+}
+
+func (o *UpdateOptions) nodePoolRun(c *cobra.Command, args []string) {
+	cluster, name := args[0], args[1]
+	var size int64
+	err := o.updateNodePool(cluster, name, func(pool *models.NodePool) {
+		o.nodePool.Apply(pool)
+		size = pool.Size
+	})
+	cmd.CheckError(err)
+	fmt.Printf("Nodepool %v of cluster %v updated.\n", name, cluster)
+
+	if o.nodePool.Wait {
+		cmd.CheckError(o.Kubernikus.WaitForNodePool(cluster, name, size, o.nodePool.Timeout))
+	}
+}
+
+func (o *UpdateOptions) scaleNodePoolPreRun(c *cobra.Command, args []string) {
+	cmd.CheckError(validateNodePoolCommandArgs(args))
+	if o.size < 0 {
+		cmd.CheckError(errors.Errorf("--size is required"))
+	}
+	cmd.CheckError(o.SetupKubernikusClient())
+}
+
+func (o *UpdateOptions) scaleNodePoolRun(c *cobra.Command, args []string) {
+	cluster, name := args[0], args[1]
+	err := o.updateNodePool(cluster, name, func(pool *models.NodePool) {
+		pool.Size = o.size
+	})
+	cmd.CheckError(err)
+	fmt.Printf("Nodepool %v of cluster %v scaled to %d.\n", name, cluster, o.size)
+
+	if o.nodePool.Wait {
+		cmd.CheckError(o.Kubernikus.WaitForNodePool(cluster, name, o.size, o.nodePool.Timeout))
+	}
+}
+
+func (o *UpdateOptions) updateNodePool(cluster, name string, update func(*models.NodePool)) error {
+	return o.Kubernikus.UpdateNodePools(cluster, func(pools []models.NodePool) ([]models.NodePool, error) {
+		i := common.FindNodePool(pools, name)
+		if i < 0 {
+			return nil, errors.Errorf("Nodepool %v not found", name)
+		}
+		update(&pools[i])
+		return pools, nil
+	})
+}
+
+func validateNodePoolCommandArgs(args []string) error {
+	if len(args) != 2 {
+		return errors.Errorf("Please supply the name of the cluster and the nodepool, %v", args)
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package kubernikusctl
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/sapcc/kubernikus/pkg/cmd/kubernikusctl/common"
+	"github.com/sapcc/kubernikus/pkg/cmd/kubernikusctl/upgrade"
+)
+
+func upgradeRun(c *cobra.Command, args []string) {
+	c.Help()
+}
+
+func NewUpgradeCommand() *cobra.Command {
+	o := upgrade.UpgradeOptions{
+		Openstack: common.NewOpenstackClient(),
+	}
+
+	c := &cobra.Command{
+		Use:              "upgrade [object]",
+		Short:            "Upgrades an object to a new version",
+		PersistentPreRun: o.PersistentPreRun,
+		Run:              upgradeRun,
+	}
+	o.BindFlags(c.PersistentFlags())
+	c.AddCommand(o.NewClusterCommand())
+	return c
+}
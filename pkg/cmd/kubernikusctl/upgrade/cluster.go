@@ -0,0 +1,144 @@
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/klog"
+
+	"github.com/sapcc/kubernikus/pkg/cmd"
+)
+
+// PollInterval is the interval in which the upgrade progress is checked
+var PollInterval = 10 * time.Second
+
+func (o *UpgradeOptions) NewClusterCommand() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "cluster [name]",
+		Short: "Upgrades the cluster to a new Kubernetes version",
+		Long: `Changes the version of the cluster and follows the upgrade: first the control
+plane is upgraded, then servicing replaces the nodes of all pools allowing
+replacement. Recent events of the cluster are shown meanwhile.`,
+		Aliases: []string{"clusters"},
+		PreRun:  o.clusterPreRun,
+		Run:     o.clusterRun,
+	}
+	c.Flags().StringVar(&o.to, "to", "", "Kubernetes version to upgrade to")
+	c.Flags().BoolVar(&o.follow, "follow", true, "Follow the upgrade until all nodes run the new version")
+	c.Flags().DurationVar(&o.timeout, "timeout", 4*time.Hour, "How long to follow the upgrade")
+	return c
+}
+
+func (o *UpgradeOptions) clusterPreRun(c *cobra.Command, args []string) {
+	if len(args) != 1 {
+		cmd.CheckError(errors.Errorf("Please supply the name of the cluster to be upgraded."))
+	}
+	if o.to == "" {
+		cmd.CheckError(errors.Errorf("--to is required"))
+	}
+	cmd.CheckError(o.SetupKubernikusClient())
+}
+
+func (o *UpgradeOptions) clusterRun(c *cobra.Command, args []string) {
+	name := args[0]
+
+	info, err := o.Kubernikus.Info()
+	cmd.CheckError(err)
+	if !contains(info.SupportedClusterVersions, o.to) {
+		cmd.CheckError(errors.Errorf("Version %v is not supported, supported versions: %v", o.to, info.SupportedClusterVersions))
+	}
+
+	cluster, err := o.Kubernikus.ShowCluster(name)
+	cmd.CheckError(err)
+	p := newProgress(o.to)
+	// events before the upgrade are not shown
+	events, err := o.Kubernikus.GetClusterEvents(name)
+	cmd.CheckError(err)
+	p.newEvents(events)
+
+	if cluster.Spec.Version != o.to {
+		cluster.Spec.Version = o.to
+		_, err = o.Kubernikus.UpdateCluster(cluster)
+		cmd.CheckError(err)
+		fmt.Printf("Upgrade of cluster %v to %v requested.\n", name, o.to)
+	}
+	if !o.follow {
+		return
+	}
+	cmd.CheckError(o.followUpgrade(name, p))
+	fmt.Printf("Cluster %v upgraded to %v.\n", name, o.to)
+}
+
+func (o *UpgradeOptions) followUpgrade(name string, p *progress) error {
+	var nodes kubernetes.Interface
+	err := wait.PollImmediate(PollInterval, o.timeout, func() (bool, error) { //nolint:staticcheck
+		cluster, err := o.Kubernikus.ShowCluster(name)
+		if err != nil {
+			return false, err
+		}
+		lines, controlPlaneReady := p.cluster(cluster)
+		if events, err := o.Kubernikus.GetClusterEvents(name); err == nil {
+			lines = append(lines, p.newEvents(events)...)
+		} else {
+			klog.V(2).Infof("Error getting events: %v", err)
+		}
+		printLines(lines)
+		if !controlPlaneReady {
+			return false, nil
+		}
+
+		if nodes == nil {
+			fmt.Println("Control plane upgraded, waiting for the nodes to be replaced")
+			if nodes, err = o.clusterClient(name); err != nil {
+				return false, err
+			}
+		}
+		list, err := nodes.CoreV1().Nodes().List(context.TODO(), meta_v1.ListOptions{})
+		if err != nil {
+			klog.V(2).Infof("Error listing nodes: %v", err)
+			return false, nil
+		}
+		lines, done := p.kubelets(list.Items, cluster.Spec.NodePools)
+		printLines(lines)
+		return done, nil
+	})
+	if err == wait.ErrWaitTimeout { //nolint:staticcheck
+		return errors.Errorf("Upgrade not finished after %v", o.timeout)
+	}
+	return err
+}
+
+// clusterClient connects to the cluster with the credentials of the user
+func (o *UpgradeOptions) clusterClient(name string) (kubernetes.Interface, error) {
+	kubeconfig, err := o.Kubernikus.GetCredentials(name)
+	if err != nil {
+		return nil, err
+	}
+	config, err := clientcmd.RESTConfigFromKubeConfig([]byte(kubeconfig))
+	if err != nil {
+		return nil, errors.Wrap(err, "Error parsing credentials")
+	}
+	return kubernetes.NewForConfig(config)
+}
+
+func printLines(lines []string) {
+	for _, line := range lines {
+		fmt.Println(line)
+	}
+}
+
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package upgrade
+
+import (
+	"fmt"
+
+	core_v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/version"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// NodePoolLabel is set on the nodes by their ignition config
+const NodePoolLabel = "ccloud.sap.com/nodepool"
+
+// progress tracks an upgrade and reports what changed since the last poll
+type progress struct {
+	to     string
+	phase  models.KlusterPhase
+	events map[string]bool
+	nodes  string
+}
+
+func newProgress(to string) *progress {
+	return &progress{to: to, events: map[string]bool{}}
+}
+
+// cluster reports phase transitions. The control plane is upgraded once
+// groundctl moves the cluster back to Running with the new apiserver version,
+// which it does when all control plane pods are ready.
+func (p *progress) cluster(cluster *models.Kluster) (lines []string, controlPlaneReady bool) {
+	if cluster.Status.Phase != p.phase {
+		lines = append(lines, fmt.Sprintf("Cluster is %s", cluster.Status.Phase))
+		p.phase = cluster.Status.Phase
+	}
+	return lines, cluster.Status.Phase == models.KlusterPhaseRunning && sameVersion(cluster.Status.ApiserverVersion, p.to)
+}
+
+// newEvents returns the events not seen before
+func (p *progress) newEvents(events []*models.Event) []string {
+	var lines []string
+	for _, event := range events {
+		key := fmt.Sprintf("%s/%s/%s/%d", event.Reason, event.Message, event.LastTimestamp, event.Count)
+		if p.events[key] {
+			continue
+		}
+		p.events[key] = true
+		lines = append(lines, fmt.Sprintf("%s %s %s: %s", event.LastTimestamp, event.Type, event.Reason, event.Message))
+	}
+	return lines
+}
+
+// kubelets reports how many nodes run the new version. Nodes of pools not
+// allowing replacement are not upgraded by servicing and are skipped.
+func (p *progress) kubelets(nodes []core_v1.Node, pools []models.NodePool) (lines []string, done bool) {
+	skipped := map[string]bool{}
+	for _, pool := range pools {
+		if pool.Config != nil && pool.Config.AllowReplace != nil && !*pool.Config.AllowReplace {
+			skipped[pool.Name] = true
+		}
+	}
+
+	var upgraded, total int
+	for _, node := range nodes {
+		if skipped[node.Labels[NodePoolLabel]] {
+			continue
+		}
+		total++
+		if sameVersion(node.Status.NodeInfo.KubeletVersion, p.to) {
+			upgraded++
+		}
+	}
+
+	status := fmt.Sprintf("%d/%d nodes run %s", upgraded, total, p.to)
+	if status != p.nodes {
+		lines = append(lines, status)
+		p.nodes = status
+	}
+	return lines, upgraded == total
+}
+
+func sameVersion(current, to string) bool {
+	v, err := version.ParseGeneric(current)
+	if err != nil {
+		return false
+	}
+	return v.String() == to
+}
@@ -0,0 +1,69 @@
+package upgrade
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+func node(pool, kubelet string) core_v1.Node {
+	return core_v1.Node{
+		ObjectMeta: meta_v1.ObjectMeta{Labels: map[string]string{NodePoolLabel: pool}},
+		Status:     core_v1.NodeStatus{NodeInfo: core_v1.NodeSystemInfo{KubeletVersion: kubelet}},
+	}
+}
+
+func TestProgressCluster(t *testing.T) {
+	p := newProgress("1.31.2")
+	cluster := &models.Kluster{Status: models.KlusterStatus{Phase: models.KlusterPhaseRunning, ApiserverVersion: "1.30.5"}}
+
+	lines, ready := p.cluster(cluster)
+	assert.Equal(t, []string{"Cluster is Running"}, lines)
+	assert.False(t, ready)
+
+	cluster.Status.Phase = models.KlusterPhaseUpgrading
+	lines, ready = p.cluster(cluster)
+	assert.Equal(t, []string{"Cluster is Upgrading"}, lines)
+	assert.False(t, ready)
+
+	cluster.Status.ApiserverVersion = "1.31.2"
+	lines, ready = p.cluster(cluster)
+	assert.Empty(t, lines)
+	assert.False(t, ready)
+
+	cluster.Status.Phase = models.KlusterPhaseRunning
+	_, ready = p.cluster(cluster)
+	assert.True(t, ready)
+}
+
+func TestProgressEvents(t *testing.T) {
+	p := newProgress("1.31.2")
+	event := &models.Event{Reason: "failedUpgrade", Message: "oops", LastTimestamp: "2026-10-19T10:00:00Z", Type: "Warning", Count: 1}
+
+	assert.Len(t, p.newEvents([]*models.Event{event}), 1)
+	assert.Empty(t, p.newEvents([]*models.Event{event}))
+
+	event.Count = 2
+	assert.Equal(t, []string{"2026-10-19T10:00:00Z Warning failedUpgrade: oops"}, p.newEvents([]*models.Event{event}))
+}
+
+func TestProgressKubelets(t *testing.T) {
+	p := newProgress("1.31.2")
+	allowReplace := false
+	pools := []models.NodePool{{Name: "default"}, {Name: "pinned", Config: &models.NodePoolConfig{AllowReplace: &allowReplace}}}
+
+	lines, done := p.kubelets([]core_v1.Node{node("default", "v1.31.2"), node("default", "v1.30.5"), node("pinned", "v1.30.5")}, pools)
+	assert.Equal(t, []string{"1/2 nodes run 1.31.2"}, lines)
+	assert.False(t, done)
+
+	lines, done = p.kubelets([]core_v1.Node{node("default", "v1.31.2"), node("default", "v1.31.2"), node("pinned", "v1.30.5")}, pools)
+	assert.Equal(t, []string{"2/2 nodes run 1.31.2"}, lines)
+	assert.True(t, done)
+
+	lines, _ = p.kubelets([]core_v1.Node{node("default", "v1.31.2"), node("default", "v1.31.2")}, pools)
+	assert.Empty(t, lines)
+}
@@ -0,0 +1,140 @@
+package printers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/yaml"
+)
+
+// Out receives all output formats, including the human readable ones
+var Out io.Writer = os.Stdout
+
+// OutputFormats lists the values accepted by ParseOutput
+var OutputFormats = []string{"json", "yaml", "wide", "name", "jsonpath=...", "go-template=..."}
+
+// Named is implemented by objects supporting the name output format
+type Named interface {
+	ResourceName() string
+}
+
+// Output mirrors the -o flag of kubectl
+type Output struct {
+	Format   string
+	Template string
+}
+
+// ParseOutput parses the value of the -o flag, an empty value selects the
+// default human readable output
+func ParseOutput(value string) (Output, error) {
+	format, template, _ := strings.Cut(value, "=")
+	output := Output{Format: format, Template: template}
+	switch format {
+	case "", "json", "yaml", "wide", "name":
+		if template != "" {
+			return output, errors.Errorf("Output format %s doesn't take an argument", format)
+		}
+	case "jsonpath", "go-template":
+		if template == "" {
+			return output, errors.Errorf("Output format %s needs a template, e.g. %s={.name}", format, format)
+		}
+	default:
+		return output, errors.Errorf("Unknown output format %q, supported formats: %s", value, strings.Join(OutputFormats, ", "))
+	}
+	return output, nil
+}
+
+// PrintObject prints a single object, by default in the Human format
+func (o Output) PrintObject(item Printable) error {
+	switch o.Format {
+	case "":
+		return item.Print(Human, PrintOptions{})
+	case "wide":
+		return printTable([]Printable{item}, true)
+	default:
+		return o.print(item, []Printable{item})
+	}
+}
+
+// PrintList prints a list of objects, by default as a table. Structured
+// formats get a list object with the items, like kubectl.
+func (o Output) PrintList(items []Printable) error {
+	switch o.Format {
+	case "":
+		return PrintTable(items)
+	case "wide":
+		return printTable(items, true)
+	default:
+		return o.print(map[string]interface{}{"kind": "List", "items": items}, items)
+	}
+}
+
+func (o Output) print(obj interface{}, items []Printable) error {
+	switch o.Format {
+	case "json":
+		data, err := json.MarshalIndent(obj, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(Out, string(data))
+		return err
+	case "yaml":
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		_, err = Out.Write(data)
+		return err
+	case "name":
+		for _, item := range items {
+			named, ok := item.(Named)
+			if !ok {
+				return errors.Errorf("Output format name is not supported for %T", item)
+			}
+			fmt.Fprintln(Out, named.ResourceName())
+		}
+		return nil
+	case "jsonpath":
+		data, err := generic(obj)
+		if err != nil {
+			return err
+		}
+		parser := jsonpath.New("output").AllowMissingKeys(true)
+		if err := parser.Parse(o.Template); err != nil {
+			return errors.Wrap(err, "Error parsing jsonpath")
+		}
+		if err := parser.Execute(Out, data); err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(Out)
+		return err
+	case "go-template":
+		data, err := generic(obj)
+		if err != nil {
+			return err
+		}
+		tmpl, err := template.New("output").Parse(o.Template)
+		if err != nil {
+			return errors.Wrap(err, "Error parsing go-template")
+		}
+		return tmpl.Execute(Out, data)
+	}
+	return errors.Errorf("Unknown output format %s", o.Format)
+}
+
+// generic converts the object to plain maps and slices using its JSON field
+// names, which is what the templates refer to
+func generic(obj interface{}) (interface{}, error) {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var data interface{}
+	return data, json.Unmarshal(raw, &data)
+}
@@ -0,0 +1,73 @@
+package printers
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type pool struct {
+	Name string `json:"name"`
+	Size int    `json:"size"`
+}
+
+func (p pool) GetFormats() map[PrintFormat]struct{} {
+	return map[PrintFormat]struct{}{Table: {}}
+}
+
+func (p pool) Print(format PrintFormat, options PrintOptions) error {
+	return nil
+}
+
+func (p pool) ResourceName() string {
+	return "nodepool/" + p.Name
+}
+
+func TestParseOutput(t *testing.T) {
+	for value, expected := range map[string]Output{
+		"":                        {},
+		"json":                    {Format: "json"},
+		"wide":                    {Format: "wide"},
+		"jsonpath={.name}":        {Format: "jsonpath", Template: "{.name}"},
+		"go-template={{.name}}":   {Format: "go-template", Template: "{{.name}}"},
+		"jsonpath={.a=='b'}":      {Format: "jsonpath", Template: "{.a=='b'}"},
+		"go-template={{ .a }}={}": {Format: "go-template", Template: "{{ .a }}={}"},
+	} {
+		output, err := ParseOutput(value)
+		if assert.NoError(t, err, value) {
+			assert.Equal(t, expected, output, value)
+		}
+	}
+
+	for _, value := range []string{"xml", "json=x", "jsonpath", "go-template="} {
+		_, err := ParseOutput(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestOutputFormats(t *testing.T) {
+	var buf bytes.Buffer
+	Out = &buf
+	items := []Printable{pool{Name: "a", Size: 1}, pool{Name: "b", Size: 2}}
+
+	for value, expected := range map[string]string{
+		"json":                      "{\n  \"items\": [\n    {\n      \"name\": \"a\",\n      \"size\": 1\n    },\n    {\n      \"name\": \"b\",\n      \"size\": 2\n    }\n  ],\n  \"kind\": \"List\"\n}\n",
+		"yaml":                      "items:\n- name: a\n  size: 1\n- name: b\n  size: 2\nkind: List\n",
+		"name":                      "nodepool/a\nnodepool/b\n",
+		"jsonpath={.items[*].name}": "a b\n",
+		"go-template={{range .items}}{{.size}}{{end}}": "12",
+	} {
+		buf.Reset()
+		output, err := ParseOutput(value)
+		require.NoError(t, err)
+		require.NoError(t, output.PrintList(items), value)
+		assert.Equal(t, expected, buf.String(), value)
+	}
+
+	buf.Reset()
+	output, _ := ParseOutput("jsonpath={.size}")
+	require.NoError(t, output.PrintObject(pool{Name: "a", Size: 3}))
+	assert.Equal(t, "3\n", buf.String())
+}
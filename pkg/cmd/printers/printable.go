@@ -13,6 +13,8 @@ const (
 
 type PrintOptions struct {
 	WithHeaders bool
+	// Wide adds more columns to the Table format
+	Wide bool
 }
 
 type Printable interface {
@@ -21,13 +23,17 @@ type Printable interface {
 }
 
 func PrintTable(list []Printable) error {
+	return printTable(list, false)
+}
+
+func printTable(list []Printable, wide bool) error {
 	first := true
 	for _, item := range list {
 		_, ok := item.GetFormats()[Table]
 		if !ok {
 			return errors.Errorf("Unsupported print format table supported formats: %v, %v", item, item.GetFormats())
 		}
-		item.Print(Table, PrintOptions{WithHeaders: first})
+		item.Print(Table, PrintOptions{WithHeaders: first, Wide: wide})
 		first = false
 	}
 	return nil
@@ -2,36 +2,88 @@ package certs
 
 import (
 	"fmt"
+	"strconv"
+	"sync"
 	"time"
 
 	kitlog "github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	core_v1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 
+	"github.com/sapcc/kubernikus/pkg/api/models"
 	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
 	"github.com/sapcc/kubernikus/pkg/controller/base"
 	"github.com/sapcc/kubernikus/pkg/controller/config"
+	"github.com/sapcc/kubernikus/pkg/controller/events"
+	"github.com/sapcc/kubernikus/pkg/controller/metrics"
 	"github.com/sapcc/kubernikus/pkg/util"
 )
 
 type certsController struct {
-	logger kitlog.Logger
-	config config.Config
-	client kubernetes.Interface
+	logger   kitlog.Logger
+	config   config.Config
+	client   kubernetes.Interface
+	recorder record.EventRecorder
 }
 
-func New(syncPeriod time.Duration, factories config.Factories, config config.Config, clients config.Clients, logger kitlog.Logger) base.Controller {
+func New(syncPeriod time.Duration, factories config.Factories, config config.Config, clients config.Clients, recorder record.EventRecorder, logger kitlog.Logger) base.Controller {
 	logger = kitlog.With(logger, "controller", "certs")
 
 	certs := certsController{
-		logger: logger,
-		config: config,
-		client: clients.Kubernetes,
+		logger:   logger,
+		config:   config,
+		client:   clients.Kubernetes,
+		recorder: recorder,
 	}
 
-	return base.NewPollingController(syncPeriod, factories.Kubernikus.Kubernikus().V1().Klusters(), &certs, logger)
+	// drop the expiry metrics of deleted klusters right away, they would
+	// otherwise linger until the operator restarts
+	factories.Kubernikus.Kubernikus().V1().Klusters().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if kluster, ok := obj.(*v1.Kluster); ok {
+				metrics.CertificateExpiry.DeletePartialMatch(prometheus.Labels{"kluster": kluster.GetName()})
+			}
+		},
+	})
+
+	rotation := rotationController{
+		logger:        kitlog.With(logger, "loop", "rotation"),
+		config:        config,
+		client:        clients.Kubernetes,
+		kubernikus:    clients.Kubernikus,
+		klusterLister: factories.Kubernikus.Kubernikus().V1().Klusters().Lister(),
+		satellites:    clients.Satellites,
+		recorder:      recorder,
+	}
+
+	// certificates are renewed rarely, running CA rotations are driven more often
+	return controllers{
+		base.NewPollingController(syncPeriod, factories.Kubernikus.Kubernikus().V1().Klusters(), &certs, logger),
+		base.NewPollingController(RotationSyncPeriod, factories.Kubernikus.Kubernikus().V1().Klusters(), &rotation, rotation.logger),
+	}
+}
+
+type controllers []base.Controller
+
+func (c controllers) Run(stopCh <-chan struct{}, wg *sync.WaitGroup) {
+	for _, controller := range c {
+		go controller.Run(stopCh, wg)
+	}
+	<-stopCh
 }
 
 func (cc *certsController) Reconcile(kluster *v1.Kluster) (err error) {
+	if kluster.Status.Phase == models.KlusterPhaseTerminating {
+		metrics.CertificateExpiry.DeletePartialMatch(prometheus.Labels{"kluster": kluster.GetName()})
+		return nil
+	}
+
 	secret, err := util.KlusterSecret(cc.client, kluster)
 	if err != nil {
 		return fmt.Errorf("couldn't get kluster secret: %s", err)
@@ -50,6 +102,17 @@ func (cc *certsController) Reconcile(kluster *v1.Kluster) (err error) {
 		}
 
 		cc.logger.Log("msg", "Certificates updated", "kluster", kluster.Name, "changes", fmt.Sprintf("%#v", updates))
+		for _, update := range updates {
+			cc.recorder.Eventf(kluster, core_v1.EventTypeNormal, events.CertificateRenewed, "Renewed %s %s: %s", update.Type, update.Name, update.Reason)
+		}
+	}
+
+	certificates, err := util.ParseCertificates(&secret.Certificates)
+	if err != nil {
+		return fmt.Errorf("couldn't parse certificates: %s", err)
+	}
+	for _, cert := range certificates {
+		metrics.CertificateExpiry.WithLabelValues(kluster.GetName(), cert.Name, strconv.FormatBool(cert.IsCA)).Set(float64(cert.NotAfter.Unix()))
 	}
 
 	return nil
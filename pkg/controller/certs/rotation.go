@@ -0,0 +1,302 @@
+package certs
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	kitlog "github.com/go-kit/log"
+	apps_v1 "k8s.io/api/apps/v1"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	kube "github.com/sapcc/kubernikus/pkg/client/kubernetes"
+	"github.com/sapcc/kubernikus/pkg/controller/config"
+	"github.com/sapcc/kubernikus/pkg/controller/events"
+	"github.com/sapcc/kubernikus/pkg/controller/servicing"
+	kubernikus_clientset "github.com/sapcc/kubernikus/pkg/generated/clientset"
+	kubernikus_listers "github.com/sapcc/kubernikus/pkg/generated/listers/kubernikus/v1"
+	"github.com/sapcc/kubernikus/pkg/util"
+	etcd_util "github.com/sapcc/kubernikus/pkg/util/etcd"
+)
+
+const (
+	// RotationSyncPeriod is the interval in which running CA rotations are driven
+	RotationSyncPeriod = 30 * time.Second
+	// AnnotationCARotation is set on the pod templates of the control plane
+	// to roll it after each phase of a CA rotation
+	AnnotationCARotation = "kubernikus.cloud.sap/ca-rotation"
+)
+
+type rotationController struct {
+	logger        kitlog.Logger
+	config        config.Config
+	client        kubernetes.Interface
+	kubernikus    kubernikus_clientset.Interface
+	klusterLister kubernikus_listers.KlusterLister
+	satellites    kube.SharedClientFactory
+	recorder      record.EventRecorder
+	// usersCARevisions caches the users CA revision applied per kluster UID
+	usersCARevisions sync.Map
+}
+
+func (rc *rotationController) Reconcile(kluster *v1.Kluster) error {
+	if err := rc.ensureUsersCA(kluster); err != nil {
+		return err
+	}
+
+	rotation := kluster.Status.CaRotation
+	if rotation == nil || rotation.Phase == models.CARotationPhaseCompleted || rotation.Waiting {
+		return nil
+	}
+	if kluster.Status.Phase != models.KlusterPhaseRunning {
+		return nil
+	}
+
+	secret, err := util.KlusterSecret(rc.client, kluster)
+	if err != nil {
+		return fmt.Errorf("couldn't get kluster secret: %s", err)
+	}
+
+	switch rotation.Phase {
+	case models.CARotationPhaseTrustNew:
+		added, err := util.CATrustAdded(&secret.Certificates, rotation.Authorities)
+		if err != nil {
+			return err
+		}
+		if !added {
+			if err := rc.ensureEtcdSnapshot(kluster, "CA rotation"); err != nil {
+				return fmt.Errorf("couldn't take etcd snapshot: %s", err)
+			}
+			if err := util.AddCATrust(kluster, &secret.Certificates, rotation.Authorities); err != nil {
+				return fmt.Errorf("couldn't add new CAs: %s", err)
+			}
+			return rc.updateSecret(kluster, secret, "Added new CAs to the trust bundles")
+		}
+	case models.CARotationPhaseReissue:
+		pending, err := util.CATrustAdded(&secret.Certificates, rotation.Authorities)
+		if err != nil {
+			return err
+		}
+		if pending {
+			if err := util.SwitchCASigning(&secret.Certificates, rotation.Authorities); err != nil {
+				return fmt.Errorf("couldn't switch CAs: %s", err)
+			}
+			if _, err := util.NewCertificateFactory(kluster, &secret.Certificates, rc.config.Kubernikus.Domain).Ensure(); err != nil {
+				return fmt.Errorf("couldn't reissue certificates: %s", err)
+			}
+			return rc.updateSecret(kluster, secret, "Switched to the new CAs and reissued all certificates")
+		}
+	case models.CARotationPhaseRemoveOld:
+		removed, err := util.OldCAsRemoved(&secret.Certificates, rotation.Authorities)
+		if err != nil {
+			return err
+		}
+		if !removed {
+			if err := util.RemoveOldCAs(&secret.Certificates, rotation.Authorities); err != nil {
+				return fmt.Errorf("couldn't remove old CAs: %s", err)
+			}
+			return rc.updateSecret(kluster, secret, "Removed old CAs from the trust bundles")
+		}
+	default:
+		return fmt.Errorf("unknown CA rotation phase %s", rotation.Phase)
+	}
+
+	done, err := rc.rollControlPlane(kluster, string(rotation.Phase))
+	if err != nil || !done {
+		return err
+	}
+
+	// nodes are replaced once the new CAs sign, their replacements trust both
+	// bundles and carry certificates of the new CAs
+	if rotation.Phase == models.CARotationPhaseReissue {
+		done, err = rc.rollNodes(kluster, rotation.PhaseStartedAt)
+		if err != nil || !done {
+			return err
+		}
+	}
+
+	return rc.advance(kluster)
+}
+
+func (rc *rotationController) updateSecret(kluster *v1.Kluster, secret *v1.Secret, message string) error {
+	if err := util.UpdateKlusterSecret(rc.client, kluster, secret); err != nil {
+		return fmt.Errorf("couldn't update kluster secret: %s", err)
+	}
+	rc.logger.Log("msg", message, "kluster", kluster.Name, "phase", kluster.Status.CaRotation.Phase)
+	rc.recorder.Eventf(kluster, core_v1.EventTypeNormal, events.CARotationProgressing, "%s", message)
+	return nil
+}
+
+// ensureEtcdSnapshot takes a full etcd snapshot before the certificates of
+// the kluster are touched for the first time
+func (rc *rotationController) ensureEtcdSnapshot(kluster *v1.Kluster, reason string) error {
+	snapshot, taken, err := etcd_util.EnsureFullSnapshot(rc.client, kluster, reason, func(snapshot models.EtcdSnapshotReference) error {
+		_, err := util.UpdateKlusterWithRetries(rc.kubernikus.KubernikusV1().Klusters(kluster.Namespace), rc.klusterLister.Klusters(kluster.Namespace), kluster.GetName(), func(kluster *v1.Kluster) error {
+			etcd_util.RecordSnapshot(kluster, snapshot)
+			return nil
+		})
+		return err
+	})
+	if err != nil || !taken {
+		return err
+	}
+	rc.logger.Log("msg", "took etcd snapshot", "kluster", kluster.Name, "snapshot", snapshot.Name, "reason", reason)
+	rc.recorder.Eventf(kluster, core_v1.EventTypeNormal, events.EtcdSnapshotTaken, "Took etcd snapshot %s before %s", snapshot.Name, reason)
+	return nil
+}
+
+// ensureUsersCA replaces the users CA when its revision annotation was
+// increased to revoke all user certificates. The apiserver reloads its client
+// CAs, no restart is needed.
+func (rc *rotationController) ensureUsersCA(kluster *v1.Kluster) error {
+	revision := kluster.Annotations[v1.UserCARevisionAnnotationKey]
+	if revision == "" || kluster.Status.Phase == models.KlusterPhaseTerminating {
+		return nil
+	}
+	if applied, ok := rc.usersCARevisions.Load(kluster.GetUID()); ok && applied == revision {
+		return nil
+	}
+
+	secret, err := util.KlusterSecret(rc.client, kluster)
+	if err != nil {
+		return fmt.Errorf("couldn't get kluster secret: %s", err)
+	}
+	if secret.ApiserverUsersCARevision != revision {
+		if err := util.RotateUsersCA(kluster, &secret.Certificates, revision); err != nil {
+			return fmt.Errorf("couldn't rotate users CA: %s", err)
+		}
+		if err := util.UpdateKlusterSecret(rc.client, kluster, secret); err != nil {
+			return fmt.Errorf("couldn't update kluster secret: %s", err)
+		}
+		rc.logger.Log("msg", "rotated users CA", "kluster", kluster.Name, "revision", revision)
+		rc.recorder.Event(kluster, core_v1.EventTypeNormal, events.UserCertificatesRevoked, "Replaced the users CA, all user certificates issued before are revoked")
+	}
+	rc.usersCARevisions.Store(kluster.GetUID(), revision)
+	return nil
+}
+
+// rollControlPlane restarts all control plane deployments once per phase and
+// tells if they are rolled out
+func (rc *rotationController) rollControlPlane(kluster *v1.Kluster, phase string) (bool, error) {
+	deployments, err := rc.client.AppsV1().Deployments(kluster.Namespace).List(context.TODO(), meta_v1.ListOptions{LabelSelector: "release=" + kluster.GetName()})
+	if err != nil {
+		return false, fmt.Errorf("couldn't list control plane deployments: %s", err)
+	}
+
+	done := true
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		if deployment.Spec.Template.Annotations[AnnotationCARotation] != phase {
+			if deployment.Spec.Template.Annotations == nil {
+				deployment.Spec.Template.Annotations = map[string]string{}
+			}
+			deployment.Spec.Template.Annotations[AnnotationCARotation] = phase
+			if _, err := rc.client.AppsV1().Deployments(kluster.Namespace).Update(context.TODO(), deployment, meta_v1.UpdateOptions{}); err != nil {
+				return false, fmt.Errorf("couldn't restart deployment %s: %s", deployment.Name, err)
+			}
+			rc.logger.Log("msg", "restarted deployment", "kluster", kluster.Name, "deployment", deployment.Name, "phase", phase)
+			done = false
+			continue
+		}
+		if !rolledOut(deployment) {
+			done = false
+		}
+	}
+	return done, nil
+}
+
+func rolledOut(deployment *apps_v1.Deployment) bool {
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	status := deployment.Status
+	return status.ObservedGeneration >= deployment.Generation &&
+		status.UpdatedReplicas == replicas &&
+		status.AvailableReplicas == replicas &&
+		status.Replicas == replicas
+}
+
+// rollNodes marks all nodes created before the given time for replacement by
+// the servicing controller and tells if none of them are left
+func (rc *rotationController) rollNodes(kluster *v1.Kluster, since string) (bool, error) {
+	start, err := time.Parse(time.RFC3339, since)
+	if err != nil {
+		return false, fmt.Errorf("couldn't parse phase start %q: %s", since, err)
+	}
+	client, err := rc.satellites.ClientFor(kluster)
+	if err != nil {
+		return false, err
+	}
+	nodes, err := client.CoreV1().Nodes().List(context.TODO(), meta_v1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("couldn't list nodes: %s", err)
+	}
+
+	done := true
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		if !node.CreationTimestamp.Time.Before(start) {
+			continue
+		}
+		done = false
+		if util.EnabledValue(node.Annotations[servicing.AnnotationNodeForceReplace]) {
+			continue
+		}
+		if node.Annotations == nil {
+			node.Annotations = map[string]string{}
+		}
+		node.Annotations[servicing.AnnotationNodeForceReplace] = "true"
+		if _, err := client.CoreV1().Nodes().Update(context.TODO(), node, meta_v1.UpdateOptions{}); err != nil {
+			return false, fmt.Errorf("couldn't mark node %s for replacement: %s", node.Name, err)
+		}
+		rc.logger.Log("msg", "marked node for replacement", "kluster", kluster.Name, "node", node.Name)
+	}
+	return done, nil
+}
+
+// advance moves the rotation to the next phase. Manual rotations wait for the
+// API instead.
+func (rc *rotationController) advance(kluster *v1.Kluster) error {
+	var rotation *models.CARotationStatus
+	_, err := util.UpdateKlusterWithRetries(rc.kubernikus.KubernikusV1().Klusters(kluster.Namespace), rc.klusterLister.Klusters(kluster.Namespace), kluster.GetName(), func(kluster *v1.Kluster) error {
+		if kluster.Status.CaRotation == nil || kluster.Status.CaRotation.Waiting {
+			return util.ErrKlusterNotUpdated
+		}
+		now := time.Now().UTC().Format(time.RFC3339)
+		status := kluster.Status.CaRotation
+		next := util.NextCARotationPhase(status.Phase)
+		switch {
+		case next == models.CARotationPhaseCompleted:
+			status.Phase = next
+			status.CompletedAt = now
+		case status.Manual:
+			status.Waiting = true
+		default:
+			status.Phase = next
+			status.PhaseStartedAt = now
+		}
+		rotation = status
+		return nil
+	})
+	if err != nil || rotation == nil {
+		return err
+	}
+
+	switch {
+	case rotation.Phase == models.CARotationPhaseCompleted:
+		rc.recorder.Event(kluster, core_v1.EventTypeNormal, events.CARotationCompleted, "CA rotation completed")
+	case rotation.Waiting:
+		rc.recorder.Eventf(kluster, core_v1.EventTypeNormal, events.CARotationProgressing, "CA rotation phase %s rolled out, waiting to be advanced", rotation.Phase)
+	default:
+		rc.recorder.Eventf(kluster, core_v1.EventTypeNormal, events.CARotationProgressing, "CA rotation entered phase %s", rotation.Phase)
+	}
+	rc.logger.Log("msg", "CA rotation advanced", "kluster", kluster.Name, "phase", rotation.Phase, "waiting", rotation.Waiting)
+	return nil
+}
@@ -119,14 +119,36 @@ func (d *DeorbitReconciler) deorbit(kluster *v1.Kluster) (err error) {
 		return fmt.Errorf("could not create block storage client: %v", err)
 	}
 
-	deorbiter, err := NewDeorbiter(kluster, done, d.Clients, d.Recorder, logger, serviceClient)
+	networkClient, err := openstack.NewNetworkV2(providerClient, gophercloud.EndpointOpts{})
+	if err != nil {
+		return fmt.Errorf("could not create network client: %v", err)
+	}
+
+	// Not every region offers Octavia. Without it there are no load balancers to clean up.
+	loadBalancerClient, err := openstack.NewLoadBalancerV2(providerClient, gophercloud.EndpointOpts{})
+	if err != nil {
+		logger.Log("msg", "no load balancer endpoint, skipping load balancer cleanup", "err", err, "v", 2)
+		loadBalancerClient = nil
+	}
+
+	computeClient, err := openstack.NewComputeV2(providerClient, gophercloud.EndpointOpts{})
+	if err != nil {
+		return fmt.Errorf("could not create compute client: %v", err)
+	}
+
+	adminClient, err := d.Openstack.AdminClient()
+	if err != nil {
+		return fmt.Errorf("could not create admin client: %v", err)
+	}
+
+	deorbiter, err := NewDeorbiter(kluster, done, d.Clients, d.Recorder, logger, serviceClient, networkClient, loadBalancerClient, computeClient, adminClient)
 	if err != nil {
 		return err
 	}
 
 	err = d.doDeorbit(deorbiter)
 
-	return d.doSelfDestruct(deorbiter, err)
+	return d.doSelfDestruct(kluster, deorbiter, err)
 }
 
 func (d *DeorbitReconciler) doDeorbit(deorbiter Deorbiter) (err error) {
@@ -158,15 +180,22 @@ func (d *DeorbitReconciler) doDeorbit(deorbiter Deorbiter) (err error) {
 		return err
 	}
 
+	// Catch load balancers and floating IPs the cloud controller manager
+	// failed to remove, e.g. because their services were not of type
+	// LoadBalancer anymore.
+	if _, err := deorbiter.DeleteOpenstackDebris(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-func (d *DeorbitReconciler) doSelfDestruct(deorbiter Deorbiter, err error) error {
+func (d *DeorbitReconciler) doSelfDestruct(kluster *v1.Kluster, deorbiter Deorbiter, err error) error {
 	// If for some reason communication with the Kluster's apiserver is not possible,
 	// we retry until a timeout is reached. Then self-destruct and accept debris.
 	if errors.IsUnexpectedServerError(err) || errors.IsServerTimeout(err) {
 		if deorbiter.IsAPIUnavailableTimeout() {
-			return deorbiter.SelfDestruct(APIUnavailable)
+			return d.selfDestruct(kluster, deorbiter, APIUnavailable)
 		}
 	}
 
@@ -176,8 +205,25 @@ func (d *DeorbitReconciler) doSelfDestruct(deorbiter Deorbiter, err error) error
 	// Kluster automatically without human interaction. It frees up the Kluster with
 	// the downside of potential debris in the customer's project.
 	if deorbiter.IsDeorbitHangingTimeout() {
-		return deorbiter.SelfDestruct(DeorbitHanging)
+		return d.selfDestruct(kluster, deorbiter, DeorbitHanging)
 	}
 
 	return err
 }
+
+func (d *DeorbitReconciler) selfDestruct(kluster *v1.Kluster, deorbiter Deorbiter, reason SelfDestructReason) error {
+	// Without a cloud controller manager nobody deletes the load balancers.
+	// Remove what we can find directly. This is best effort, failures are
+	// reported by the deorbiter and must not prevent self-destruction.
+	deorbiter.DeleteOpenstackDebris()
+
+	// Record what is left behind so support can clean up or bill. The report
+	// outlives the kluster. An incomplete inventory is still worth saving.
+	if report, _ := deorbiter.TakeInventory(reason); report != nil {
+		if err := util.SaveTerminationReport(d.Clients.Kubernetes, kluster.Namespace, kluster.Name, report); err != nil {
+			d.Logger.Log("msg", "failed to save termination report", "kluster", kluster.Name, "err", err)
+		}
+	}
+
+	return deorbiter.SelfDestruct(reason)
+}
@@ -3,9 +3,14 @@ package deorbit
 import (
 	"testing"
 
+	"github.com/go-kit/log"
 	"github.com/stretchr/testify/assert"
 	core_v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/sapcc/kubernikus/pkg/controller/config"
+	"github.com/sapcc/kubernikus/pkg/util"
 )
 
 var (
@@ -13,7 +18,10 @@ var (
 )
 
 func TestDeorbit(testing *testing.T) {
-	reconciler := &DeorbitReconciler{}
+	reconciler := &DeorbitReconciler{
+		Clients: config.Clients{Kubernetes: fake.NewSimpleClientset()},
+		Logger:  log.NewNopLogger(),
+	}
 
 	deorbiter := &FakeDeorbiter{
 		CinderPVCCount: 3,
@@ -22,14 +30,16 @@ func TestDeorbit(testing *testing.T) {
 	}
 
 	err := reconciler.doDeorbit(deorbiter)
-	err = reconciler.doSelfDestruct(deorbiter, err)
+	err = reconciler.doSelfDestruct(kluster, deorbiter, err)
 	assert.Equal(testing, true, deorbiter.HasCalledDeleteSnapshots)
 	assert.Equal(testing, true, deorbiter.HasCalledDeletePersistentVolumeClaims)
 	assert.Equal(testing, true, deorbiter.HasCalledDeleteServices)
 	assert.Equal(testing, true, deorbiter.HasCalledWaitForSnapshotCleanup)
 	assert.Equal(testing, true, deorbiter.HasCalledWaitForPersistentVolumeCleanup)
 	assert.Equal(testing, true, deorbiter.HasCalledWaitForServiceCleanup)
+	assert.Equal(testing, true, deorbiter.HasCalledDeleteOpenstackDebris)
 	assert.Equal(testing, false, deorbiter.HasCalledSeldDestruct)
+	assert.Equal(testing, false, deorbiter.HasCalledTakeInventory)
 	assert.NoError(testing, err)
 
 	deorbiter = &FakeDeorbiter{
@@ -39,14 +49,16 @@ func TestDeorbit(testing *testing.T) {
 	}
 
 	err = reconciler.doDeorbit(deorbiter)
-	err = reconciler.doSelfDestruct(deorbiter, err)
+	err = reconciler.doSelfDestruct(kluster, deorbiter, err)
 	assert.Equal(testing, true, deorbiter.HasCalledDeleteSnapshots)
 	assert.Equal(testing, true, deorbiter.HasCalledDeletePersistentVolumeClaims)
 	assert.Equal(testing, true, deorbiter.HasCalledDeleteServices)
 	assert.Equal(testing, true, deorbiter.HasCalledWaitForSnapshotCleanup)
 	assert.Equal(testing, true, deorbiter.HasCalledWaitForPersistentVolumeCleanup)
 	assert.Equal(testing, true, deorbiter.HasCalledWaitForServiceCleanup)
+	assert.Equal(testing, true, deorbiter.HasCalledDeleteOpenstackDebris)
 	assert.Equal(testing, false, deorbiter.HasCalledSeldDestruct)
+	assert.Equal(testing, false, deorbiter.HasCalledTakeInventory)
 	assert.NoError(testing, err)
 
 	deorbiter = &FakeDeorbiter{
@@ -58,14 +70,16 @@ func TestDeorbit(testing *testing.T) {
 
 	err = reconciler.doDeorbit(deorbiter)
 	assert.NoError(testing, err)
-	err = reconciler.doSelfDestruct(deorbiter, ServerTimeout)
+	err = reconciler.doSelfDestruct(kluster, deorbiter, ServerTimeout)
 	assert.Equal(testing, true, deorbiter.HasCalledDeleteSnapshots)
 	assert.Equal(testing, true, deorbiter.HasCalledDeletePersistentVolumeClaims)
 	assert.Equal(testing, true, deorbiter.HasCalledDeleteServices)
 	assert.Equal(testing, true, deorbiter.HasCalledWaitForSnapshotCleanup)
 	assert.Equal(testing, true, deorbiter.HasCalledWaitForPersistentVolumeCleanup)
 	assert.Equal(testing, true, deorbiter.HasCalledWaitForServiceCleanup)
+	assert.Equal(testing, true, deorbiter.HasCalledDeleteOpenstackDebris)
 	assert.Equal(testing, true, deorbiter.HasCalledSeldDestruct)
+	assert.Equal(testing, true, deorbiter.HasCalledTakeInventory)
 	assert.Equal(testing, APIUnavailable, deorbiter.SelfDestructReason)
 	assert.NoError(testing, err)
 
@@ -77,14 +91,21 @@ func TestDeorbit(testing *testing.T) {
 	}
 
 	err = reconciler.doDeorbit(deorbiter)
-	err = reconciler.doSelfDestruct(deorbiter, err)
+	err = reconciler.doSelfDestruct(kluster, deorbiter, err)
 	assert.Equal(testing, true, deorbiter.HasCalledDeleteSnapshots)
 	assert.Equal(testing, true, deorbiter.HasCalledDeletePersistentVolumeClaims)
 	assert.Equal(testing, true, deorbiter.HasCalledDeleteServices)
 	assert.Equal(testing, true, deorbiter.HasCalledWaitForSnapshotCleanup)
 	assert.Equal(testing, true, deorbiter.HasCalledWaitForPersistentVolumeCleanup)
 	assert.Equal(testing, true, deorbiter.HasCalledWaitForServiceCleanup)
+	assert.Equal(testing, true, deorbiter.HasCalledDeleteOpenstackDebris)
 	assert.Equal(testing, true, deorbiter.HasCalledSeldDestruct)
+	assert.Equal(testing, true, deorbiter.HasCalledTakeInventory)
 	assert.Equal(testing, DeorbitHanging, deorbiter.SelfDestructReason)
 	assert.NoError(testing, err)
+
+	report, err := util.TerminationReport(reconciler.Clients.Kubernetes, kluster.Namespace, kluster.Name)
+	assert.NoError(testing, err)
+	assert.Equal(testing, string(DeorbitHanging), report.Reason)
+	assert.Len(testing, report.Resources, 3)
 }
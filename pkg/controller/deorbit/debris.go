@@ -0,0 +1,104 @@
+package deorbit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+
+	"github.com/sapcc/kubernikus/pkg/client/openstack/inventory"
+)
+
+// Debris are OpenStack resources created on behalf of the cluster by the
+// cloud controller manager. They are left behind if the cloud controller
+// manager is gone before the LoadBalancer services are cleaned up.
+type Debris struct {
+	LoadBalancers []loadbalancers.LoadBalancer
+	FloatingIPs   []floatingips.FloatingIP
+}
+
+func (d Debris) Empty() bool {
+	return len(d.LoadBalancers) == 0 && len(d.FloatingIPs) == 0
+}
+
+func (d Debris) String() string {
+	lbs := make([]string, len(d.LoadBalancers))
+	for i, lb := range d.LoadBalancers {
+		lbs[i] = fmt.Sprintf("%s (%s)", lb.Name, lb.ID)
+	}
+	fips := make([]string, len(d.FloatingIPs))
+	for i, fip := range d.FloatingIPs {
+		fips[i] = fmt.Sprintf("%s (%s)", fip.FloatingIP, fip.ID)
+	}
+	return fmt.Sprintf("load balancers: [%s], floating IPs: [%s]", strings.Join(lbs, ", "), strings.Join(fips, ", "))
+}
+
+// DeleteOpenstackDebris removes load balancers and floating IPs that were
+// created by the cloud controller manager for this cluster. Load balancers are
+// deleted in cascade mode which also removes listeners, pools and monitors.
+func (d *ConcreteDeorbiter) DeleteOpenstackDebris() (deleted Debris, err error) {
+	if d.LoadBalancerClient != nil {
+		deleted.LoadBalancers, err = d.deleteLoadBalancers()
+		if err != nil {
+			return deleted, err
+		}
+	}
+	if d.NetworkClient != nil {
+		deleted.FloatingIPs, err = d.deleteFloatingIPs()
+	}
+	return deleted, err
+}
+
+func (d *ConcreteDeorbiter) deleteLoadBalancers() (deleted []loadbalancers.LoadBalancer, err error) {
+	deleted = []loadbalancers.LoadBalancer{}
+
+	allPages, err := loadbalancers.List(d.LoadBalancerClient, loadbalancers.ListOpts{ProjectID: d.Kluster.Account()}).AllPages()
+	if err != nil {
+		return deleted, fmt.Errorf("failed to list load balancers: %w", err)
+	}
+	allLoadBalancers, err := loadbalancers.ExtractLoadBalancers(allPages)
+	if err != nil {
+		return deleted, fmt.Errorf("failed to extract load balancers: %w", err)
+	}
+
+	for _, lb := range allLoadBalancers {
+		if !inventory.IsClusterLoadBalancer(d.Kluster, lb) {
+			continue
+		}
+		if err := loadbalancers.Delete(d.LoadBalancerClient, lb.ID, loadbalancers.DeleteOpts{Cascade: true}).ExtractErr(); err != nil {
+			return deleted, fmt.Errorf("failed to delete load balancer %s: %w", lb.ID, err)
+		}
+		deleted = append(deleted, lb)
+	}
+
+	return deleted, nil
+}
+
+func (d *ConcreteDeorbiter) deleteFloatingIPs() (deleted []floatingips.FloatingIP, err error) {
+	deleted = []floatingips.FloatingIP{}
+
+	allPages, err := floatingips.List(d.NetworkClient, floatingips.ListOpts{ProjectID: d.Kluster.Account()}).AllPages()
+	if err != nil {
+		return deleted, fmt.Errorf("failed to list floating IPs: %w", err)
+	}
+	allFloatingIPs, err := floatingips.ExtractFloatingIPs(allPages)
+	if err != nil {
+		return deleted, fmt.Errorf("failed to extract floating IPs: %w", err)
+	}
+
+	for _, fip := range allFloatingIPs {
+		// Only floating IPs allocated by the cloud controller manager are
+		// removed. Pre-allocated ones are merely disassociated by Neutron once
+		// the load balancer's vip port is gone.
+		if !inventory.IsClusterFloatingIP(d.Kluster, fip) {
+			continue
+		}
+		if err := floatingips.Delete(d.NetworkClient, fip.ID).ExtractErr(); err != nil {
+			return deleted, fmt.Errorf("failed to delete floating IP %s: %w", fip.ID, err)
+		}
+		deleted = append(deleted, fip)
+	}
+
+	return deleted, nil
+}
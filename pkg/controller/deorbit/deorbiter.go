@@ -17,7 +17,9 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
 
+	"github.com/sapcc/kubernikus/pkg/api/models"
 	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	"github.com/sapcc/kubernikus/pkg/client/openstack/admin"
 	"github.com/sapcc/kubernikus/pkg/controller/config"
 	"github.com/sapcc/kubernikus/pkg/controller/metrics"
 	"github.com/sapcc/kubernikus/pkg/util"
@@ -57,6 +59,8 @@ type Deorbiter interface {
 	WaitForSnapshotCleanUp() error
 	WaitForPersistentVolumeCleanup() error
 	WaitForServiceCleanup() error
+	DeleteOpenstackDebris() (Debris, error)
+	TakeInventory(SelfDestructReason) (*models.TerminationReport, error)
 	SelfDestruct(SelfDestructReason) error
 	IsAPIUnavailableTimeout() bool
 	IsDeorbitHangingTimeout() bool
@@ -69,9 +73,14 @@ type ConcreteDeorbiter struct {
 	DynamicClient dynamic.Interface
 	Logger        log.Logger
 	ServiceClient *gophercloud.ServiceClient
+
+	NetworkClient      *gophercloud.ServiceClient
+	LoadBalancerClient *gophercloud.ServiceClient
+	ComputeClient      *gophercloud.ServiceClient
+	AdminClient        admin.AdminClient
 }
 
-func NewDeorbiter(kluster *v1.Kluster, stopCh <-chan struct{}, clients config.Clients, recorder record.EventRecorder, logger log.Logger, serviceClient *gophercloud.ServiceClient) (Deorbiter, error) {
+func NewDeorbiter(kluster *v1.Kluster, stopCh <-chan struct{}, clients config.Clients, recorder record.EventRecorder, logger log.Logger, serviceClient, networkClient, loadBalancerClient, computeClient *gophercloud.ServiceClient, adminClient admin.AdminClient) (Deorbiter, error) {
 	client, err := clients.Satellites.ClientFor(kluster)
 	if err != nil {
 		return nil, err
@@ -83,7 +92,7 @@ func NewDeorbiter(kluster *v1.Kluster, stopCh <-chan struct{}, clients config.Cl
 	}
 
 	var deorbiter Deorbiter
-	deorbiter = &ConcreteDeorbiter{kluster, stopCh, client, dynamicClient, logger, serviceClient}
+	deorbiter = &ConcreteDeorbiter{kluster, stopCh, client, dynamicClient, logger, serviceClient, networkClient, loadBalancerClient, computeClient, adminClient}
 	deorbiter = &LoggingDeorbiter{deorbiter, logger}
 	deorbiter = &EventingDeorbiter{deorbiter, kluster, recorder}
 	deorbiter = &InstrumentingDeorbiter{
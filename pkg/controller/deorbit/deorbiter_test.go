@@ -7,9 +7,12 @@ import (
 	"testing"
 
 	"github.com/go-kit/log"
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
 	th "github.com/gophercloud/gophercloud/testhelper"
 	tc "github.com/gophercloud/gophercloud/testhelper/client"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	core_v1 "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -18,7 +21,9 @@ import (
 	dynamicFake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes/fake"
 
+	"github.com/sapcc/kubernikus/pkg/api/models"
 	kubernikus_v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	openstack_fake "github.com/sapcc/kubernikus/pkg/client/openstack/fake"
 )
 
 var (
@@ -189,7 +194,7 @@ func TestIsServiceCleanupFinished(testing *testing.T) {
 		dynamicClient := dynamicFake.NewSimpleDynamicClient(runtime.NewScheme())
 		logger := log.NewNopLogger()
 
-		deorbiter := &ConcreteDeorbiter{kluster, done, client, dynamicClient, logger, nil}
+		deorbiter := &ConcreteDeorbiter{kluster, done, client, dynamicClient, logger, nil, nil, nil, nil, nil}
 		finished, err := deorbiter.isServiceCleanupFinished()
 
 		assert.Equal(testing, t.expected, finished, "Test %d failed: %v", i, t.message)
@@ -221,7 +226,7 @@ func TestIsPersistentVolumesCleanupFinished(testing *testing.T) {
 		MockVolumeListResponse(testing)
 		serviceClient := tc.ServiceClient()
 
-		deorbiter := &ConcreteDeorbiter{kluster, done, client, dynamicClient, logger, serviceClient}
+		deorbiter := &ConcreteDeorbiter{kluster, done, client, dynamicClient, logger, serviceClient, nil, nil, nil, nil}
 		finished, err := deorbiter.isPersistentVolumesCleanupFinished()
 
 		assert.Equal(testing, t.expected, finished, "Test %d failed: %v", i, t.message)
@@ -336,7 +341,7 @@ func TestIsSnapshotCleanupFinished(testing *testing.T) {
 		dynamicClient := dynamicFake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{SnapshotGvr: "volumesnapshotsList"}, t.objects...)
 		logger := log.NewNopLogger()
 
-		deorbiter := &ConcreteDeorbiter{kluster, done, client, dynamicClient, logger, nil}
+		deorbiter := &ConcreteDeorbiter{kluster, done, client, dynamicClient, logger, nil, nil, nil, nil, nil}
 		finished, err := deorbiter.isSnapshotCleanupFinished()
 
 		assert.Equal(testing, t.expected, finished, "Test %d failed: %v", i, t.message)
@@ -362,7 +367,7 @@ func TestDeletePersistentVolumeClaims(testing *testing.T) {
 		dynamicClient := dynamicFake.NewSimpleDynamicClient(runtime.NewScheme())
 		logger := log.NewNopLogger()
 
-		deorbiter := &ConcreteDeorbiter{kluster, done, client, dynamicClient, logger, nil}
+		deorbiter := &ConcreteDeorbiter{kluster, done, client, dynamicClient, logger, nil, nil, nil, nil, nil}
 		deleted, err := deorbiter.DeletePersistentVolumeClaims()
 		remaining, _ := client.CoreV1().PersistentVolumeClaims(meta_v1.NamespaceAll).List(context.Background(), meta_v1.ListOptions{})
 
@@ -388,7 +393,7 @@ func TestDeleteSnapshots(testing *testing.T) {
 		dynamicClient := dynamicFake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{SnapshotGvr: "volumesnapshotsList"}, t.objects...)
 		logger := log.NewNopLogger()
 
-		deorbiter := &ConcreteDeorbiter{kluster, done, client, dynamicClient, logger, nil}
+		deorbiter := &ConcreteDeorbiter{kluster, done, client, dynamicClient, logger, nil, nil, nil, nil, nil}
 		deleted, err := deorbiter.DeleteSnapshots()
 		remaining, _ := dynamicClient.Resource(SnapshotGvr).Namespace(meta_v1.NamespaceAll).List(context.TODO(), meta_v1.ListOptions{})
 
@@ -416,7 +421,7 @@ func TestDeleteServices(testing *testing.T) {
 		dynamicClient := dynamicFake.NewSimpleDynamicClient(runtime.NewScheme())
 		logger := log.NewNopLogger()
 
-		deorbiter := &ConcreteDeorbiter{kluster, done, client, dynamicClient, logger, nil}
+		deorbiter := &ConcreteDeorbiter{kluster, done, client, dynamicClient, logger, nil, nil, nil, nil, nil}
 		deleted, err := deorbiter.DeleteServices()
 		remaining, _ := client.CoreV1().Services(meta_v1.NamespaceAll).List(context.Background(), meta_v1.ListOptions{})
 
@@ -425,3 +430,57 @@ func TestDeleteServices(testing *testing.T) {
 		assert.NoError(testing, err, "test %d failed", i)
 	}
 }
+
+func TestDeleteOpenstackDebris(testing *testing.T) {
+	cloud := openstack_fake.NewCloud()
+	defer cloud.Close()
+	cloud.AddProject("project", "project", openstack_fake.DefaultDomain)
+	cloud.AddProject("other", "other", openstack_fake.DefaultDomain)
+
+	named := cloud.AddLoadBalancer(openstack_fake.LoadBalancer{ProjectID: "project", Name: "kube_service_test_default_nginx"})
+	tagged := cloud.AddLoadBalancer(openstack_fake.LoadBalancer{ProjectID: "project", Name: "renamed", Tags: []string{"kube_service_test_default_web"}})
+	cloud.AddLoadBalancer(openstack_fake.LoadBalancer{ProjectID: "project", Name: "kube_service_testing_default_nginx"})
+	cloud.AddLoadBalancer(openstack_fake.LoadBalancer{ProjectID: "project", Name: "manual"})
+	cloud.AddLoadBalancer(openstack_fake.LoadBalancer{ProjectID: "other", Name: "kube_service_test_default_nginx"})
+
+	allocated := cloud.AddFloatingIP(openstack_fake.FloatingIP{ProjectID: "project", PortID: named.VipPortID, Description: "Floating IP for Kubernetes external service default/nginx from cluster test"})
+	preallocated := cloud.AddFloatingIP(openstack_fake.FloatingIP{ProjectID: "project", PortID: tagged.VipPortID, Description: "reserved"})
+	cloud.AddFloatingIP(openstack_fake.FloatingIP{ProjectID: "project", Description: "Floating IP for Kubernetes external service default/nginx from cluster testing"})
+
+	provider := cloud.ProviderClient("project")
+	networkClient, err := openstack.NewNetworkV2(provider, gophercloud.EndpointOpts{})
+	require.NoError(testing, err)
+	loadBalancerClient, err := openstack.NewLoadBalancerV2(provider, gophercloud.EndpointOpts{})
+	require.NoError(testing, err)
+
+	kluster := &kubernikus_v1.Kluster{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "test-project", Namespace: "test", Labels: map[string]string{"account": "project"}},
+		Spec:       models.KlusterSpec{Name: "test"},
+	}
+	deorbiter := &ConcreteDeorbiter{kluster, make(chan struct{}), nil, nil, log.NewNopLogger(), nil, networkClient, loadBalancerClient, nil, nil}
+
+	deleted, err := deorbiter.DeleteOpenstackDebris()
+	require.NoError(testing, err)
+	assert.ElementsMatch(testing, []string{named.ID, tagged.ID}, []string{deleted.LoadBalancers[0].ID, deleted.LoadBalancers[1].ID})
+	require.Len(testing, deleted.FloatingIPs, 1)
+	assert.Equal(testing, allocated.ID, deleted.FloatingIPs[0].ID)
+
+	remaining := []string{}
+	for _, lb := range cloud.LoadBalancers("project") {
+		remaining = append(remaining, lb.Name)
+	}
+	assert.Equal(testing, []string{"kube_service_testing_default_nginx", "manual"}, remaining)
+	assert.Len(testing, cloud.LoadBalancers("other"), 1)
+
+	fips := cloud.FloatingIPs("project")
+	require.Len(testing, fips, 2)
+	for _, fip := range fips {
+		if fip.ID == preallocated.ID {
+			assert.Empty(testing, fip.PortID, "pre-allocated floating IP should be disassociated")
+		}
+	}
+
+	deleted, err = deorbiter.DeleteOpenstackDebris()
+	require.NoError(testing, err)
+	assert.True(testing, deleted.Empty())
+}
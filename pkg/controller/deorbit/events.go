@@ -2,11 +2,13 @@ package deorbit
 
 import (
 	"fmt"
+	"strings"
 
 	core_v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/tools/record"
 
+	"github.com/sapcc/kubernikus/pkg/api/models"
 	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
 	"github.com/sapcc/kubernikus/pkg/controller/events"
 )
@@ -110,6 +112,44 @@ func (d *EventingDeorbiter) WaitForServiceCleanup() (err error) {
 	return
 }
 
+func (d *EventingDeorbiter) DeleteOpenstackDebris() (deleted Debris, err error) {
+	deleted, err = d.Deorbiter.DeleteOpenstackDebris()
+
+	if !deleted.Empty() {
+		d.Recorder.Eventf(d.Kluster, core_v1.EventTypeNormal, events.TerminationReport, "Removed OpenStack resources left behind by the cloud controller manager: %v", deleted)
+	}
+	if err != nil {
+		d.Recorder.Eventf(d.Kluster, core_v1.EventTypeWarning, events.FailedDeorbitDebris, "Failed to remove load balancers and floating IPs: %v", err)
+	}
+
+	return
+}
+
+func (d *EventingDeorbiter) TakeInventory(reason SelfDestructReason) (report *models.TerminationReport, err error) {
+	report, err = d.Deorbiter.TakeInventory(reason)
+
+	if report != nil && len(report.Resources) > 0 {
+		counts := map[string]int{}
+		kinds := []string{}
+		for _, resource := range report.Resources {
+			if counts[resource.Kind] == 0 {
+				kinds = append(kinds, resource.Kind)
+			}
+			counts[resource.Kind]++
+		}
+		summary := make([]string, len(kinds))
+		for i, kind := range kinds {
+			summary[i] = fmt.Sprintf("%d %s", counts[kind], kind)
+		}
+		d.Recorder.Eventf(d.Kluster, core_v1.EventTypeWarning, events.TerminationReport, "Leaving behind OpenStack resources: %v", strings.Join(summary, ", "))
+	}
+	if err != nil {
+		d.Recorder.Eventf(d.Kluster, core_v1.EventTypeWarning, events.TerminationReport, "Inventory of left-over OpenStack resources is incomplete: %v", err)
+	}
+
+	return
+}
+
 func (d *EventingDeorbiter) SelfDestruct(reason SelfDestructReason) (err error) {
 	err = d.Deorbiter.SelfDestruct(reason)
 
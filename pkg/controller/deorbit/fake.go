@@ -6,6 +6,8 @@ import (
 	core_v1 "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
 )
 
 type FakeDeorbiter struct {
@@ -22,6 +24,8 @@ type FakeDeorbiter struct {
 	HasCalledWaitForSnapshotCleanup         bool
 	HasCalledWaitForPersistentVolumeCleanup bool
 	HasCalledWaitForServiceCleanup          bool
+	HasCalledDeleteOpenstackDebris          bool
+	HasCalledTakeInventory                  bool
 	HasCalledSeldDestruct                   bool
 
 	SelfDestructReason SelfDestructReason
@@ -106,6 +110,22 @@ func (d *FakeDeorbiter) WaitForServiceCleanup() (err error) {
 	return nil
 }
 
+func (d *FakeDeorbiter) DeleteOpenstackDebris() (deleted Debris, err error) {
+	d.HasCalledDeleteOpenstackDebris = true
+
+	return deleted, nil
+}
+
+func (d *FakeDeorbiter) TakeInventory(reason SelfDestructReason) (*models.TerminationReport, error) {
+	d.HasCalledTakeInventory = true
+
+	report := &models.TerminationReport{Name: "test", Account: "account", Reason: string(reason)}
+	for i := 0; i < d.CinderPVCCount; i++ {
+		report.Resources = append(report.Resources, models.DebrisResource{Kind: models.DebrisResourceKindVolume, ID: fmt.Sprintf("pv-cinder%d", i)})
+	}
+	return report, nil
+}
+
 func (d *FakeDeorbiter) SelfDestruct(reason SelfDestructReason) (err error) {
 	d.HasCalledSeldDestruct = true
 	d.SelfDestructReason = reason
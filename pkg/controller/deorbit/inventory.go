@@ -0,0 +1,29 @@
+package deorbit
+
+import (
+	"time"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	"github.com/sapcc/kubernikus/pkg/client/openstack/inventory"
+)
+
+// TakeInventory lists the OpenStack resources in the kluster's project that
+// are attributable to the kluster and still exist. It is best effort: Services
+// that can't be queried are skipped and reported in the returned error.
+func (d *ConcreteDeorbiter) TakeInventory(reason SelfDestructReason) (*models.TerminationReport, error) {
+	resources, err := inventory.Take(d.Kluster, inventory.Clients{
+		BlockStorage: d.ServiceClient,
+		Network:      d.NetworkClient,
+		LoadBalancer: d.LoadBalancerClient,
+		Compute:      d.ComputeClient,
+		Admin:        d.AdminClient,
+	})
+
+	return &models.TerminationReport{
+		Name:      d.Kluster.Spec.Name,
+		Account:   d.Kluster.Account(),
+		Reason:    string(reason),
+		CreatedAt: time.Now().UTC().Format(time.RFC3339),
+		Resources: resources,
+	}, err
+}
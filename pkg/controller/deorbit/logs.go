@@ -8,6 +8,8 @@ import (
 	"github.com/go-kit/log"
 	core_v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
 )
 
 type LoggingDeorbiter struct {
@@ -99,6 +101,35 @@ func (d *LoggingDeorbiter) WaitForServiceCleanup() (err error) {
 	return d.Deorbiter.WaitForServiceCleanup()
 }
 
+func (d *LoggingDeorbiter) DeleteOpenstackDebris() (deleted Debris, err error) {
+	defer func(begin time.Time) {
+		d.Logger.Log(
+			"msg", "deleted openstack debris",
+			"debris", deleted,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return d.Deorbiter.DeleteOpenstackDebris()
+}
+
+func (d *LoggingDeorbiter) TakeInventory(reason SelfDestructReason) (report *models.TerminationReport, err error) {
+	defer func(begin time.Time) {
+		resources := 0
+		if report != nil {
+			resources = len(report.Resources)
+		}
+		d.Logger.Log(
+			"msg", "took inventory of left-over resources",
+			"reason", reason,
+			"resources", resources,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return d.Deorbiter.TakeInventory(reason)
+}
+
 func (d *LoggingDeorbiter) SelfDestruct(reason SelfDestructReason) (err error) {
 	defer func(begin time.Time) {
 		d.Logger.Log(
@@ -6,6 +6,8 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	core_v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
 )
 
 type InstrumentingDeorbiter struct {
@@ -47,6 +49,16 @@ func (d *InstrumentingDeorbiter) WaitForServiceCleanup() (err error) {
 	return d.Deorbiter.WaitForServiceCleanup()
 }
 
+func (d *InstrumentingDeorbiter) DeleteOpenstackDebris() (deleted Debris, err error) {
+	defer d.instrument("DeleteOpenstackDebris", time.Now(), err)
+	return d.Deorbiter.DeleteOpenstackDebris()
+}
+
+func (d *InstrumentingDeorbiter) TakeInventory(reason SelfDestructReason) (report *models.TerminationReport, err error) {
+	defer d.instrument("TakeInventory", time.Now(), err)
+	return d.Deorbiter.TakeInventory(reason)
+}
+
 func (d *InstrumentingDeorbiter) SelfDestruct(reason SelfDestructReason) (err error) {
 	defer d.instrument("SelfDestruct", time.Now(), err)
 	return d.Deorbiter.SelfDestruct(reason)
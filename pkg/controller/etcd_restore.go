@@ -0,0 +1,293 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-kit/log"
+	batch_v1 "k8s.io/api/batch/v1"
+	api_v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	"github.com/sapcc/kubernikus/pkg/client/openstack"
+	"github.com/sapcc/kubernikus/pkg/controller/events"
+	"github.com/sapcc/kubernikus/pkg/util"
+	etcd_util "github.com/sapcc/kubernikus/pkg/util/etcd"
+)
+
+const (
+	// EtcdRestoreReplicasAnnotation keeps the replicas of a deployment scaled
+	// down for restoring etcd
+	EtcdRestoreReplicasAnnotation = "kubernikus.cloud.sap/etcd-restore-replicas"
+
+	etcdRestoreRecheckInterval = 10 * time.Second
+	etcdRestoreTimeout         = 30 * time.Minute
+)
+
+// snapshotStoreFunc is replaced in tests
+var snapshotStoreFunc = klusterSnapshotStore
+
+// klusterSnapshotStore accesses the etcd snapshots of the kluster as its service user
+func klusterSnapshotStore(factory openstack.SharedOpenstackClientFactory, kluster *v1.Kluster, logger log.Logger) (etcd_util.SnapshotStore, error) {
+	provider, err := factory.ProviderClientForKluster(kluster, logger)
+	if err != nil {
+		return nil, err
+	}
+	return etcd_util.NewKlusterSnapshotStore(provider, kluster)
+}
+
+// reconcileEtcdRestore drives a restore requested via the API, the kluster is
+// in phase Restoring meanwhile. It tells if the kluster can be reconciled as
+// usual again:
+//
+//	ScalingDown: the apiserver and etcd are scaled to 0
+//	Restoring: newer snapshots are archived and the etcd data is wiped
+//	ScalingUp: etcdbr restores the snapshot, which is now the latest, on start
+func (op *GroundControl) reconcileEtcdRestore(kluster *v1.Kluster) (bool, error) {
+	restore := kluster.Status.EtcdRestore
+	if restore == nil || restore.Phase == models.EtcdRestorePhaseCompleted {
+		return true, nil
+	}
+
+	var done bool
+	var err error
+	switch restore.Phase {
+	case models.EtcdRestorePhaseScalingDown:
+		done, err = op.scaleControlPlane(kluster, false)
+	case models.EtcdRestorePhaseRestoring:
+		done, err = op.restoreEtcdSnapshot(kluster, restore)
+	case models.EtcdRestorePhaseScalingUp:
+		done, err = op.scaleControlPlane(kluster, true)
+	case models.EtcdRestorePhaseFailed:
+		// bring back what was scaled down, etcdbr restores the latest snapshot if needed
+		return op.scaleControlPlane(kluster, true)
+	default:
+		return false, fmt.Errorf("unknown etcd restore phase %s", restore.Phase)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if !done {
+		if started, err := time.Parse(time.RFC3339, restore.StartedAt); err == nil && time.Since(started) > etcdRestoreTimeout {
+			return false, op.finishEtcdRestore(kluster, models.EtcdRestorePhaseFailed, fmt.Sprintf("Timed out in phase %s", restore.Phase))
+		}
+		op.queue.AddAfter(kluster.Namespace+"/"+kluster.Name, etcdRestoreRecheckInterval)
+		return false, nil
+	}
+
+	switch restore.Phase {
+	case models.EtcdRestorePhaseScalingDown:
+		return false, op.advanceEtcdRestore(kluster, models.EtcdRestorePhaseRestoring)
+	case models.EtcdRestorePhaseRestoring:
+		return false, op.advanceEtcdRestore(kluster, models.EtcdRestorePhaseScalingUp)
+	default:
+		return false, op.finishEtcdRestore(kluster, models.EtcdRestorePhaseCompleted, "")
+	}
+}
+
+// scaleControlPlane scales the apiserver and etcd down in this order, or up
+// again to their former replicas in reverse order, and tells if it is done
+func (op *GroundControl) scaleControlPlane(kluster *v1.Kluster, up bool) (bool, error) {
+	names := []string{kluster.Name + "-apiserver", kluster.Name + "-etcd"}
+	if up {
+		names = []string{kluster.Name + "-etcd", kluster.Name + "-apiserver"}
+	}
+	for _, name := range names {
+		done, err := op.scaleDeployment(kluster, name, up)
+		if err != nil || !done {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (op *GroundControl) scaleDeployment(kluster *v1.Kluster, name string, up bool) (bool, error) {
+	deployments := op.Clients.Kubernetes.AppsV1().Deployments(kluster.Namespace)
+	deployment, err := deployments.Get(context.TODO(), name, meta_v1.GetOptions{})
+	if err != nil {
+		return false, fmt.Errorf("couldn't get deployment %s: %s", name, err)
+	}
+	value, scaledDown := deployment.Annotations[EtcdRestoreReplicasAnnotation]
+
+	switch {
+	case !up && !scaledDown:
+		replicas := int32(1)
+		if deployment.Spec.Replicas != nil {
+			replicas = *deployment.Spec.Replicas
+		}
+		if deployment.Annotations == nil {
+			deployment.Annotations = map[string]string{}
+		}
+		deployment.Annotations[EtcdRestoreReplicasAnnotation] = strconv.Itoa(int(replicas))
+		deployment.Spec.Replicas = new(int32)
+	case up && scaledDown:
+		replicas, err := strconv.Atoi(value)
+		if err != nil || replicas < 1 {
+			replicas = 1
+		}
+		delete(deployment.Annotations, EtcdRestoreReplicasAnnotation)
+		scaled := int32(replicas)
+		deployment.Spec.Replicas = &scaled
+	case up:
+		return deployment.Status.ObservedGeneration >= deployment.Generation && deployment.Spec.Replicas != nil && deployment.Status.AvailableReplicas >= *deployment.Spec.Replicas, nil
+	default:
+		return deployment.Status.ObservedGeneration >= deployment.Generation && deployment.Status.Replicas == 0, nil
+	}
+
+	if _, err := deployments.Update(context.TODO(), deployment, meta_v1.UpdateOptions{}); err != nil {
+		return false, fmt.Errorf("couldn't scale deployment %s: %s", name, err)
+	}
+	op.Logger.Log("msg", "scaled deployment for etcd restore", "kluster", kluster.Name, "deployment", name, "replicas", *deployment.Spec.Replicas)
+	return false, nil
+}
+
+// restoreEtcdSnapshot makes the snapshot the latest one and wipes the etcd
+// data, so that etcdbr restores it on start
+func (op *GroundControl) restoreEtcdSnapshot(kluster *v1.Kluster, restore *models.EtcdRestoreStatus) (bool, error) {
+	store, err := snapshotStoreFunc(op.Factories.Openstack, kluster, op.Logger)
+	if err != nil {
+		return false, err
+	}
+	snapshots, err := store.List()
+	if err != nil {
+		return false, fmt.Errorf("couldn't list snapshots: %s", err)
+	}
+	newer, err := etcd_util.SnapshotsAfter(snapshots, restore.Snapshot)
+	if err != nil {
+		return false, op.finishEtcdRestore(kluster, models.EtcdRestorePhaseFailed, err.Error())
+	}
+	archive := "restore-" + restore.StartedAt
+	if started, err := time.Parse(time.RFC3339, restore.StartedAt); err == nil {
+		archive = "restore-" + strconv.FormatInt(started.Unix(), 10)
+	}
+	for _, snapshot := range newer {
+		if err := store.Archive(snapshot.Name, archive); err != nil {
+			return false, fmt.Errorf("couldn't archive snapshot %s: %s", snapshot.Name, err)
+		}
+	}
+	if len(newer) > 0 {
+		op.Recorder.Eventf(kluster, api_v1.EventTypeNormal, events.EtcdRestoreProgressing, "Archived %d snapshots newer than %s", len(newer), restore.Snapshot)
+	}
+
+	return op.wipeEtcdData(kluster)
+}
+
+// wipeEtcdData deletes the etcd data dir on the persistent volume with a job
+func (op *GroundControl) wipeEtcdData(kluster *v1.Kluster) (bool, error) {
+	etcd, err := op.Clients.Kubernetes.AppsV1().Deployments(kluster.Namespace).Get(context.TODO(), kluster.Name+"-etcd", meta_v1.GetOptions{})
+	if err != nil {
+		return false, fmt.Errorf("couldn't get etcd deployment: %s", err)
+	}
+	var claim *api_v1.PersistentVolumeClaimVolumeSource
+	for _, volume := range etcd.Spec.Template.Spec.Volumes {
+		if volume.Name == "data" {
+			claim = volume.PersistentVolumeClaim
+		}
+	}
+	// without a persistent volume the data is gone already
+	if claim == nil {
+		return true, nil
+	}
+	var image string
+	for _, container := range etcd.Spec.Template.Spec.Containers {
+		if container.Name == "etcd" {
+			image = container.Image
+		}
+	}
+
+	jobs := op.Clients.Kubernetes.BatchV1().Jobs(kluster.Namespace)
+	name := kluster.Name + "-etcd-restore"
+	job, err := jobs.Get(context.TODO(), name, meta_v1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		backoffLimit := int32(3)
+		labels := map[string]string{"app": name, "release": kluster.Name}
+		job = &batch_v1.Job{
+			ObjectMeta: meta_v1.ObjectMeta{
+				Name:            name,
+				Labels:          labels,
+				OwnerReferences: []meta_v1.OwnerReference{*util.NewOwnerRef(kluster, v1.SchemeGroupVersion.WithKind("Kluster"))},
+			},
+			Spec: batch_v1.JobSpec{
+				BackoffLimit: &backoffLimit,
+				Template: api_v1.PodTemplateSpec{
+					ObjectMeta: meta_v1.ObjectMeta{Labels: labels},
+					Spec: api_v1.PodSpec{
+						RestartPolicy: api_v1.RestartPolicyOnFailure,
+						Containers: []api_v1.Container{{
+							Name:         "wipe",
+							Image:        image,
+							Command:      []string{"/bin/sh", "-c", "rm -rf /var/lib/etcd/new.etcd /var/lib/etcd/member"},
+							VolumeMounts: []api_v1.VolumeMount{{Name: "data", MountPath: "/var/lib/etcd"}},
+						}},
+						Volumes: []api_v1.Volume{{Name: "data", VolumeSource: api_v1.VolumeSource{PersistentVolumeClaim: claim}}},
+					},
+				},
+			},
+		}
+		if _, err := jobs.Create(context.TODO(), job, meta_v1.CreateOptions{}); err != nil {
+			return false, fmt.Errorf("couldn't create job %s: %s", name, err)
+		}
+		op.Logger.Log("msg", "wiping etcd data", "kluster", kluster.Name, "job", name)
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("couldn't get job %s: %s", name, err)
+	}
+
+	if job.Status.Succeeded == 0 {
+		if job.Status.Failed > *job.Spec.BackoffLimit {
+			return false, op.finishEtcdRestore(kluster, models.EtcdRestorePhaseFailed, "Wiping the etcd data failed")
+		}
+		return false, nil
+	}
+	propagation := meta_v1.DeletePropagationBackground
+	if err := jobs.Delete(context.TODO(), name, meta_v1.DeleteOptions{PropagationPolicy: &propagation}); err != nil && !apierrors.IsNotFound(err) {
+		return false, fmt.Errorf("couldn't delete job %s: %s", name, err)
+	}
+	return true, nil
+}
+
+func (op *GroundControl) advanceEtcdRestore(kluster *v1.Kluster, phase models.EtcdRestorePhase) error {
+	err := op.updateKluster(kluster, func(kluster *v1.Kluster) error {
+		if kluster.Status.EtcdRestore == nil {
+			return util.ErrKlusterNotUpdated
+		}
+		kluster.Status.EtcdRestore.Phase = phase
+		kluster.Status.EtcdRestore.PhaseStartedAt = time.Now().UTC().Format(time.RFC3339)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	op.Logger.Log("msg", "etcd restore advanced", "kluster", kluster.Name, "phase", phase)
+	op.Recorder.Eventf(kluster, api_v1.EventTypeNormal, events.EtcdRestoreProgressing, "Etcd restore entered phase %s", phase)
+	return nil
+}
+
+func (op *GroundControl) finishEtcdRestore(kluster *v1.Kluster, phase models.EtcdRestorePhase, message string) error {
+	err := op.updateKluster(kluster, func(kluster *v1.Kluster) error {
+		if kluster.Status.EtcdRestore == nil {
+			return util.ErrKlusterNotUpdated
+		}
+		kluster.Status.EtcdRestore.Phase = phase
+		kluster.Status.EtcdRestore.Message = message
+		kluster.Status.EtcdRestore.CompletedAt = time.Now().UTC().Format(time.RFC3339)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	op.Logger.Log("msg", "etcd restore finished", "kluster", kluster.Name, "phase", phase, "message", message)
+	if phase == models.EtcdRestorePhaseFailed {
+		op.Recorder.Eventf(kluster, api_v1.EventTypeWarning, events.EtcdRestoreFailed, "Etcd restore failed: %s", message)
+	} else {
+		op.Recorder.Eventf(kluster, api_v1.EventTypeNormal, events.EtcdRestoreCompleted, "Restored etcd snapshot %s", kluster.Status.EtcdRestore.Snapshot)
+	}
+	return nil
+}
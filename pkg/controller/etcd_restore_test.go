@@ -0,0 +1,260 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apps_v1 "k8s.io/api/apps/v1"
+	api_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubernetes_fake "k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	"github.com/sapcc/kubernikus/pkg/client/openstack"
+	"github.com/sapcc/kubernikus/pkg/controller/config"
+	kubernikus_fake "github.com/sapcc/kubernikus/pkg/generated/clientset/fake"
+	kubernikus_informers "github.com/sapcc/kubernikus/pkg/generated/informers/externalversions"
+	etcd_util "github.com/sapcc/kubernikus/pkg/util/etcd"
+)
+
+var (
+	fullSnapshot  = models.EtcdSnapshot{Name: "v1/Full-00000000-00000100-1700000000.gz", Kind: models.EtcdSnapshotKindFull, LastRevision: 100}
+	deltaSnapshot = models.EtcdSnapshot{Name: "v1/Incr-00000101-00000200-1700000600.gz", Kind: models.EtcdSnapshotKindDelta, StartRevision: 101, LastRevision: 200}
+)
+
+type restoreTest struct {
+	t          *testing.T
+	op         *GroundControl
+	kubernetes *kubernetes_fake.Clientset
+	kubernikus *kubernikus_fake.Clientset
+	store      *etcd_util.FakeSnapshotStore
+}
+
+func newRestoreTest(t *testing.T, restore models.EtcdRestoreStatus, scaledDown bool) *restoreTest {
+	kluster := newGroundKluster()
+	kluster.Status.Phase = models.KlusterPhaseRestoring
+	kluster.Status.EtcdRestore = &restore
+
+	deployment := func(name string, replicas int32, volumes ...api_v1.Volume) *apps_v1.Deployment {
+		d := &apps_v1.Deployment{
+			ObjectMeta: meta_v1.ObjectMeta{Namespace: "default", Name: name},
+			Spec: apps_v1.DeploymentSpec{
+				Replicas: &replicas,
+				Template: api_v1.PodTemplateSpec{Spec: api_v1.PodSpec{
+					Containers: []api_v1.Container{{Name: "etcd", Image: "etcd:3.5"}},
+					Volumes:    volumes,
+				}},
+			},
+			Status: apps_v1.DeploymentStatus{Replicas: replicas, AvailableReplicas: replicas},
+		}
+		if scaledDown {
+			d.Annotations = map[string]string{EtcdRestoreReplicasAnnotation: strconv.Itoa(int(replicas))}
+			d.Spec.Replicas = new(int32)
+			d.Status = apps_v1.DeploymentStatus{}
+		}
+		return d
+	}
+	data := api_v1.Volume{Name: "data", VolumeSource: api_v1.VolumeSource{PersistentVolumeClaim: &api_v1.PersistentVolumeClaimVolumeSource{ClaimName: "test-etcd"}}}
+	kubernetes := kubernetes_fake.NewSimpleClientset(deployment("test-apiserver", 2), deployment("test-etcd", 1, data))
+
+	kubernikus := kubernikus_fake.NewSimpleClientset(kluster)
+	klusterInformer := kubernikus_informers.NewSharedInformerFactory(kubernikus, 0).Kubernikus().V1().Klusters()
+	require.NoError(t, klusterInformer.Informer().GetIndexer().Add(kluster))
+	// keep the cache in sync with updates and patches
+	kubernikus.PrependReactor("*", "klusters", func(action core.Action) (bool, runtime.Object, error) {
+		handled, obj, err := core.ObjectReaction(kubernikus.Tracker())(action)
+		if err == nil && (action.GetVerb() == "update" || action.GetVerb() == "patch") {
+			require.NoError(t, klusterInformer.Informer().GetIndexer().Update(obj))
+		}
+		return handled, obj, err
+	})
+
+	rt := &restoreTest{
+		t:          t,
+		kubernetes: kubernetes,
+		kubernikus: kubernikus,
+		store:      &etcd_util.FakeSnapshotStore{Snapshots: []models.EtcdSnapshot{fullSnapshot, deltaSnapshot}},
+		op: &GroundControl{
+			Clients:         config.Clients{Kubernikus: kubernikus, Kubernetes: kubernetes},
+			Recorder:        record.NewFakeRecorder(100),
+			queue:           workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()), // nolint: staticcheck
+			klusterInformer: klusterInformer,
+			Logger:          log.NewNopLogger(),
+		},
+	}
+	storeFunc := snapshotStoreFunc
+	t.Cleanup(func() {
+		snapshotStoreFunc = storeFunc
+		rt.op.queue.ShutDown()
+	})
+	snapshotStoreFunc = func(_ openstack.SharedOpenstackClientFactory, _ *v1.Kluster, _ log.Logger) (etcd_util.SnapshotStore, error) {
+		return rt.store, nil
+	}
+	return rt
+}
+
+// step handles the kluster once and returns it afterwards
+func (rt *restoreTest) step() *v1.Kluster {
+	require.NoError(rt.t, rt.op.handler("default/test"))
+	kluster, err := rt.kubernikus.KubernikusV1().Klusters("default").Get(context.Background(), "test", meta_v1.GetOptions{})
+	require.NoError(rt.t, err)
+	return kluster
+}
+
+func (rt *restoreTest) deployment(name string) *apps_v1.Deployment {
+	deployment, err := rt.kubernetes.AppsV1().Deployments("default").Get(context.Background(), name, meta_v1.GetOptions{})
+	require.NoError(rt.t, err)
+	return deployment
+}
+
+// rollOut makes the deployment report its current replicas
+func (rt *restoreTest) rollOut(name string) {
+	deployment := rt.deployment(name)
+	deployment.Status.Replicas = *deployment.Spec.Replicas
+	deployment.Status.AvailableReplicas = *deployment.Spec.Replicas
+	_, err := rt.kubernetes.AppsV1().Deployments("default").UpdateStatus(context.Background(), deployment, meta_v1.UpdateOptions{})
+	require.NoError(rt.t, err)
+}
+
+func (rt *restoreTest) setJobStatus(succeeded, failed int32) {
+	job, err := rt.kubernetes.BatchV1().Jobs("default").Get(context.Background(), "test-etcd-restore", meta_v1.GetOptions{})
+	require.NoError(rt.t, err)
+	job.Status.Succeeded = succeeded
+	job.Status.Failed = failed
+	_, err = rt.kubernetes.BatchV1().Jobs("default").UpdateStatus(context.Background(), job, meta_v1.UpdateOptions{})
+	require.NoError(rt.t, err)
+}
+
+func newRestore(phase models.EtcdRestorePhase, snapshot string, started time.Time) models.EtcdRestoreStatus {
+	return models.EtcdRestoreStatus{
+		Phase:          phase,
+		Snapshot:       snapshot,
+		StartedAt:      started.UTC().Format(time.RFC3339),
+		PhaseStartedAt: started.UTC().Format(time.RFC3339),
+	}
+}
+
+func TestEtcdRestore(t *testing.T) {
+	started := time.Now().Add(-time.Minute)
+	rt := newRestoreTest(t, newRestore(models.EtcdRestorePhaseScalingDown, fullSnapshot.Name, started), false)
+
+	// the apiserver is scaled down before etcd
+	kluster := rt.step()
+	assert.Equal(t, models.EtcdRestorePhaseScalingDown, kluster.Status.EtcdRestore.Phase)
+	assert.Equal(t, int32(0), *rt.deployment("test-apiserver").Spec.Replicas)
+	assert.Equal(t, "2", rt.deployment("test-apiserver").Annotations[EtcdRestoreReplicasAnnotation])
+	assert.Equal(t, int32(1), *rt.deployment("test-etcd").Spec.Replicas)
+
+	rt.step()
+	assert.Equal(t, int32(1), *rt.deployment("test-etcd").Spec.Replicas, "waits for the apiserver to be gone")
+
+	rt.rollOut("test-apiserver")
+	rt.step()
+	assert.Equal(t, int32(0), *rt.deployment("test-etcd").Spec.Replicas)
+
+	rt.rollOut("test-etcd")
+	kluster = rt.step()
+	assert.Equal(t, models.EtcdRestorePhaseRestoring, kluster.Status.EtcdRestore.Phase)
+	assert.Equal(t, models.KlusterPhaseRestoring, kluster.Status.Phase)
+
+	// newer snapshots are archived and the data is wiped
+	rt.step()
+	assert.Equal(t, map[string]string{deltaSnapshot.Name: "restore-" + strconv.FormatInt(started.Unix(), 10)}, rt.store.Archived)
+	assert.Equal(t, []models.EtcdSnapshot{fullSnapshot}, rt.store.Snapshots)
+	job, err := rt.kubernetes.BatchV1().Jobs("default").Get(context.Background(), "test-etcd-restore", meta_v1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "test-etcd", job.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+	assert.Equal(t, "etcd:3.5", job.Spec.Template.Spec.Containers[0].Image)
+
+	kluster = rt.step()
+	assert.Equal(t, models.EtcdRestorePhaseRestoring, kluster.Status.EtcdRestore.Phase, "waits for the job")
+
+	rt.setJobStatus(1, 0)
+	kluster = rt.step()
+	assert.Equal(t, models.EtcdRestorePhaseScalingUp, kluster.Status.EtcdRestore.Phase)
+	_, err = rt.kubernetes.BatchV1().Jobs("default").Get(context.Background(), "test-etcd-restore", meta_v1.GetOptions{})
+	assert.Error(t, err, "the job is deleted")
+
+	// etcd is scaled up before the apiserver
+	rt.step()
+	assert.Equal(t, int32(1), *rt.deployment("test-etcd").Spec.Replicas)
+	assert.NotContains(t, rt.deployment("test-etcd").Annotations, EtcdRestoreReplicasAnnotation)
+	assert.Equal(t, int32(0), *rt.deployment("test-apiserver").Spec.Replicas)
+
+	rt.rollOut("test-etcd")
+	rt.step()
+	assert.Equal(t, int32(2), *rt.deployment("test-apiserver").Spec.Replicas)
+
+	rt.rollOut("test-apiserver")
+	kluster = rt.step()
+	assert.Equal(t, models.EtcdRestorePhaseCompleted, kluster.Status.EtcdRestore.Phase)
+	assert.NotEmpty(t, kluster.Status.EtcdRestore.CompletedAt)
+	assert.Equal(t, models.KlusterPhaseRestoring, kluster.Status.Phase)
+
+	kluster = rt.step()
+	assert.Equal(t, models.KlusterPhaseRunning, kluster.Status.Phase)
+}
+
+func TestEtcdRestoreFailure(t *testing.T) {
+	t.Run("unknown snapshot", func(t *testing.T) {
+		rt := newRestoreTest(t, newRestore(models.EtcdRestorePhaseRestoring, "v1/Full-00000000-00000050-1600000000.gz", time.Now()), true)
+
+		kluster := rt.step()
+		assert.Equal(t, models.EtcdRestorePhaseFailed, kluster.Status.EtcdRestore.Phase)
+		assert.Contains(t, kluster.Status.EtcdRestore.Message, "not found")
+		assert.Empty(t, rt.store.Archived)
+
+		// the control plane is brought back
+		rt.step()
+		assert.Equal(t, int32(1), *rt.deployment("test-etcd").Spec.Replicas)
+		rt.rollOut("test-etcd")
+		rt.step()
+		assert.Equal(t, int32(2), *rt.deployment("test-apiserver").Spec.Replicas)
+		rt.rollOut("test-apiserver")
+		kluster = rt.step()
+		assert.Equal(t, models.KlusterPhaseRunning, kluster.Status.Phase)
+		assert.Equal(t, models.EtcdRestorePhaseFailed, kluster.Status.EtcdRestore.Phase)
+	})
+
+	t.Run("wipe job failing", func(t *testing.T) {
+		rt := newRestoreTest(t, newRestore(models.EtcdRestorePhaseRestoring, fullSnapshot.Name, time.Now()), true)
+
+		rt.step()
+		rt.setJobStatus(0, 4)
+		kluster := rt.step()
+		assert.Equal(t, models.EtcdRestorePhaseFailed, kluster.Status.EtcdRestore.Phase)
+		assert.Equal(t, "Wiping the etcd data failed", kluster.Status.EtcdRestore.Message)
+	})
+
+	t.Run("snapshot store failing", func(t *testing.T) {
+		rt := newRestoreTest(t, newRestore(models.EtcdRestorePhaseRestoring, fullSnapshot.Name, time.Now()), true)
+		rt.store.Err = errors.New("swift is down")
+
+		assert.EqualError(t, rt.op.handler("default/test"), "couldn't list snapshots: swift is down")
+		kluster, err := rt.kubernikus.KubernikusV1().Klusters("default").Get(context.Background(), "test", meta_v1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, models.EtcdRestorePhaseRestoring, kluster.Status.EtcdRestore.Phase, "retried")
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		rt := newRestoreTest(t, newRestore(models.EtcdRestorePhaseScalingDown, fullSnapshot.Name, time.Now().Add(-etcdRestoreTimeout-time.Minute)), false)
+
+		// the apiserver doesn't go away in time
+		rt.step()
+		kluster := rt.step()
+		assert.Equal(t, models.EtcdRestorePhaseFailed, kluster.Status.EtcdRestore.Phase)
+		assert.Equal(t, "Timed out in phase ScalingDown", kluster.Status.EtcdRestore.Message)
+		assert.Equal(t, models.KlusterPhaseRestoring, kluster.Status.Phase)
+	})
+}
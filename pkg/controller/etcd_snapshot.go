@@ -0,0 +1,27 @@
+package controller
+
+import (
+	api_v1 "k8s.io/api/core/v1"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	"github.com/sapcc/kubernikus/pkg/controller/events"
+	etcd_util "github.com/sapcc/kubernikus/pkg/util/etcd"
+)
+
+// ensureEtcdSnapshot takes a full etcd snapshot before a risky operation and
+// records it in the status, so that a restore can target it
+func (op *GroundControl) ensureEtcdSnapshot(kluster *v1.Kluster, reason string) error {
+	snapshot, taken, err := etcd_util.EnsureFullSnapshot(op.Clients.Kubernetes, kluster, reason, func(snapshot models.EtcdSnapshotReference) error {
+		return op.updateKluster(kluster, func(kluster *v1.Kluster) error {
+			etcd_util.RecordSnapshot(kluster, snapshot)
+			return nil
+		})
+	})
+	if err != nil || !taken {
+		return err
+	}
+	op.Logger.Log("msg", "took etcd snapshot", "kluster", kluster.GetName(), "snapshot", snapshot.Name, "reason", reason)
+	op.Recorder.Eventf(kluster, api_v1.EventTypeNormal, events.EtcdSnapshotTaken, "Took etcd snapshot %s before %s", snapshot.Name, reason)
+	return nil
+}
@@ -1,8 +1,16 @@
 package events
 
 const (
+	CARotationCompleted            = "CARotationCompleted"
+	CARotationProgressing          = "CARotationProgressing"
+	CertificateRenewed             = "CertificateRenewed"
+	EtcdRestoreCompleted           = "EtcdRestoreCompleted"
+	EtcdRestoreFailed              = "EtcdRestoreFailed"
+	EtcdRestoreProgressing         = "EtcdRestoreProgressing"
+	EtcdSnapshotTaken              = "EtcdSnapshotTaken"
 	FailedCreateNode               = "FailedCreateNode"
 	FailedDeleteNode               = "FailedDeleteNode"
+	FailedDeorbitDebris            = "FailedDeorbitDebris"
 	FailedDeorbitLoadBalancers     = "FailedDeorbitLoadBalancers"
 	FailedDeorbitSnapshot          = "FailedDeorbitSnapshot"
 	FailedDeorbitSnapshots         = "FailedDeorbitSnapshots"
@@ -13,6 +21,10 @@ const (
 	FailedDrainNode                = "FailedDrainNode"
 	FailedRebootNode               = "FailedRebootNode"
 	FailedReplaceNode              = "FailedReplaceNode"
+	FailedUpdateNodeLabels         = "FailedUpdateNodeLabels"
+	HammertimeActivated            = "HammertimeActivated"
+	HammertimeDeactivated          = "HammertimeDeactivated"
+	HammertimeWarning              = "HammertimeWarning"
 	SuccessfulCreateNode           = "SuccessfulCreateNode"
 	SuccessfulDeleteNode           = "SuccessfulDeleteNode"
 	SuccessfulDeorbitLoadBalancers = "SuccessfulDeorbitLoadBalancers"
@@ -25,6 +37,9 @@ const (
 	SuccessfulDrainNode            = "SuccessfulDrainNode"
 	SuccessfulRebootNode           = "SuccessfulRebootNode"
 	SuccessfulReplaceNode          = "SuccessfulReplaceNode"
+	SuccessfulUpdateNodeLabels     = "SuccessfulUpdateNodeLabels"
+	TerminationReport              = "TerminationReport"
+	UserCertificatesRevoked        = "UserCertificatesRevoked"
 	WaitingForDeorbitLoadBalancers = "WaitingForDeorbitLoadBalancers"
 	WaitingForDeorbitSnapshots     = "WaitingForDeorbitSnapshots"
 	WaitingForDeorbitPVs           = "WaitingForDeorbitPVs"
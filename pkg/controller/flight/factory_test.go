@@ -0,0 +1,75 @@
+package flight
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetes_fake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	"github.com/sapcc/kubernikus/pkg/client/openstack/fake"
+	"github.com/sapcc/kubernikus/pkg/controller/nodeobservatory"
+)
+
+func TestFlightReconcilerWithFakeCloud(t *testing.T) {
+	cloud := fake.NewCloud()
+	cloud.AddProject("project", "project", fake.DefaultDomain)
+	clusterCIDR := "100.100.0.0/16"
+	kluster := &v1.Kluster{
+		ObjectMeta: meta_v1.ObjectMeta{Namespace: "default", Name: "test", Labels: map[string]string{"account": "project"}},
+		Spec: models.KlusterSpec{
+			Name:        "test",
+			ClusterCIDR: &clusterCIDR,
+			Openstack:   models.OpenstackSpec{SecurityGroupName: "default"},
+			NodePools:   []models.NodePool{{Name: "pool", Size: 3}},
+		},
+	}
+
+	factory := fake.NewFactory(cloud)
+	adminClient, err := factory.AdminClient()
+	require.NoError(t, err)
+	require.NoError(t, adminClient.CreateKlusterServiceUser("kubernikus-test", "secret", fake.DefaultDomain, "project"))
+	secret := &v1.Secret{Openstack: v1.Openstack{ProjectID: "project", Username: "kubernikus-test", DomainName: fake.DefaultDomain}}
+	data, err := secret.ToData()
+	require.NoError(t, err)
+	kubernetes := kubernetes_fake.NewSimpleClientset()
+	_, err = kubernetes.CoreV1().Secrets("default").Create(context.Background(), &core_v1.Secret{ObjectMeta: meta_v1.ObjectMeta{Name: "test-secret"}, Data: data}, meta_v1.CreateOptions{})
+	require.NoError(t, err)
+
+	registered := cloud.AddServer(fake.Server{ProjectID: "project", Name: "kks-test-pool-aaaaa", SecurityGroups: []string{"default"}})
+	unassigned := cloud.AddServer(fake.Server{ProjectID: "project", Name: "kks-test-pool-bbbbb"})
+	errored := cloud.AddServer(fake.Server{ProjectID: "project", Name: "kks-test-pool-ccccc", SecurityGroups: []string{"default"}, VMState: "error"})
+	stale := cloud.AddServer(fake.Server{ProjectID: "project", Name: "kks-test-pool-ddddd", SecurityGroups: []string{"default"}, Created: time.Now().Add(-time.Hour)})
+
+	observatory := nodeobservatory.NewFakeController(kluster,
+		&core_v1.Node{ObjectMeta: meta_v1.ObjectMeta{Name: registered.Name}},
+		&core_v1.Node{ObjectMeta: meta_v1.ObjectMeta{Name: unassigned.Name}},
+	)
+
+	reconciler, err := NewFlightReconcilerFactory(factory, kubernetes, observatory, record.NewFakeRecorder(10), log.NewNopLogger()).FlightReconciler(kluster)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{errored.ID}, reconciler.DeleteErroredInstances())
+	assert.Equal(t, []string{stale.ID}, reconciler.DeleteIncompletelySpawnedInstances())
+	_, found := cloud.Server(stale.ID)
+	assert.False(t, found)
+
+	assert.Equal(t, []string{unassigned.ID}, reconciler.EnsureInstanceSecurityGroupAssignment())
+	server, _ := cloud.Server(unassigned.ID)
+	assert.Equal(t, []string{"default"}, server.SecurityGroups)
+
+	assert.True(t, reconciler.EnsureKubernikusRuleInSecurityGroup())
+	assert.Empty(t, reconciler.EnsureServiceUserRoles(), "the service user has its roles already")
+
+	assert.ElementsMatch(t, []string{registered.Name, unassigned.Name}, reconciler.EnsureNodeMetadataAndTags())
+	server, _ = cloud.Server(registered.ID)
+	assert.Contains(t, server.Tags, "kubernikus:nodepool=pool")
+}
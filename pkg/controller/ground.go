@@ -34,6 +34,7 @@ import (
 
 	"github.com/sapcc/kubernikus/pkg/api/models"
 	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	"github.com/sapcc/kubernikus/pkg/client/openstack/admin"
 	"github.com/sapcc/kubernikus/pkg/client/openstack/project"
 	"github.com/sapcc/kubernikus/pkg/controller/config"
 	"github.com/sapcc/kubernikus/pkg/controller/ground"
@@ -56,12 +57,17 @@ const (
 	ConfigurationError = "ConfigurationError"
 	failedCreate       = "failedCreate"
 	failedUpgrade      = "failedUpgrade"
+	scheduledDeletion  = "ScheduledDeletion"
 
 	GroundctlFinalizer = "groundctl"
 
 	UpgradeEnableAnnotation = "kubernikus.cloud.sap/upgrade"
 	SeedReconcileLabelKey   = "kubernikus.cloud.sap/seed-reconcile"
 	InjectAdmissionCAKey    = "kubernikus.cloud.sap/inject-admission-ca"
+
+	// BackupProjectRole is assigned to the service user in the project of a
+	// backup container outside of the kluster's project
+	BackupProjectRole = "reader"
 )
 
 type GroundControl struct {
@@ -141,6 +147,7 @@ func (op *GroundControl) Run(stopCh <-chan struct{}, wg *sync.WaitGroup) {
 			select {
 			case <-ticker.C:
 				op.enqueueAllKlusters()
+				op.pruneTerminationReports()
 			case <-stopCh:
 				ticker.Stop()
 				return
@@ -165,6 +172,21 @@ func (op *GroundControl) enqueueAllKlusters() {
 	}
 }
 
+func (op *GroundControl) pruneTerminationReports() {
+	deleted, err := util.PruneTerminationReports(op.Clients.Kubernetes, op.Config.Kubernikus.Namespace, util.TerminationReportRetention)
+	if err != nil {
+		op.Logger.Log(
+			"msg", "Error pruning termination reports",
+			"err", err)
+	}
+	for _, name := range deleted {
+		op.Logger.Log(
+			"msg", "pruned termination report",
+			"configmap", name,
+			"v", 2)
+	}
+}
+
 func (op *GroundControl) runWorker() {
 	for op.processNextWorkItem() {
 	}
@@ -229,6 +251,17 @@ func (op *GroundControl) handler(key string) error {
 
 		metrics.SetMetricKlusterStatusPhase(kluster.GetName(), kluster.Status.Phase)
 
+		// Scheduled deletions apply to every phase, a kluster stuck in
+		// Pending or Creating must not outlive its grace period
+		if deadline, scheduled := kluster.ScheduledDeletion(); scheduled && time.Now().After(deadline) && kluster.Status.Phase != models.KlusterPhaseTerminating {
+			if !kluster.TerminationProtection() {
+				return op.executeScheduledDeletion(kluster)
+			}
+			if kluster, err = op.cancelScheduledDeletion(kluster); err != nil {
+				return err
+			}
+		}
+
 		op.ensureStructuredAuthConfigMap(kluster)
 
 		switch phase := kluster.Status.Phase; phase {
@@ -342,6 +375,10 @@ func (op *GroundControl) handler(key string) error {
 				return err
 			}
 
+			if done, err := op.reconcileEtcdRestore(kluster); err != nil || !done {
+				return err
+			}
+
 			klusterSecret, err := util.KlusterSecret(op.Clients.Kubernetes, kluster)
 			if err != nil {
 				return err
@@ -424,6 +461,13 @@ func (op *GroundControl) handler(key string) error {
 					)
 					return err
 				}
+			} else if err := op.reconcileNodeEviction(kluster, klusterSecret, accessMode); err != nil {
+				op.Logger.Log(
+					"msg", "failed to reconcile node eviction",
+					"kluster", kluster.GetName(),
+					"project", kluster.Account(),
+					"err", err)
+				return err
 			}
 
 		case models.KlusterPhaseUpgrading:
@@ -457,6 +501,20 @@ func (op *GroundControl) handler(key string) error {
 				}
 			}
 
+		case models.KlusterPhaseRestoring:
+			if done, err := op.reconcileEtcdRestore(kluster); err != nil || !done {
+				return err
+			}
+			if err := op.updatePhase(kluster, models.KlusterPhaseRunning); err != nil {
+				op.Logger.Log(
+					"msg", "failed to update status of kluster",
+					"kluster", kluster.GetName(),
+					"project", kluster.Account(),
+					"err", err,
+				)
+				return err
+			}
+
 		case models.KlusterPhaseTerminating:
 			{
 				// Wait until all other finalizers are done.
@@ -626,6 +684,10 @@ func (op *GroundControl) createKluster(kluster *v1.Kluster) error {
 		return err
 	}
 
+	if err := util.DeleteTerminationReport(op.Clients.Kubernetes, kluster.Namespace, kluster.Name); err != nil {
+		return fmt.Errorf("failed to delete termination report of a previous kluster: %s", err)
+	}
+
 	klusterSecret, err := util.EnsureKlusterSecret(op.Clients.Kubernetes, kluster)
 	if err != nil {
 		return fmt.Errorf("failed to ensure create kluster secret; %s", err)
@@ -752,6 +814,10 @@ func (op *GroundControl) createKluster(kluster *v1.Kluster) error {
 }
 
 func (op *GroundControl) upgradeKluster(kluster *v1.Kluster, toVersion string) error {
+	if err := op.ensureEtcdSnapshot(kluster, "upgrade to "+toVersion); err != nil {
+		return errors.Wrap(err, "etcd snapshot")
+	}
+
 	klusterSecret, err := util.KlusterSecret(op.Clients.Kubernetes, kluster)
 	if err != nil {
 		return err
@@ -835,7 +901,14 @@ func (op *GroundControl) upgradeKluster(kluster *v1.Kluster, toVersion string) e
 		return fmt.Errorf("couldn't determine access mode for pvc: %s", err)
 	}
 
-	values, err := helm_util.KlusterToHelmValues(kluster, klusterSecret, toVersion, &op.Config.Images, accessMode)
+	return op.upgradeRelease(kluster, klusterSecret, toVersion, accessMode)
+}
+
+// upgradeRelease renders the chart for the version and upgrades the helm
+// release of the kluster. The node eviction state it was rendered with is
+// recorded on the kluster.
+func (op *GroundControl) upgradeRelease(kluster *v1.Kluster, klusterSecret *v1.Secret, version, accessMode string) error {
+	values, err := helm_util.KlusterToHelmValues(kluster, klusterSecret, version, &op.Config.Images, accessMode)
 	if err != nil {
 		return err
 	}
@@ -844,8 +917,78 @@ func (op *GroundControl) upgradeKluster(kluster *v1.Kluster, toVersion string) e
 		return err
 	}
 	upgrade := action.NewUpgrade(op.Clients.Helm3)
-	_, err = upgrade.Run(kluster.Name, chart, values)
-	return err
+	if _, err = upgrade.Run(kluster.Name, chart, values); err != nil {
+		return err
+	}
+	paused := kluster.NodeEvictionPaused()
+	return op.updateKluster(kluster, func(k *v1.Kluster) error {
+		if k.NodeEvictionPausedDeployed() == paused {
+			return util.ErrKlusterNotUpdated
+		}
+		if paused {
+			if k.Annotations == nil {
+				k.Annotations = map[string]string{}
+			}
+			k.Annotations[v1.NodeEvictionPausedDeployedAnnotationKey] = "true"
+		} else {
+			delete(k.Annotations, v1.NodeEvictionPausedDeployedAnnotationKey)
+		}
+		return nil
+	})
+}
+
+// reconcileNodeEviction rolls out the controller-manager when hammertime
+// paused or resumed node eviction since the chart was last deployed
+func (op *GroundControl) reconcileNodeEviction(kluster *v1.Kluster, klusterSecret *v1.Secret, accessMode string) error {
+	if kluster.NodeEvictionPaused() == kluster.NodeEvictionPausedDeployed() {
+		return nil
+	}
+	if err := op.upgradeRelease(kluster, klusterSecret, kluster.Spec.Version, accessMode); err != nil {
+		return err
+	}
+	op.Logger.Log(
+		"msg", "updated node eviction",
+		"kluster", kluster.GetName(),
+		"project", kluster.Account(),
+		"paused", kluster.NodeEvictionPaused())
+	return nil
+}
+
+// executeScheduledDeletion terminates a kluster whose grace period is over
+func (op *GroundControl) executeScheduledDeletion(kluster *v1.Kluster) error {
+	op.Logger.Log(
+		"msg", "terminating kluster scheduled for deletion",
+		"kluster", kluster.GetName(),
+		"project", kluster.Account(),
+		"deadline", kluster.Annotations[v1.ScheduledDeletionAnnotationKey])
+	op.Recorder.Event(kluster, api_v1.EventTypeNormal, scheduledDeletion, "Grace period is over, terminating kluster")
+	return util.TerminateKluster(op.Clients.Kubernikus.KubernikusV1(), kluster)
+}
+
+// cancelScheduledDeletion drops the deletion of a kluster whose termination
+// protection was enabled in the meantime and restores its node pool sizes.
+// The returned kluster is reconciled as usual.
+func (op *GroundControl) cancelScheduledDeletion(kluster *v1.Kluster) (*v1.Kluster, error) {
+	updated, err := util.UpdateKlusterWithRetries(
+		op.Clients.Kubernikus.KubernikusV1().Klusters(kluster.Namespace),
+		op.klusterInformer.Lister().Klusters(kluster.Namespace),
+		kluster.Name,
+		func(k *v1.Kluster) error {
+			if _, scheduled := k.ScheduledDeletion(); !scheduled {
+				return util.ErrKlusterNotUpdated
+			}
+			return k.CancelScheduledDeletion()
+		})
+	if err != nil {
+		return nil, err
+	}
+	op.Recorder.Event(kluster, api_v1.EventTypeWarning, scheduledDeletion, "Scheduled deletion canceled, termination protection enabled")
+	//Wait for up to 5 seconds for the local cache to reflect the cancellation
+	waitutil.WaitForKluster(updated, op.klusterInformer.Informer().GetIndexer(), func(k *v1.Kluster) (bool, error) {
+		_, scheduled := k.ScheduledDeletion()
+		return !scheduled, nil
+	})
+	return updated, nil
 }
 
 func (op *GroundControl) terminateKluster(kluster *v1.Kluster) error {
@@ -1121,14 +1264,15 @@ func (op *GroundControl) ensureStorageContainers(kluster *v1.Kluster, klusterSec
 		return err
 	}
 
-	ensureContainer := func(name string) error {
-		meta, err := adminClient.GetStorageContainerMeta(klusterSecret.ProjectID, name)
+	ensureContainer := func(projectID, region, name string) error {
+		meta, err := adminClient.GetStorageContainerMeta(projectID, region, name)
 		if err != nil {
 			return err
 		}
 		if meta == nil {
 			if err := adminClient.CreateStorageContainer(
-				klusterSecret.ProjectID,
+				projectID,
+				region,
 				name,
 				klusterSecret.Username,
 				klusterSecret.DomainName,
@@ -1137,7 +1281,7 @@ func (op *GroundControl) ensureStorageContainers(kluster *v1.Kluster, klusterSec
 			}
 			return nil
 		}
-		aclStr, err := adminClient.GetContainerACLEntry(klusterSecret.ProjectID, klusterSecret.Username, klusterSecret.DomainName)
+		aclStr, err := adminClient.GetContainerACLEntry(projectID, klusterSecret.Username, klusterSecret.DomainName)
 		if err != nil {
 			return fmt.Errorf("failed to determine swift acl entry for kluster %s: %w", kluster.Name, err)
 		}
@@ -1151,24 +1295,51 @@ func (op *GroundControl) ensureStorageContainers(kluster *v1.Kluster, klusterSec
 			needsUpdate = true
 		}
 		if needsUpdate {
-			adminClient.UpdateStorageContainerMeta(klusterSecret.ProjectID, name, *meta)
+			adminClient.UpdateStorageContainerMeta(projectID, region, name, *meta)
 		}
 		return nil
 	}
 
-	if kluster.Spec.Backup == "on" {
-		if err = ensureContainer(etcd_util.DefaultStorageContainer(kluster)); err != nil {
+	if backup, ok := etcd_util.SwiftBackupContainer(kluster); ok {
+		if backup.ProjectID != klusterSecret.ProjectID {
+			if err := op.ensureBackupProjectAccess(kluster, klusterSecret, adminClient, backup.ProjectID); err != nil {
+				return err
+			}
+		}
+		if err = ensureContainer(backup.ProjectID, backup.Region, backup.Name); err != nil {
 			return err
 		}
 	}
 	if conv.Value(kluster.Spec.Audit) == "swift" {
-		if err = ensureContainer(kluster.Name + "-audit-log"); err != nil {
+		if err = ensureContainer(klusterSecret.ProjectID, "", kluster.Name+"-audit-log"); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// ensureBackupProjectAccess lets etcdbr scope to the project of a backup
+// container outside of the kluster's project. The container ACL grants the
+// actual access, the role only allows to authenticate.
+func (op *GroundControl) ensureBackupProjectAccess(kluster *v1.Kluster, klusterSecret *v1.Secret, adminClient admin.AdminClient, projectID string) error {
+	roles, err := adminClient.GetUserRoles(projectID, klusterSecret.Username, klusterSecret.DomainName)
+	if err != nil {
+		return fmt.Errorf("failed to get roles in backup project %s: %w", projectID, err)
+	}
+	if !slices.Contains(roles, BackupProjectRole) {
+		if err := adminClient.AssignUserRoles(projectID, klusterSecret.Username, klusterSecret.DomainName, []string{BackupProjectRole}); err != nil {
+			return fmt.Errorf("failed to assign role %s in backup project %s: %w", BackupProjectRole, projectID, err)
+		}
+	}
+	if klusterSecret.Backup.SwiftProjectName != "" {
+		return nil
+	}
+	if klusterSecret.Backup.SwiftProjectName, err = adminClient.GetProjectName(projectID); err != nil {
+		return fmt.Errorf("failed to retrieve name of backup project %s: %w", projectID, err)
+	}
+	return util.UpdateKlusterSecret(op.Clients.Kubernetes, kluster, klusterSecret)
+}
+
 // inject admission CA in labeled namespaces
 func (op *GroundControl) ensureAdmissionCA(kluster *v1.Kluster, klusterSecret *v1.Secret) error {
 	k8sClient, err := op.Clients.Satellites.ClientFor(kluster)
@@ -0,0 +1,194 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-openapi/swag/conv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubernetes_fake "k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	"github.com/sapcc/kubernikus/pkg/client/openstack/fake"
+	"github.com/sapcc/kubernikus/pkg/controller/config"
+	kubernikus_fake "github.com/sapcc/kubernikus/pkg/generated/clientset/fake"
+	kubernikus_informers "github.com/sapcc/kubernikus/pkg/generated/informers/externalversions"
+)
+
+func newFakeGroundControl(cloud *fake.Cloud) *GroundControl {
+	return &GroundControl{
+		Factories: config.Factories{Openstack: fake.NewFactory(cloud)},
+		Logger:    log.NewNopLogger(),
+	}
+}
+
+func newGroundKluster() *v1.Kluster {
+	return &v1.Kluster{
+		ObjectMeta: meta_v1.ObjectMeta{Namespace: "default", Name: "test", Labels: map[string]string{"account": "project"}},
+		Spec:       models.KlusterSpec{Name: "test"},
+	}
+}
+
+func TestDiscoverOpenstackInfo(t *testing.T) {
+	cloud := fake.NewCloud()
+	cloud.AddProject("project", "project", fake.DefaultDomain)
+	router := cloud.Routers("project")[0]
+	op := newFakeGroundControl(cloud)
+
+	kluster := newGroundKluster()
+	require.True(t, op.requiresOpenstackInfo(kluster))
+	require.NoError(t, op.discoverOpenstackInfo(kluster))
+	assert.False(t, op.requiresOpenstackInfo(kluster))
+	assert.Equal(t, models.OpenstackSpec{
+		RouterID:            router.ID,
+		NetworkID:           router.Networks[0].ID,
+		LBSubnetID:          router.Networks[0].Subnets[0].ID,
+		LBFloatingNetworkID: router.ExternalNetworkID,
+		SecurityGroupName:   "default",
+	}, kluster.Spec.Openstack)
+
+	kluster = newGroundKluster()
+	kluster.Spec.Openstack.RouterID = "unknown"
+	assert.EqualError(t, op.discoverOpenstackInfo(kluster), "specified router unknown not found in project")
+
+	kluster = newGroundKluster()
+	kluster.Spec.Openstack.SecurityGroupName = "unknown"
+	assert.EqualError(t, op.discoverOpenstackInfo(kluster), "selected security group unknown not found in project")
+
+	kluster = newGroundKluster()
+	kluster.Labels["account"] = "other"
+	assert.Error(t, op.discoverOpenstackInfo(kluster))
+}
+
+func TestEnsureStorageContainers(t *testing.T) {
+	cloud := fake.NewCloud()
+	cloud.AddProject("project", "project", fake.DefaultDomain)
+	op := newFakeGroundControl(cloud)
+	adminClient, err := op.Factories.Openstack.AdminClient()
+	require.NoError(t, err)
+	require.NoError(t, adminClient.CreateKlusterServiceUser("kubernikus-test", "secret", fake.DefaultDomain, "project"))
+	secret := &v1.Secret{Openstack: v1.Openstack{ProjectID: "project", Username: "kubernikus-test", DomainName: fake.DefaultDomain}}
+
+	acl, err := adminClient.GetContainerACLEntry("project", "kubernikus-test", fake.DefaultDomain)
+	require.NoError(t, err)
+	names := func() []string {
+		result := []string{}
+		for _, container := range cloud.Containers("project") {
+			assert.Equal(t, []string{acl}, container.WriteACL, container.Name)
+			result = append(result, container.Name)
+		}
+		return result
+	}
+
+	kluster := newGroundKluster()
+	kluster.UID = "12345"
+	require.NoError(t, op.ensureStorageContainers(kluster, secret))
+	assert.ElementsMatch(t, []string{"kubernikus-etcd-backup-test-12345"}, names())
+
+	kluster.Spec.Audit = conv.Pointer("swift")
+	require.NoError(t, op.ensureStorageContainers(kluster, secret))
+	assert.ElementsMatch(t, []string{"kubernikus-etcd-backup-test-12345", "test-audit-log"}, names())
+
+	require.NoError(t, op.ensureStorageContainers(kluster, secret), "existing containers are kept")
+	assert.Len(t, cloud.Containers("project"), 2)
+}
+
+func TestScheduledDeletion(t *testing.T) {
+	for _, phase := range []models.KlusterPhase{models.KlusterPhasePending, models.KlusterPhaseCreating, models.KlusterPhaseRunning} {
+		t.Run(string(phase), func(t *testing.T) {
+			kluster := newGroundKluster()
+			kluster.Annotations = map[string]string{v1.ScheduledDeletionAnnotationKey: time.Now().Add(-time.Minute).Format(time.RFC3339)}
+			kluster.Status.Phase = phase
+
+			clientset := kubernikus_fake.NewSimpleClientset(kluster)
+			informers := kubernikus_informers.NewSharedInformerFactory(clientset, 0)
+			klusterInformer := informers.Kubernikus().V1().Klusters()
+			require.NoError(t, klusterInformer.Informer().GetIndexer().Add(kluster))
+			op := &GroundControl{
+				Clients:         config.Clients{Kubernikus: clientset, Kubernetes: kubernetes_fake.NewSimpleClientset()},
+				Recorder:        record.NewFakeRecorder(10),
+				klusterInformer: klusterInformer,
+				Logger:          log.NewNopLogger(),
+			}
+
+			require.NoError(t, op.handler("default/test"))
+			_, err := clientset.KubernikusV1().Klusters("default").Get(context.Background(), "test", meta_v1.GetOptions{})
+			assert.Error(t, err, "the kluster is deleted")
+		})
+	}
+}
+
+func TestScheduledDeletionProtected(t *testing.T) {
+	cloud := fake.NewCloud()
+	cloud.AddProject("project", "project", fake.DefaultDomain)
+
+	kluster := newGroundKluster()
+	kluster.Annotations = map[string]string{
+		v1.ScheduledDeletionAnnotationKey:          time.Now().Add(-time.Minute).Format(time.RFC3339),
+		v1.ScheduledDeletionPoolSizesAnnotationKey: `{"default":3}`,
+	}
+	kluster.Spec.TerminationProtection = conv.Pointer(true)
+	kluster.Spec.NodePools = []models.NodePool{{Name: "default", Size: 0}}
+	kluster.Status.Phase = models.KlusterPhasePending
+
+	clientset := kubernikus_fake.NewSimpleClientset(kluster)
+	informers := kubernikus_informers.NewSharedInformerFactory(clientset, 0)
+	klusterInformer := informers.Kubernikus().V1().Klusters()
+	require.NoError(t, klusterInformer.Informer().GetIndexer().Add(kluster))
+	// keep the cache in sync with the updates
+	clientset.PrependReactor("*", "klusters", func(action core.Action) (bool, runtime.Object, error) {
+		handled, obj, err := core.ObjectReaction(clientset.Tracker())(action)
+		if err == nil && action.GetVerb() == "update" {
+			require.NoError(t, klusterInformer.Informer().GetIndexer().Update(obj))
+		}
+		return handled, obj, err
+	})
+	recorder := record.NewFakeRecorder(10)
+	op := newFakeGroundControl(cloud)
+	op.Clients = config.Clients{Kubernikus: clientset, Kubernetes: kubernetes_fake.NewSimpleClientset()}
+	op.Recorder = recorder
+	op.klusterInformer = klusterInformer
+
+	require.NoError(t, op.handler("default/test"))
+	updated, err := clientset.KubernikusV1().Klusters("default").Get(context.Background(), "test", meta_v1.GetOptions{})
+	require.NoError(t, err, "the kluster is kept")
+	_, scheduled := updated.ScheduledDeletion()
+	assert.False(t, scheduled)
+	assert.NotContains(t, updated.Annotations, v1.ScheduledDeletionPoolSizesAnnotationKey)
+	assert.Equal(t, int64(3), updated.Spec.NodePools[0].Size)
+	assert.False(t, op.requiresOpenstackInfo(updated), "the kluster is reconciled")
+
+	// the next sync continues with the discovery and doesn't warn again
+	op.handler("default/test") //nolint:errcheck
+	close(recorder.Events)
+	var events []string
+	for event := range recorder.Events {
+		if strings.Contains(event, "ScheduledDeletion") {
+			events = append(events, event)
+		}
+	}
+	assert.Equal(t, []string{"Warning ScheduledDeletion Scheduled deletion canceled, termination protection enabled"}, events)
+}
+
+func TestReconcileNodeEvictionUnchanged(t *testing.T) {
+	// no helm client, the release must not be touched
+	op := newFakeGroundControl(fake.NewCloud())
+
+	kluster := newGroundKluster()
+	assert.NoError(t, op.reconcileNodeEviction(kluster, nil, ""))
+
+	kluster.Annotations = map[string]string{
+		v1.PauseNodeEvictionAnnotationKey:          "true",
+		v1.NodeEvictionPausedDeployedAnnotationKey: "true",
+	}
+	assert.NoError(t, op.reconcileNodeEviction(kluster, nil, ""))
+}
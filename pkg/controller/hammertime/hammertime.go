@@ -3,6 +3,7 @@ package hammertime
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	kitlog "github.com/go-kit/log"
@@ -20,24 +21,50 @@ import (
 	kube "github.com/sapcc/kubernikus/pkg/client/kubernetes"
 	"github.com/sapcc/kubernikus/pkg/controller/base"
 	"github.com/sapcc/kubernikus/pkg/controller/config"
+	"github.com/sapcc/kubernikus/pkg/controller/events"
 	"github.com/sapcc/kubernikus/pkg/controller/metrics"
 	"github.com/sapcc/kubernikus/pkg/controller/nodeobservatory"
+	kubernikus_clientset "github.com/sapcc/kubernikus/pkg/generated/clientset"
+	listers_kubernikus "github.com/sapcc/kubernikus/pkg/generated/listers/kubernikus/v1"
 	"github.com/sapcc/kubernikus/pkg/util"
 )
 
 type hammertimeController struct {
 	nodeObervatory *nodeobservatory.NodeObservatory
 	client         kubernetes.Interface
+	kubernikus     kubernikus_clientset.Interface
+	klusterLister  listers_kubernikus.KlusterLister
 	satellites     kube.SharedClientFactory
 	timeout        time.Duration
 	logger         kitlog.Logger
 	recorder       record.EventRecorder
+	// warned holds the klusters in the grace period, the warning event is
+	// only emitted when entering it
+	warned sync.Map
 }
 
 const (
 	HammertimeDisableAnnotation = "kubernikus.cloud.sap/hammertime"
+
+	// ModeScale scales the controller-manager to zero
+	ModeScale = "scale"
+	// ModeGraded warns during the grace period and then pauses node eviction
+	ModeGraded = "graded"
+
+	// DefaultMinNodes is the minimum number of nodes required for hammertime
+	DefaultMinNodes = 2
+
+	// MaxActivations is the number of activations kept in the kluster status
+	MaxActivations = 10
 )
 
+type settings struct {
+	timeout     time.Duration
+	gracePeriod time.Duration
+	minNodes    int
+	mode        string
+}
+
 func New(syncPeriod time.Duration, timeout time.Duration, factories config.Factories, clients config.Clients, recorder record.EventRecorder, logger kitlog.Logger) base.Controller {
 
 	logger = kitlog.With(logger, "controller", "hammertime")
@@ -45,6 +72,8 @@ func New(syncPeriod time.Duration, timeout time.Duration, factories config.Facto
 	controller := hammertimeController{
 		nodeObervatory: factories.NodesObservatory.NodeInformer(),
 		client:         clients.Kubernetes,
+		kubernikus:     clients.Kubernikus,
+		klusterLister:  factories.Kubernikus.Kubernikus().V1().Klusters().Lister(),
 		satellites:     clients.Satellites,
 		timeout:        timeout,
 		logger:         logger,
@@ -64,9 +93,11 @@ func (hc *hammertimeController) Reconcile(kluster *v1.Kluster) error {
 
 	// stop hammertime during upgrades and termination or if explicitly disabled
 	if kluster.Status.Phase != models.KlusterPhaseRunning || util.DisabledValue(kluster.Annotations[HammertimeDisableAnnotation]) {
-		return hc.scaleDeployment(kluster, false, logger)
+		return hc.release(kluster, logger)
 	}
 
+	settings := hc.settingsFor(kluster)
+
 	lister, err := hc.nodeObervatory.GetListerForKluster(kluster)
 	if err != nil {
 		return fmt.Errorf("failed to get node lister: %s", err)
@@ -76,13 +107,13 @@ func (hc *hammertimeController) Reconcile(kluster *v1.Kluster) error {
 		return fmt.Errorf("listing nodes failed: %s", err)
 	}
 
-	// No Hammertime if the cluster is terminating or has less then two nodes
+	// No Hammertime if the cluster is terminating or has less nodes than required
 	specNodes := 0
 	for _, pool := range kluster.Spec.NodePools {
 		specNodes += int(pool.Size)
 	}
-	if len(nodes) < 2 || specNodes < 2 || kluster.Status.Phase != models.KlusterPhaseRunning {
-		return hc.scaleDeployment(kluster, false, logger)
+	if len(nodes) < settings.minNodes || specNodes < settings.minNodes || kluster.Status.Phase != models.KlusterPhaseRunning {
+		return hc.release(kluster, logger)
 	}
 
 	var newestHearbeat time.Time
@@ -111,9 +142,147 @@ func (hc *hammertimeController) Reconcile(kluster *v1.Kluster) error {
 		}
 	}
 
-	timeout_exeeded := time.Since(newestHearbeat) > hc.timeout
+	stale := time.Since(newestHearbeat)
+	if stale <= settings.timeout {
+		return hc.release(kluster, logger)
+	}
+
+	if settings.mode != ModeGraded {
+		if err := hc.scaleDeployment(kluster, true, logger); err != nil {
+			return err
+		}
+		return hc.recordActivation(kluster, true, ModeScale, logger)
+	}
+
+	if stale <= settings.timeout+settings.gracePeriod {
+		_, warned := hc.warned.Load(kluster.GetName())
+		if err := hc.release(kluster, logger); err != nil {
+			return err
+		}
+		hc.warned.Store(kluster.GetName(), true)
+		metrics.HammertimeWarning.WithLabelValues(kluster.Name).Set(1)
+		if !warned {
+			hc.recorder.Eventf(kluster, core_v1.EventTypeWarning, events.HammertimeWarning, "All node heartbeats are older than %s, node eviction will be paused after %s", settings.timeout, settings.gracePeriod)
+		}
+		return nil
+	}
+
+	hc.warned.Delete(kluster.GetName())
+	metrics.HammertimeWarning.WithLabelValues(kluster.Name).Set(0)
+	if err := hc.scaleDeployment(kluster, false, logger); err != nil {
+		return err
+	}
+	metrics.HammertimeStatus.WithLabelValues(kluster.Name).Set(1)
+	return hc.recordActivation(kluster, true, ModeGraded, logger)
+}
+
+// settingsFor merges the kluster's hammertime spec with the operator defaults
+func (hc *hammertimeController) settingsFor(kluster *v1.Kluster) settings {
+	s := settings{
+		timeout:  hc.timeout,
+		minNodes: DefaultMinNodes,
+		mode:     ModeScale,
+	}
+	if spec := kluster.Spec.Hammertime; spec != nil {
+		if spec.Timeout > 0 {
+			s.timeout = time.Duration(spec.Timeout) * time.Second
+		}
+		if spec.MinNodes > 0 {
+			s.minNodes = int(spec.MinNodes)
+		}
+		if spec.Mode != "" {
+			s.mode = spec.Mode
+		}
+	}
+	s.gracePeriod = s.timeout
+	if spec := kluster.Spec.Hammertime; spec != nil && spec.GracePeriod != nil {
+		s.gracePeriod = time.Duration(*spec.GracePeriod) * time.Second
+	}
+	return s
+}
+
+// release undoes any intervention regardless of the mode it was done in
+func (hc *hammertimeController) release(kluster *v1.Kluster, logger kitlog.Logger) error {
+	hc.warned.Delete(kluster.GetName())
+	metrics.HammertimeWarning.WithLabelValues(kluster.Name).Set(0)
+	if err := hc.scaleDeployment(kluster, false, logger); err != nil {
+		return err
+	}
+	return hc.recordActivation(kluster, false, "", logger)
+}
+
+// recordActivation keeps track of activations in the kluster status. While
+// active in graded mode node eviction is paused by annotating the kluster, the
+// kube-controller-manager flags are rendered by the chart from it and rolled
+// out by groundctl.
+func (hc *hammertimeController) recordActivation(kluster *v1.Kluster, active bool, mode string, logger kitlog.Logger) error {
+	pause := active && mode == ModeGraded
+	if status := kluster.Status.Hammertime; ((status == nil && !active) || (status != nil && status.Active == active)) && kluster.NodeEvictionPaused() == pause {
+		return nil
+	}
+	var toggled, pauseChanged bool
+	_, err := util.UpdateKlusterWithRetries(hc.kubernikus.KubernikusV1().Klusters(kluster.Namespace), hc.klusterLister.Klusters(kluster.Namespace), kluster.GetName(), func(kluster *v1.Kluster) error {
+		toggled = false
+		pauseChanged = setNodeEvictionPaused(kluster, pause)
+		status := kluster.Status.Hammertime
+		if (status == nil && !active) || (status != nil && status.Active == active) {
+			if !pauseChanged {
+				return util.ErrKlusterNotUpdated
+			}
+			return nil
+		}
+		if status == nil {
+			status = &models.HammertimeStatus{}
+			kluster.Status.Hammertime = status
+		}
+		toggled = true
+		status.Active = active
+		now := time.Now().UTC().Format(time.RFC3339)
+		if active {
+			status.Activations = append(status.Activations, models.HammertimeActivation{Mode: mode, Start: now})
+			if len(status.Activations) > MaxActivations {
+				status.Activations = status.Activations[len(status.Activations)-MaxActivations:]
+			}
+		} else if len(status.Activations) > 0 {
+			status.Activations[len(status.Activations)-1].End = now
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record hammertime activation: %s", err)
+	}
+	if pauseChanged {
+		if pause {
+			logger.Log("msg", "Pausing node eviction")
+		} else {
+			logger.Log("msg", "Resuming node eviction")
+		}
+	}
+	if !toggled {
+		return nil
+	}
+	if active {
+		hc.recorder.Eventf(kluster, core_v1.EventTypeWarning, events.HammertimeActivated, "All node heartbeats are stale, hammertime activated (mode: %s)", mode)
+	} else {
+		hc.recorder.Event(kluster, core_v1.EventTypeNormal, events.HammertimeDeactivated, "Node heartbeats recovered, hammertime deactivated")
+	}
+	return nil
+}
 
-	return hc.scaleDeployment(kluster, timeout_exeeded, logger)
+// setNodeEvictionPaused sets or removes the annotation and returns whether it changed
+func setNodeEvictionPaused(kluster *v1.Kluster, pause bool) bool {
+	if kluster.NodeEvictionPaused() == pause {
+		return false
+	}
+	if !pause {
+		delete(kluster.Annotations, v1.PauseNodeEvictionAnnotationKey)
+		return true
+	}
+	if kluster.Annotations == nil {
+		kluster.Annotations = map[string]string{}
+	}
+	kluster.Annotations[v1.PauseNodeEvictionAnnotationKey] = "true"
+	return true
 }
 
 func (hc *hammertimeController) scaleDeployment(kluster *v1.Kluster, disable bool, logger kitlog.Logger) error {
@@ -0,0 +1,139 @@
+package hammertime
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-openapi/swag"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apps_v1 "k8s.io/api/apps/v1"
+	autoscaling_v1 "k8s.io/api/autoscaling/v1"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubernetes_fake "k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	"github.com/sapcc/kubernikus/pkg/controller/metrics"
+	"github.com/sapcc/kubernikus/pkg/controller/nodeobservatory"
+	kubernikus_fake "github.com/sapcc/kubernikus/pkg/generated/clientset/fake"
+	kubernikus_informers "github.com/sapcc/kubernikus/pkg/generated/informers/externalversions"
+)
+
+func newNodes(heartbeat time.Time) []runtime.Object {
+	nodes := []runtime.Object{}
+	for _, name := range []string{"kks-test-pool-aaaaa", "kks-test-pool-bbbbb"} {
+		nodes = append(nodes, &core_v1.Node{
+			ObjectMeta: meta_v1.ObjectMeta{Name: name},
+			Status: core_v1.NodeStatus{
+				Conditions: []core_v1.NodeCondition{
+					{Type: core_v1.NodeReady, LastHeartbeatTime: meta_v1.NewTime(heartbeat)},
+				},
+			},
+		})
+	}
+	return nodes
+}
+
+func TestSettings(t *testing.T) {
+	hc := &hammertimeController{timeout: 20 * time.Second}
+
+	s := hc.settingsFor(&v1.Kluster{})
+	assert.Equal(t, settings{timeout: 20 * time.Second, gracePeriod: 20 * time.Second, minNodes: 2, mode: ModeScale}, s)
+
+	s = hc.settingsFor(&v1.Kluster{Spec: models.KlusterSpec{Hammertime: &models.HammertimeSpec{Timeout: 60, MinNodes: 5, Mode: ModeGraded, GracePeriod: swag.Int64(0)}}})
+	assert.Equal(t, settings{timeout: time.Minute, gracePeriod: 0, minNodes: 5, mode: ModeGraded}, s)
+}
+
+func TestReconcileGraded(t *testing.T) {
+	kluster := &v1.Kluster{
+		ObjectMeta: meta_v1.ObjectMeta{Namespace: "test", Name: "test"},
+		Spec: models.KlusterSpec{
+			Name:       "test",
+			NodePools:  []models.NodePool{{Name: "pool", Size: 2}},
+			Hammertime: &models.HammertimeSpec{Mode: ModeGraded, Timeout: 60, GracePeriod: swag.Int64(60)},
+		},
+		Status: models.KlusterStatus{Phase: models.KlusterPhaseRunning},
+	}
+	deployment := &apps_v1.Deployment{
+		ObjectMeta: meta_v1.ObjectMeta{Namespace: "test", Name: "test-cmanager"},
+		Spec: apps_v1.DeploymentSpec{
+			Template: core_v1.PodTemplateSpec{
+				Spec: core_v1.PodSpec{
+					Containers: []core_v1.Container{{Name: "controller-manager", Args: []string{"kube-controller-manager"}}},
+				},
+			},
+		},
+	}
+	client := kubernetes_fake.NewSimpleClientset(deployment)
+	client.PrependReactor("get", "deployments", func(action core.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "scale" {
+			return false, nil, nil
+		}
+		return true, &autoscaling_v1.Scale{Spec: autoscaling_v1.ScaleSpec{Replicas: 1}}, nil
+	})
+	kubernikus := kubernikus_fake.NewSimpleClientset(kluster)
+	informers := kubernikus_informers.NewSharedInformerFactory(kubernikus, 0)
+	klusters := informers.Kubernikus().V1().Klusters()
+
+	recorder := record.NewFakeRecorder(10)
+	hc := &hammertimeController{
+		client:        client,
+		kubernikus:    kubernikus,
+		klusterLister: klusters.Lister(),
+		timeout:       20 * time.Second,
+		logger:        log.NewNopLogger(),
+		recorder:      recorder,
+	}
+
+	reconcile := func(heartbeat time.Time) *v1.Kluster {
+		current, err := kubernikus.KubernikusV1().Klusters("test").Get(context.Background(), "test", meta_v1.GetOptions{})
+		require.NoError(t, err)
+		require.NoError(t, klusters.Informer().GetIndexer().Update(current))
+		hc.nodeObervatory = nodeobservatory.NewFakeController(current, newNodes(heartbeat)...)
+		require.NoError(t, hc.Reconcile(current))
+
+		current, err = kubernikus.KubernikusV1().Klusters("test").Get(context.Background(), "test", meta_v1.GetOptions{})
+		require.NoError(t, err)
+		return current
+	}
+
+	// within the grace period hammertime only warns
+	current := reconcile(time.Now().Add(-90 * time.Second))
+	assert.False(t, current.NodeEvictionPaused())
+	assert.Nil(t, current.Status.Hammertime)
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.HammertimeWarning.WithLabelValues("test")))
+	// the warning is only emitted when entering the grace period
+	reconcile(time.Now().Add(-100 * time.Second))
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, "HammertimeWarning")
+
+	// afterwards node eviction is paused
+	current = reconcile(time.Now().Add(-150 * time.Second))
+	assert.True(t, current.NodeEvictionPaused())
+	require.NotNil(t, current.Status.Hammertime)
+	assert.True(t, current.Status.Hammertime.Active)
+	require.Len(t, current.Status.Hammertime.Activations, 1)
+	assert.Equal(t, ModeGraded, current.Status.Hammertime.Activations[0].Mode)
+	assert.Empty(t, current.Status.Hammertime.Activations[0].End)
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.HammertimeStatus.WithLabelValues("test")))
+
+	// and resumed once heartbeats recover
+	current = reconcile(time.Now())
+	assert.False(t, current.NodeEvictionPaused())
+	assert.False(t, current.Status.Hammertime.Active)
+	assert.NotEmpty(t, current.Status.Hammertime.Activations[0].End)
+	assert.Equal(t, 0.0, testutil.ToFloat64(metrics.HammertimeStatus.WithLabelValues("test")))
+
+	// the controller-manager is left to the chart
+	deployment, err := client.AppsV1().Deployments("test").Get(context.Background(), "test-cmanager", meta_v1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"kube-controller-manager"}, deployment.Spec.Template.Spec.Containers[0].Args)
+}
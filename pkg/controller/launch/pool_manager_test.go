@@ -3,12 +3,16 @@ package launch
 import (
 	"testing"
 
+	"github.com/go-kit/log"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	core_v1 "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/sapcc/kubernikus/pkg/api/models"
 	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	"github.com/sapcc/kubernikus/pkg/client/openstack/fake"
 	"github.com/sapcc/kubernikus/pkg/controller/nodeobservatory"
 )
 
@@ -92,3 +96,55 @@ func TestNodesSorting(t *testing.T) {
 	}
 
 }
+
+func TestPoolManagerWithFakeCloud(t *testing.T) {
+	cloud := fake.NewCloud()
+	cloud.AddProject("project", "project", fake.DefaultDomain)
+	kluster := &v1.Kluster{
+		ObjectMeta: meta_v1.ObjectMeta{Namespace: "default", Name: "test", Labels: map[string]string{"account": "project"}},
+		Spec:       models.KlusterSpec{Name: "test"},
+	}
+	pool := &models.NodePool{Name: "pool", Size: 3}
+
+	running := cloud.AddServer(fake.Server{ProjectID: "project", Name: "kks-test-pool-aaaaa"})
+	starting := cloud.AddServer(fake.Server{ProjectID: "project", Name: "kks-test-pool-bbbbb", VMState: "building"})
+	cloud.AddServer(fake.Server{ProjectID: "project", Name: "kks-test-other-ccccc"})
+	cloud.AddServer(fake.Server{ProjectID: "project", Name: "kks-other-pool-ddddd"})
+	cloud.AddServerGroup(fake.ServerGroup{ProjectID: "project", Name: "test/pool"})
+
+	klusterClient, err := fake.NewFactory(cloud).KlusterClientFor(kluster)
+	require.NoError(t, err)
+	node := &core_v1.Node{
+		ObjectMeta: meta_v1.ObjectMeta{Name: running.Name},
+		Spec:       core_v1.NodeSpec{ProviderID: "openstack:///" + running.ID},
+		Status:     core_v1.NodeStatus{Conditions: []core_v1.NodeCondition{{Type: core_v1.NodeReady, Status: core_v1.ConditionTrue}}},
+	}
+	pm := &ConcretePoolManager{
+		klusterClient:   klusterClient,
+		nodeObservatory: nodeobservatory.NewFakeController(kluster, node),
+		Kluster:         kluster,
+		Pool:            pool,
+		Logger:          log.NewNopLogger(),
+	}
+
+	status, err := pm.GetStatus()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{running.ID, starting.ID}, status.Nodes)
+	assert.Equal(t, 1, status.Running)
+	assert.Equal(t, 1, status.Starting)
+	assert.Equal(t, 1, status.Needed)
+	assert.Equal(t, 0, status.UnNeeded)
+	assert.Equal(t, 1, status.Healthy)
+	assert.Equal(t, 1, status.Schedulable)
+
+	require.NoError(t, pm.DeleteNode(starting.ID))
+	_, found := cloud.Server(starting.ID)
+	assert.False(t, found)
+	status, err = pm.GetStatus()
+	require.NoError(t, err)
+	assert.Equal(t, []string{running.ID}, status.Nodes)
+	assert.Equal(t, 2, status.Needed)
+
+	require.NoError(t, pm.DeletePool())
+	assert.Empty(t, cloud.ServerGroups("project"))
+}
@@ -0,0 +1,19 @@
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+func init() {
+	prometheus.MustRegister(
+		CertificateExpiry,
+	)
+}
+
+var CertificateExpiry = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: "kubernikus",
+		Subsystem: "certs",
+		Name:      "not_after_timestamp_seconds",
+		Help:      "Expiry (NotAfter) of the kluster's certificates and CAs",
+	},
+	[]string{"kluster", "certificate", "ca"},
+)
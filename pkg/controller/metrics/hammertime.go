@@ -5,6 +5,7 @@ import "github.com/prometheus/client_golang/prometheus"
 func init() {
 	prometheus.MustRegister(
 		HammertimeStatus,
+		HammertimeWarning,
 	)
 	HammertimeStatus.With(prometheus.Labels{"kluster": "dummy-for-absent-metrics-operator"}).Set(0)
 }
@@ -14,7 +15,17 @@ var HammertimeStatus = prometheus.NewGaugeVec(
 		Namespace: "kubernikus",
 		Subsystem: "hammertime",
 		Name:      "status",
-		Help:      "Status of hammertime (controler manager scaled down)",
+		Help:      "Status of hammertime (controler manager scaled down or node eviction paused)",
+	},
+	[]string{"kluster"},
+)
+
+var HammertimeWarning = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: "kubernikus",
+		Subsystem: "hammertime",
+		Name:      "warning",
+		Help:      "Hammertime is in its grace period before pausing node eviction",
 	},
 	[]string{"kluster"},
 )
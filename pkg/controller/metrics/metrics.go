@@ -23,6 +23,7 @@ var klusterPhases = []models.KlusterPhase{
 	models.KlusterPhaseCreating,
 	models.KlusterPhaseRunning,
 	models.KlusterPhaseUpgrading,
+	models.KlusterPhaseRestoring,
 	models.KlusterPhaseTerminating,
 }
 
@@ -0,0 +1,30 @@
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+func init() {
+	prometheus.MustRegister(
+		NodeLabelsUpdatesTotal,
+		NodeLabelsFailedOperationsTotal,
+	)
+}
+
+var NodeLabelsUpdatesTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "kubernikus",
+		Subsystem: "nodelabels",
+		Name:      "updates_total",
+		Help:      "Number of nodes updated to match the labels and taints of their pool",
+	},
+	[]string{"kluster"},
+)
+
+var NodeLabelsFailedOperationsTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "kubernikus",
+		Subsystem: "nodelabels",
+		Name:      "failed_operation_total",
+		Help:      "Number of failed node updates.",
+	},
+	[]string{"kluster"},
+)
@@ -0,0 +1,43 @@
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+func init() {
+	prometheus.MustRegister(
+		OrphanedResources,
+		OrphanedResourcesDeletedTotal,
+		OrphanGCFailedOperationsTotal,
+	)
+
+	OrphanGCFailedOperationsTotal.With(prometheus.Labels{}).Add(0)
+}
+
+var OrphanedResources = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: "kubernikus",
+		Subsystem: "orphangc",
+		Name:      "orphaned_resources",
+		Help:      "Number of orphaned ports and volumes found in the last run",
+	},
+	[]string{"kluster", "kind"},
+)
+
+var OrphanedResourcesDeletedTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "kubernikus",
+		Subsystem: "orphangc",
+		Name:      "deleted_resources_total",
+		Help:      "Number of orphaned ports and volumes deleted",
+	},
+	[]string{"kind"},
+)
+
+var OrphanGCFailedOperationsTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "kubernikus",
+		Subsystem: "orphangc",
+		Name:      "failed_operation_total",
+		Help:      "Number of failed operations.",
+	},
+	[]string{},
+)
@@ -4,22 +4,30 @@ import "github.com/prometheus/client_golang/prometheus"
 
 func init() {
 	prometheus.MustRegister(
+		InvalidRoutes,
 		OrphanedRoutesTotal,
 		RouteGCFailedOperationsTotal,
 	)
-
-	OrphanedRoutesTotal.With(prometheus.Labels{}).Add(0)
-	RouteGCFailedOperationsTotal.With(prometheus.Labels{}).Add(0)
 }
 
+var InvalidRoutes = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: "kubernikus",
+		Subsystem: "routegc",
+		Name:      "invalid_routes",
+		Help:      "Number of orphaned and wrong nexthop routes found in the last run",
+	},
+	[]string{"kluster", "reason"},
+)
+
 var OrphanedRoutesTotal = prometheus.NewCounterVec(
 	prometheus.CounterOpts{
 		Namespace: "kubernikus",
 		Subsystem: "routegc",
 		Name:      "orphaned_routes_total",
-		Help:      "Number of orphaned routes removed from OpenStack router",
+		Help:      "Number of orphaned and wrong nexthop routes removed from OpenStack router",
 	},
-	[]string{},
+	[]string{"kluster", "reason"},
 )
 
 var RouteGCFailedOperationsTotal = prometheus.NewCounterVec(
@@ -29,5 +37,5 @@ var RouteGCFailedOperationsTotal = prometheus.NewCounterVec(
 		Name:      "failed_operation_total",
 		Help:      "Number of failed operations.",
 	},
-	[]string{},
+	[]string{"kluster"},
 )
@@ -0,0 +1,273 @@
+package nodelabels
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-kit/log"
+	core_v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	kube "github.com/sapcc/kubernikus/pkg/client/kubernetes"
+	"github.com/sapcc/kubernikus/pkg/controller/base"
+	"github.com/sapcc/kubernikus/pkg/controller/config"
+	"github.com/sapcc/kubernikus/pkg/controller/events"
+	"github.com/sapcc/kubernikus/pkg/controller/metrics"
+	"github.com/sapcc/kubernikus/pkg/controller/nodeobservatory"
+	"github.com/sapcc/kubernikus/pkg/util"
+)
+
+const (
+	// AnnotationManagedLabels records the label keys applied from the node pool spec
+	AnnotationManagedLabels = "kubernikus.cloud.sap/managed-labels"
+	// AnnotationManagedTaints records the taints (key:effect) applied from the node pool spec
+	AnnotationManagedTaints = "kubernikus.cloud.sap/managed-taints"
+	// NodeLabelsDisableAnnotation disables label and taint reconciliation for a kluster
+	NodeLabelsDisableAnnotation = "kubernikus.cloud.sap/nodelabels"
+)
+
+// Controller keeps the labels and taints of a node pool's members in sync
+// with the pool spec.
+//
+// Labels and taints are initially applied by the kubelet during node
+// registration. Afterwards this controller continuously reconciles them so
+// that changes to the pool spec are applied to existing nodes.
+//
+// Labels and taints added by Kubernikus are tracked via node annotations.
+// Only those are removed when they disappear from the spec, anything added
+// by users is left untouched.
+type Controller struct {
+	nodeObservatory *nodeobservatory.NodeObservatory
+	satellites      kube.SharedClientFactory
+	recorder        record.EventRecorder
+	logger          log.Logger
+}
+
+// New creates a polling controller reconciling node labels and taints
+func New(syncPeriod time.Duration, factories config.Factories, clients config.Clients, recorder record.EventRecorder, logger log.Logger) base.Controller {
+	logger = log.With(logger, "controller", "nodelabels")
+
+	controller := &Controller{
+		nodeObservatory: factories.NodesObservatory.NodeInformer(),
+		satellites:      clients.Satellites,
+		recorder:        recorder,
+		logger:          logger,
+	}
+
+	return base.NewPollingController(syncPeriod, factories.Kubernikus.Kubernikus().V1().Klusters(), controller, logger)
+}
+
+// Reconcile applies the labels and taints of all pools to their member nodes
+func (c *Controller) Reconcile(kluster *v1.Kluster) error {
+	if kluster.Status.Phase != models.KlusterPhaseRunning {
+		return nil
+	}
+	if util.DisabledValue(kluster.Annotations[NodeLabelsDisableAnnotation]) {
+		return nil
+	}
+
+	logger := log.With(c.logger, "kluster", kluster.GetName())
+
+	lister, err := c.nodeObservatory.GetListerForKluster(kluster)
+	if err != nil {
+		return fmt.Errorf("failed to get node lister: %s", err)
+	}
+	nodes, err := lister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("listing nodes failed: %s", err)
+	}
+
+	client, err := c.satellites.ClientFor(kluster)
+	if err != nil {
+		return fmt.Errorf("failed to get client for kluster: %s", err)
+	}
+
+	var errs []string
+	for _, pool := range kluster.Spec.NodePools {
+		for _, node := range nodes {
+			if !util.IsKubernikusNode(node.Name, kluster.Spec.Name, pool.Name) {
+				continue
+			}
+			updated, changed, err := ReconcileNode(node, pool)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("%s: %s", node.Name, err))
+				continue
+			}
+			if !changed {
+				continue
+			}
+			_, err = client.CoreV1().Nodes().Update(context.TODO(), updated, metav1.UpdateOptions{})
+			logger.Log("msg", "updated labels and taints", "node", node.Name, "pool", pool.Name, "err", err)
+			if err != nil {
+				metrics.NodeLabelsFailedOperationsTotal.WithLabelValues(kluster.GetName()).Inc()
+				c.recorder.Eventf(kluster, core_v1.EventTypeWarning, events.FailedUpdateNodeLabels, "Failed to update labels and taints of node %s: %s", node.Name, err)
+				errs = append(errs, fmt.Sprintf("%s: %s", node.Name, err))
+				continue
+			}
+			metrics.NodeLabelsUpdatesTotal.WithLabelValues(kluster.GetName()).Inc()
+			c.recorder.Eventf(kluster, core_v1.EventTypeNormal, events.SuccessfulUpdateNodeLabels, "Updated labels and taints of node %s", node.Name)
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to reconcile nodes: %s", strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// ReconcileNode computes the desired labels and taints of a node for the given pool.
+// It returns an updated copy of the node and whether anything changed.
+func ReconcileNode(node *core_v1.Node, pool models.NodePool) (*core_v1.Node, bool, error) {
+	desiredLabels, err := ParseLabels(pool.Labels)
+	if err != nil {
+		return nil, false, err
+	}
+	desiredTaints, err := ParseTaints(pool.Taints)
+	if err != nil {
+		return nil, false, err
+	}
+
+	updated := node.DeepCopy()
+	if updated.Labels == nil {
+		updated.Labels = map[string]string{}
+	}
+	if updated.Annotations == nil {
+		updated.Annotations = map[string]string{}
+	}
+
+	for _, key := range splitAnnotation(node.Annotations[AnnotationManagedLabels]) {
+		if _, ok := desiredLabels[key]; !ok {
+			delete(updated.Labels, key)
+		}
+	}
+	managedLabels := make([]string, 0, len(desiredLabels))
+	for key, value := range desiredLabels {
+		updated.Labels[key] = value
+		managedLabels = append(managedLabels, key)
+	}
+
+	previousTaints := map[string]bool{}
+	for _, key := range splitAnnotation(node.Annotations[AnnotationManagedTaints]) {
+		previousTaints[key] = true
+	}
+	desiredTaintKeys := map[string]core_v1.Taint{}
+	for _, taint := range desiredTaints {
+		desiredTaintKeys[taintKey(taint)] = taint
+	}
+	taints := make([]core_v1.Taint, 0, len(node.Spec.Taints)+len(desiredTaints))
+	for _, taint := range node.Spec.Taints {
+		key := taintKey(taint)
+		if _, desired := desiredTaintKeys[key]; desired {
+			continue
+		}
+		if previousTaints[key] {
+			continue
+		}
+		taints = append(taints, taint)
+	}
+	managedTaints := make([]string, 0, len(desiredTaints))
+	for _, taint := range desiredTaints {
+		taints = append(taints, taint)
+		managedTaints = append(managedTaints, taintKey(taint))
+	}
+	if len(taints) == 0 {
+		taints = nil
+	}
+	updated.Spec.Taints = taints
+
+	setAnnotation(updated, AnnotationManagedLabels, managedLabels)
+	setAnnotation(updated, AnnotationManagedTaints, managedTaints)
+
+	changed := !equalStringMaps(node.Labels, updated.Labels) ||
+		!equalStringMaps(node.Annotations, updated.Annotations) ||
+		!equalTaints(node.Spec.Taints, updated.Spec.Taints)
+
+	return updated, changed, nil
+}
+
+// ParseLabels parses labels in the form key=value
+func ParseLabels(specs []string) (map[string]string, error) {
+	result := make(map[string]string, len(specs))
+	for _, spec := range specs {
+		parts := strings.SplitN(spec, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid label %q", spec)
+		}
+		result[parts[0]] = parts[1]
+	}
+	return result, nil
+}
+
+// ParseTaints parses taints in the form key=value:effect
+func ParseTaints(specs []string) ([]core_v1.Taint, error) {
+	result := make([]core_v1.Taint, 0, len(specs))
+	for _, spec := range specs {
+		idx := strings.LastIndex(spec, ":")
+		if idx < 0 {
+			return nil, fmt.Errorf("invalid taint %q", spec)
+		}
+		effect := core_v1.TaintEffect(spec[idx+1:])
+		switch effect {
+		case core_v1.TaintEffectNoSchedule, core_v1.TaintEffectNoExecute, core_v1.TaintEffectPreferNoSchedule:
+		default:
+			return nil, fmt.Errorf("invalid taint effect in %q", spec)
+		}
+		parts := strings.SplitN(spec[:idx], "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid taint %q", spec)
+		}
+		result = append(result, core_v1.Taint{Key: parts[0], Value: parts[1], Effect: effect})
+	}
+	return result, nil
+}
+
+func taintKey(taint core_v1.Taint) string {
+	return fmt.Sprintf("%s:%s", taint.Key, taint.Effect)
+}
+
+func splitAnnotation(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+func setAnnotation(node *core_v1.Node, key string, values []string) {
+	if len(values) == 0 {
+		delete(node.Annotations, key)
+		return
+	}
+	sort.Strings(values)
+	node.Annotations[key] = strings.Join(values, ",")
+}
+
+func equalStringMaps(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if bv, ok := b[k]; !ok || bv != v {
+			return false
+		}
+	}
+	return true
+}
+
+func equalTaints(a, b []core_v1.Taint) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Key != b[i].Key || a[i].Value != b[i].Value || a[i].Effect != b[i].Effect {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,121 @@
+package nodelabels
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetes_fake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	kube "github.com/sapcc/kubernikus/pkg/client/kubernetes"
+	"github.com/sapcc/kubernikus/pkg/controller/nodeobservatory"
+)
+
+func newNode(name string, labels, annotations map[string]string, taints ...core_v1.Taint) *core_v1.Node {
+	return &core_v1.Node{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:        name,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Spec: core_v1.NodeSpec{
+			Taints: taints,
+		},
+	}
+}
+
+func TestReconcileNodeAddsLabelsAndTaints(t *testing.T) {
+	node := newNode("node", map[string]string{"user": "label"}, nil, core_v1.Taint{Key: "user", Value: "taint", Effect: core_v1.TaintEffectNoSchedule})
+	pool := models.NodePool{
+		Labels: []string{"pool=a", "zone=b"},
+		Taints: []string{"dedicated=pool:NoExecute"},
+	}
+
+	updated, changed, err := ReconcileNode(node, pool)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, map[string]string{"user": "label", "pool": "a", "zone": "b"}, updated.Labels)
+	assert.Equal(t, []core_v1.Taint{
+		{Key: "user", Value: "taint", Effect: core_v1.TaintEffectNoSchedule},
+		{Key: "dedicated", Value: "pool", Effect: core_v1.TaintEffectNoExecute},
+	}, updated.Spec.Taints)
+	assert.Equal(t, "pool,zone", updated.Annotations[AnnotationManagedLabels])
+	assert.Equal(t, "dedicated:NoExecute", updated.Annotations[AnnotationManagedTaints])
+
+	//reconciling again is a no-op
+	_, changed, err = ReconcileNode(updated, pool)
+	require.NoError(t, err)
+	assert.False(t, changed)
+}
+
+func TestReconcileNodeRemovesManaged(t *testing.T) {
+	node := newNode("node",
+		map[string]string{"user": "label", "pool": "a", "zone": "b"},
+		map[string]string{AnnotationManagedLabels: "pool,zone", AnnotationManagedTaints: "dedicated:NoExecute"},
+		core_v1.Taint{Key: "user", Value: "taint", Effect: core_v1.TaintEffectNoSchedule},
+		core_v1.Taint{Key: "dedicated", Value: "pool", Effect: core_v1.TaintEffectNoExecute},
+	)
+	pool := models.NodePool{
+		Labels: []string{"pool=c"},
+	}
+
+	updated, changed, err := ReconcileNode(node, pool)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, map[string]string{"user": "label", "pool": "c"}, updated.Labels)
+	assert.Equal(t, []core_v1.Taint{{Key: "user", Value: "taint", Effect: core_v1.TaintEffectNoSchedule}}, updated.Spec.Taints)
+	assert.Equal(t, "pool", updated.Annotations[AnnotationManagedLabels])
+	assert.NotContains(t, updated.Annotations, AnnotationManagedTaints)
+}
+
+func TestParseTaints(t *testing.T) {
+	taints, err := ParseTaints([]string{"example.com/key=value:PreferNoSchedule"})
+	require.NoError(t, err)
+	assert.Equal(t, []core_v1.Taint{{Key: "example.com/key", Value: "value", Effect: core_v1.TaintEffectPreferNoSchedule}}, taints)
+
+	_, err = ParseTaints([]string{"key=value:Invalid"})
+	assert.Error(t, err)
+	_, err = ParseTaints([]string{"key:NoSchedule"})
+	assert.Error(t, err)
+}
+
+func TestReconcile(t *testing.T) {
+	kluster := &v1.Kluster{
+		ObjectMeta: meta_v1.ObjectMeta{Namespace: "test", Name: "test"},
+		Spec: models.KlusterSpec{
+			Name: "test",
+			NodePools: []models.NodePool{
+				{Name: "pool", Labels: []string{"pool=a"}},
+			},
+		},
+		Status: models.KlusterStatus{Phase: models.KlusterPhaseRunning},
+	}
+	member := newNode("kks-test-pool-abcde", nil, nil)
+	stranger := newNode("somenode", nil, nil)
+
+	observatory := nodeobservatory.NewFakeController(kluster, member, stranger)
+	clientset := kubernetes_fake.NewSimpleClientset(member, stranger)
+	controller := &Controller{
+		nodeObservatory: observatory,
+		satellites:      &kube.MockSharedClientFactory{Clientset: clientset},
+		recorder:        record.NewFakeRecorder(10),
+		logger:          log.NewNopLogger(),
+	}
+
+	require.NoError(t, controller.Reconcile(kluster))
+
+	node, err := clientset.CoreV1().Nodes().Get(context.Background(), member.Name, meta_v1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "a", node.Labels["pool"])
+
+	node, err = clientset.CoreV1().Nodes().Get(context.Background(), stranger.Name, meta_v1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, node.Labels, "pool")
+}
@@ -27,7 +27,9 @@ import (
 	"github.com/sapcc/kubernikus/pkg/controller/hammertime"
 	"github.com/sapcc/kubernikus/pkg/controller/launch"
 	"github.com/sapcc/kubernikus/pkg/controller/migration"
+	"github.com/sapcc/kubernikus/pkg/controller/nodelabels"
 	"github.com/sapcc/kubernikus/pkg/controller/nodeobservatory"
+	"github.com/sapcc/kubernikus/pkg/controller/orphangc"
 	"github.com/sapcc/kubernikus/pkg/controller/routegc"
 	"github.com/sapcc/kubernikus/pkg/controller/servicing"
 	kubernikus_informers "github.com/sapcc/kubernikus/pkg/generated/informers/externalversions"
@@ -58,6 +60,12 @@ type KubernikusOperatorOptions struct {
 	LogLevel            int
 
 	NodeUpdateHoldoff time.Duration
+	HammertimeTimeout time.Duration
+	OrphanGCDryRun    bool
+	RouteGCDryRun     bool
+
+	// Openstack overrides the openstack client factory, e.g. with an in-memory fake
+	Openstack openstack.SharedOpenstackClientFactory
 }
 
 type KubernikusOperator struct {
@@ -149,9 +157,12 @@ func NewKubernikusOperator(options *KubernikusOperatorOptions, logger log.Logger
 
 	klusters := o.Factories.Kubernikus.Kubernikus().V1().Klusters().Informer()
 
-	if options.AuthURL != "" {
+	switch {
+	case options.Openstack != nil:
+		o.Factories.Openstack = options.Openstack
+	case options.AuthURL != "":
 		o.Factories.Openstack = openstack.NewSharedOpenstackClientFactory(o.Clients.Kubernetes, klusters, adminAuthOptions, logger)
-	} else {
+	default:
 		o.Factories.Openstack = openstack.NotAvailableFactory{}
 	}
 
@@ -186,7 +197,9 @@ func NewKubernikusOperator(options *KubernikusOperatorOptions, logger log.Logger
 		case "launchctl":
 			o.Config.Kubernikus.Controllers["launchctl"] = launch.NewController(10, o.Factories, o.Clients, recorder, o.Config.Images, logger)
 		case "routegc":
-			o.Config.Kubernikus.Controllers["routegc"] = routegc.New(300*time.Second, o.Factories, logger)
+			o.Config.Kubernikus.Controllers["routegc"] = routegc.New(300*time.Second, o.Factories, options.RouteGCDryRun, logger)
+		case "orphangc":
+			o.Config.Kubernikus.Controllers["orphangc"] = orphangc.New(600*time.Second, o.Factories, options.OrphanGCDryRun, logger)
 		case "deorbiter":
 			o.Config.Kubernikus.Controllers["deorbiter"] = deorbit.NewController(10, o.Factories, o.Clients, recorder, logger)
 		case "flight":
@@ -194,11 +207,13 @@ func NewKubernikusOperator(options *KubernikusOperatorOptions, logger log.Logger
 		case "migration":
 			o.Config.Kubernikus.Controllers["migration"] = migration.NewController(3, o.Factories, o.Clients, recorder, logger)
 		case "hammertime":
-			o.Config.Kubernikus.Controllers["hammertime"] = hammertime.New(10*time.Second, 20*time.Second, o.Factories, o.Clients, recorder, logger)
+			o.Config.Kubernikus.Controllers["hammertime"] = hammertime.New(10*time.Second, options.HammertimeTimeout, o.Factories, o.Clients, recorder, logger)
 		case "servicing":
 			o.Config.Kubernikus.Controllers["servicing"] = servicing.NewController(10, o.Factories, o.Clients, recorder, options.NodeUpdateHoldoff, logger)
+		case "nodelabels":
+			o.Config.Kubernikus.Controllers["nodelabels"] = nodelabels.New(60*time.Second, o.Factories, o.Clients, recorder, logger)
 		case "certs":
-			o.Config.Kubernikus.Controllers["certs"] = certs.New(12*time.Hour, o.Factories, o.Config, o.Clients, logger)
+			o.Config.Kubernikus.Controllers["certs"] = certs.New(12*time.Hour, o.Factories, o.Config, o.Clients, recorder, logger)
 		}
 	}
 
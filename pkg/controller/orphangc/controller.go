@@ -0,0 +1,240 @@
+package orphangc
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/attributestags"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	os_client "github.com/sapcc/kubernikus/pkg/client/openstack"
+	"github.com/sapcc/kubernikus/pkg/client/openstack/inventory"
+	"github.com/sapcc/kubernikus/pkg/controller/base"
+	"github.com/sapcc/kubernikus/pkg/controller/config"
+	"github.com/sapcc/kubernikus/pkg/controller/metrics"
+)
+
+// orphanGarbageCollector removes ports and root volumes left behind when
+// nodes are deleted and Nova fails to clean up after them.
+//
+// Nova doesn't mark these resources as belonging to a kluster. So while the
+// nodes are alive, their ports on the kluster network are tagged and their
+// root volumes get a metadata entry. Once the node is gone, everything marked
+// that is no longer attached is considered orphaned.
+type orphanGarbageCollector struct {
+	logger          log.Logger
+	osClientFactory os_client.SharedOpenstackClientFactory
+	dryRun          bool
+}
+
+func New(syncPeriod time.Duration, factories config.Factories, dryRun bool, logger log.Logger) base.Controller {
+
+	logger = log.With(logger, "controller", "orphangc", "dry_run", dryRun)
+
+	orphanGC := orphanGarbageCollector{
+		logger:          logger,
+		osClientFactory: factories.Openstack,
+		dryRun:          dryRun,
+	}
+
+	return base.NewPollingController(syncPeriod, factories.Kubernikus.Kubernikus().V1().Klusters(), &orphanGC, logger)
+}
+
+func (w *orphanGarbageCollector) Reconcile(kluster *v1.Kluster) (err error) {
+
+	//skip klusters not in state Running
+	if kluster.Status.Phase != models.KlusterPhaseRunning {
+		return nil
+	}
+
+	// nodes of klusters without cloudprovider are not managed by us
+	if kluster.Spec.NoCloud {
+		return nil
+	}
+
+	defer func() {
+		if err != nil {
+			metrics.OrphanGCFailedOperationsTotal.With(prometheus.Labels{}).Add(1)
+		}
+	}()
+
+	providerClient, err := w.osClientFactory.ProviderClientForKluster(kluster, w.logger)
+	if err != nil {
+		return err
+	}
+	computeClient, err := openstack.NewComputeV2(providerClient, gophercloud.EndpointOpts{})
+	if err != nil {
+		return fmt.Errorf("failed to setup openstack compute client: %s", err)
+	}
+	networkClient, err := openstack.NewNetworkV2(providerClient, gophercloud.EndpointOpts{})
+	if err != nil {
+		return fmt.Errorf("failed to setup openstack network client: %s", err)
+	}
+	blockStorageClient, err := openstack.NewBlockStorageV3(providerClient, gophercloud.EndpointOpts{})
+	if err != nil {
+		return fmt.Errorf("failed to setup openstack block storage client: %s", err)
+	}
+
+	allPages, err := servers.List(computeClient, servers.ListOpts{}).AllPages()
+	if err != nil {
+		return fmt.Errorf("failed to list servers: %s", err)
+	}
+	allServers, err := servers.ExtractServers(allPages)
+	if err != nil {
+		return fmt.Errorf("failed to extract servers: %s", err)
+	}
+	existingServers := map[string]bool{}
+	nodes := map[string]bool{}
+	for _, server := range allServers {
+		existingServers[server.ID] = true
+		if server.Tags != nil && hasTag(*server.Tags, inventory.NodeResourceTag(kluster)) {
+			nodes[server.ID] = true
+		}
+	}
+
+	logger := log.With(w.logger, "kluster", kluster.GetName(), "project", kluster.Account())
+
+	if err := w.collectPorts(kluster, networkClient, nodes, existingServers, logger); err != nil {
+		return err
+	}
+	return w.collectVolumes(kluster, blockStorageClient, nodes, logger)
+}
+
+func (w *orphanGarbageCollector) collectPorts(kluster *v1.Kluster, client *gophercloud.ServiceClient, nodes, existingServers map[string]bool, logger log.Logger) error {
+	tag := inventory.NodeResourceTag(kluster)
+
+	allPages, err := ports.List(client, ports.ListOpts{NetworkID: kluster.Spec.Openstack.NetworkID}).AllPages()
+	if err != nil {
+		return fmt.Errorf("failed to list ports: %s", err)
+	}
+	allPorts, err := ports.ExtractPorts(allPages)
+	if err != nil {
+		return fmt.Errorf("failed to extract ports: %s", err)
+	}
+
+	orphaned := 0
+	for _, port := range allPorts {
+		if nodes[port.DeviceID] {
+			if !hasTag(port.Tags, tag) {
+				if err := attributestags.Add(client, "ports", port.ID, tag).ExtractErr(); err != nil {
+					return fmt.Errorf("failed to tag port %s: %s", port.ID, err)
+				}
+			}
+			continue
+		}
+		if !hasTag(port.Tags, tag) || !isComputePort(port) {
+			continue
+		}
+		if port.DeviceID != "" && existingServers[port.DeviceID] {
+			continue
+		}
+
+		orphaned++
+		logger.Log("msg", "port orphaned", "port", port.ID, "address", fixedIPs(port), "device", port.DeviceID)
+		if w.dryRun {
+			continue
+		}
+		if err := ports.Delete(client, port.ID).ExtractErr(); err != nil {
+			return fmt.Errorf("failed to delete port %s: %s", port.ID, err)
+		}
+		metrics.OrphanedResourcesDeletedTotal.With(prometheus.Labels{"kind": "port"}).Add(1)
+		logger.Log("msg", "deleted port", "port", port.ID)
+	}
+	metrics.OrphanedResources.With(prometheus.Labels{"kluster": kluster.GetName(), "kind": "port"}).Set(float64(orphaned))
+
+	return nil
+}
+
+func (w *orphanGarbageCollector) collectVolumes(kluster *v1.Kluster, client *gophercloud.ServiceClient, nodes map[string]bool, logger log.Logger) error {
+	allPages, err := volumes.List(client, volumes.ListOpts{}).AllPages()
+	if err != nil {
+		return fmt.Errorf("failed to list volumes: %s", err)
+	}
+	allVolumes, err := volumes.ExtractVolumes(allPages)
+	if err != nil {
+		return fmt.Errorf("failed to extract volumes: %s", err)
+	}
+
+	orphaned := 0
+	for _, volume := range allVolumes {
+		if isRootVolumeOf(volume, nodes) {
+			if volume.Metadata[inventory.NodeResourceMarker] != kluster.Spec.Name {
+				metadata := map[string]string{inventory.NodeResourceMarker: kluster.Spec.Name}
+				for key, value := range volume.Metadata {
+					metadata[key] = value
+				}
+				if _, err := volumes.Update(client, volume.ID, volumes.UpdateOpts{Metadata: metadata}).Extract(); err != nil {
+					return fmt.Errorf("failed to mark volume %s: %s", volume.ID, err)
+				}
+			}
+			continue
+		}
+		if volume.Metadata[inventory.NodeResourceMarker] != kluster.Spec.Name {
+			continue
+		}
+		if volume.Status != "available" || len(volume.Attachments) > 0 {
+			continue
+		}
+
+		orphaned++
+		logger.Log("msg", "volume orphaned", "volume", volume.ID, "name", volume.Name)
+		if w.dryRun {
+			continue
+		}
+		if err := volumes.Delete(client, volume.ID, volumes.DeleteOpts{}).ExtractErr(); err != nil {
+			return fmt.Errorf("failed to delete volume %s: %s", volume.ID, err)
+		}
+		metrics.OrphanedResourcesDeletedTotal.With(prometheus.Labels{"kind": "volume"}).Add(1)
+		logger.Log("msg", "deleted volume", "volume", volume.ID)
+	}
+	metrics.OrphanedResources.With(prometheus.Labels{"kluster": kluster.GetName(), "kind": "volume"}).Set(float64(orphaned))
+
+	return nil
+}
+
+// isRootVolumeOf reports whether the volume is a boot volume attached to one
+// of the given servers. Persistent volumes of the kluster are left alone,
+// they are managed by the Cinder CSI driver.
+func isRootVolumeOf(volume volumes.Volume, servers map[string]bool) bool {
+	if volume.Bootable != "true" || volume.Metadata[inventory.CinderCSIClusterMetadata] != "" {
+		return false
+	}
+	for _, attachment := range volume.Attachments {
+		if servers[attachment.ServerID] {
+			return true
+		}
+	}
+	return false
+}
+
+// isComputePort filters out ports of routers, load balancers and DHCP
+// agents that might have been tagged by mistake
+func isComputePort(port ports.Port) bool {
+	return port.DeviceOwner == "" || strings.HasPrefix(port.DeviceOwner, "compute:")
+}
+
+func fixedIPs(port ports.Port) string {
+	addresses := make([]string, 0, len(port.FixedIPs))
+	for _, ip := range port.FixedIPs {
+		addresses = append(addresses, ip.IPAddress)
+	}
+	return strings.Join(addresses, ",")
+}
+
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+package orphangc
+
+import (
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	openstack_fake "github.com/sapcc/kubernikus/pkg/client/openstack/fake"
+	"github.com/sapcc/kubernikus/pkg/client/openstack/inventory"
+	"github.com/sapcc/kubernikus/pkg/controller/metrics"
+)
+
+func TestReconcileRemovesOrphanedPortsAndVolumes(t *testing.T) {
+	cloud := openstack_fake.NewCloud()
+	defer cloud.Close()
+	cloud.AddProject("project", "project", openstack_fake.DefaultDomain)
+
+	kluster := &v1.Kluster{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "test-project", Labels: map[string]string{"account": "project"}},
+		Spec: models.KlusterSpec{
+			Name:      "test",
+			Openstack: models.OpenstackSpec{NetworkID: "network"},
+		},
+		Status: models.KlusterStatus{Phase: models.KlusterPhaseRunning},
+	}
+	tag := inventory.NodeResourceTag(kluster)
+
+	node := cloud.AddServer(openstack_fake.Server{ProjectID: "project", Name: "kks-test-pool-abcde", NetworkID: "network", Tags: []string{"kubernikus", tag}})
+	other := cloud.AddServer(openstack_fake.Server{ProjectID: "project", Name: "other", NetworkID: "network"})
+	rootVolume := cloud.AddVolume(openstack_fake.Volume{ProjectID: "project", Name: "root", Bootable: true, AttachedTo: node.ID})
+	pvcVolume := cloud.AddVolume(openstack_fake.Volume{ProjectID: "project", Name: "pvc", AttachedTo: node.ID, Metadata: map[string]string{inventory.CinderCSIClusterMetadata: kluster.GetName()}})
+	otherRootVolume := cloud.AddVolume(openstack_fake.Volume{ProjectID: "project", Name: "other-root", Bootable: true, AttachedTo: other.ID})
+
+	orphanedPort := cloud.AddPort(openstack_fake.Port{ProjectID: "project", NetworkID: "network", DeviceID: "gone", DeviceOwner: "compute:az", Tags: []string{tag}})
+	detachedPort := cloud.AddPort(openstack_fake.Port{ProjectID: "project", NetworkID: "network", Tags: []string{tag}})
+	userPort := cloud.AddPort(openstack_fake.Port{ProjectID: "project", NetworkID: "network"})
+	routerPort := cloud.AddPort(openstack_fake.Port{ProjectID: "project", NetworkID: "network", DeviceID: "router", DeviceOwner: "network:router_interface", Tags: []string{tag}})
+	cloud.AddVolume(openstack_fake.Volume{ProjectID: "project", Name: "orphaned", Bootable: true, Metadata: map[string]string{inventory.NodeResourceMarker: "test"}})
+	otherKlusterVolume := cloud.AddVolume(openstack_fake.Volume{ProjectID: "project", Name: "other-kluster", Bootable: true, Metadata: map[string]string{inventory.NodeResourceMarker: "other"}})
+
+	gc := &orphanGarbageCollector{
+		logger:          log.NewNopLogger(),
+		osClientFactory: openstack_fake.NewFactory(cloud),
+		dryRun:          true,
+	}
+
+	// A dry run only marks the resources of live nodes
+	require.NoError(t, gc.Reconcile(kluster))
+	assert.Len(t, cloud.Ports("project"), 6)
+	assert.Len(t, cloud.Volumes("project"), 5)
+	assert.Equal(t, float64(2), testutil.ToFloat64(metrics.OrphanedResources.With(prometheus.Labels{"kluster": kluster.GetName(), "kind": "port"})))
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.OrphanedResources.With(prometheus.Labels{"kluster": kluster.GetName(), "kind": "volume"})))
+	for _, port := range cloud.Ports("project") {
+		switch port.DeviceID {
+		case node.ID:
+			assert.Contains(t, port.Tags, tag, "port of node should be tagged")
+		case other.ID:
+			assert.NotContains(t, port.Tags, tag, "port of foreign server must not be tagged")
+		}
+	}
+	for _, volume := range cloud.Volumes("project") {
+		switch volume.ID {
+		case rootVolume.ID:
+			assert.Equal(t, "test", volume.Metadata[inventory.NodeResourceMarker])
+		case pvcVolume.ID, otherRootVolume.ID:
+			assert.Empty(t, volume.Metadata[inventory.NodeResourceMarker])
+		}
+	}
+
+	gc.dryRun = false
+	require.NoError(t, gc.Reconcile(kluster))
+	portIDs := []string{}
+	for _, port := range cloud.Ports("project") {
+		portIDs = append(portIDs, port.ID)
+	}
+	assert.NotContains(t, portIDs, orphanedPort.ID)
+	assert.NotContains(t, portIDs, detachedPort.ID)
+	assert.Contains(t, portIDs, userPort.ID)
+	assert.Contains(t, portIDs, routerPort.ID)
+	volumeIDs := []string{}
+	for _, volume := range cloud.Volumes("project") {
+		volumeIDs = append(volumeIDs, volume.ID)
+	}
+	assert.ElementsMatch(t, []string{rootVolume.ID, pvcVolume.ID, otherRootVolume.ID, otherKlusterVolume.ID}, volumeIDs)
+
+	// The root volume is left behind when the node is deleted
+	require.NoError(t, cloud.DeleteServer(node.ID))
+	require.NoError(t, gc.Reconcile(kluster))
+	volumeIDs = []string{}
+	for _, volume := range cloud.Volumes("project") {
+		volumeIDs = append(volumeIDs, volume.ID)
+	}
+	assert.NotContains(t, volumeIDs, rootVolume.ID)
+	assert.Contains(t, volumeIDs, pvcVolume.ID)
+}
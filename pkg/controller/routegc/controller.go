@@ -12,32 +12,45 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
 	"github.com/gophercloud/gophercloud/pagination"
 	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/sapcc/kubernikus/pkg/api/models"
 	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
 	os_client "github.com/sapcc/kubernikus/pkg/client/openstack"
+	"github.com/sapcc/kubernikus/pkg/client/openstack/inventory"
 	"github.com/sapcc/kubernikus/pkg/controller/base"
 	"github.com/sapcc/kubernikus/pkg/controller/config"
 	"github.com/sapcc/kubernikus/pkg/controller/metrics"
+	"github.com/sapcc/kubernikus/pkg/controller/nodeobservatory"
 )
 
 type routeGarbageCollector struct {
 	logger          log.Logger
 	osClientFactory os_client.SharedOpenstackClientFactory
+	nodeObservatory *nodeobservatory.NodeObservatory
+	dryRun          bool
 }
 
-func New(syncPeriod time.Duration, factories config.Factories, logger log.Logger) base.Controller {
+func New(syncPeriod time.Duration, factories config.Factories, dryRun bool, logger log.Logger) base.Controller {
 
 	logger = log.With(logger, "controller", "routegc")
 
 	routeGC := routeGarbageCollector{
 		logger:          logger,
 		osClientFactory: factories.Openstack,
+		nodeObservatory: factories.NodesObservatory.NodeInformer(),
+		dryRun:          dryRun,
 	}
 
 	return base.NewPollingController(syncPeriod, factories.Kubernikus.Kubernikus().V1().Klusters(), &routeGC, logger)
 }
 
+// Reconcile removes invalid routes within the kluster's clusterCIDR from its router.
+//
+// Only the kluster's own servers (found by the tag set by flight) are valid
+// nexthops. A route is orphaned if its nexthop isn't one of them or if it
+// doesn't match the PodCIDR of the node behind the nexthop. A route for a
+// node's PodCIDR pointing to another server has a wrong nexthop.
 func (w *routeGarbageCollector) Reconcile(kluster *v1.Kluster) (err error) {
 
 	//skip klusters not in state Running
@@ -53,7 +66,7 @@ func (w *routeGarbageCollector) Reconcile(kluster *v1.Kluster) (err error) {
 	routerID := kluster.Spec.Openstack.RouterID
 	defer func(begin time.Time) {
 		if err != nil {
-			metrics.RouteGCFailedOperationsTotal.With(prometheus.Labels{}).Add(1)
+			metrics.RouteGCFailedOperationsTotal.With(prometheus.Labels{"kluster": kluster.GetName()}).Add(1)
 		}
 	}(time.Now())
 
@@ -78,6 +91,7 @@ func (w *routeGarbageCollector) Reconcile(kluster *v1.Kluster) (err error) {
 	}
 
 	if len(router.Routes) == 0 {
+		setInvalidRoutes(kluster, 0, 0)
 		return nil
 	}
 
@@ -86,8 +100,11 @@ func (w *routeGarbageCollector) Reconcile(kluster *v1.Kluster) (err error) {
 		return fmt.Errorf("failed to setup openstack compute client: %s", err)
 	}
 
+	// nexthop address -> server name
 	validNexthops := map[string]string{}
-	err = foreachServer(computeClient, servers.ListOpts{}, func(srv *servers.Server) (bool, error) {
+	// server name -> addresses
+	serverAddresses := map[string][]string{}
+	err = foreachServer(computeClient, servers.ListOpts{Tags: inventory.NodeResourceTag(kluster)}, func(srv *servers.Server) (bool, error) {
 		for _, addrs := range srv.Addresses {
 			for _, nase := range addrs.([]interface{}) {
 				addresses, ok := nase.(map[string]interface{})
@@ -98,7 +115,8 @@ func (w *routeGarbageCollector) Reconcile(kluster *v1.Kluster) (err error) {
 				if !ok {
 					continue
 				}
-				validNexthops[addr.(string)] = srv.ID
+				validNexthops[addr.(string)] = srv.Name
+				serverAddresses[srv.Name] = append(serverAddresses[srv.Name], addr.(string))
 			}
 		}
 		return true, nil
@@ -107,34 +125,77 @@ func (w *routeGarbageCollector) Reconcile(kluster *v1.Kluster) (err error) {
 		return fmt.Errorf("failed to list servers: %s", err)
 	}
 
-	logger := log.With(w.logger, "router", routerID)
+	lister, err := w.nodeObservatory.GetListerForKluster(kluster)
+	if err != nil {
+		return fmt.Errorf("failed to get node lister: %s", err)
+	}
+	nodes, err := lister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list nodes: %s", err)
+	}
 
+	logger := log.With(w.logger, "router", routerID, "kluster", kluster.GetName())
+
+	// server name -> PodCIDR, PodCIDR -> server name
+	podCIDRs := map[string]string{}
+	podCIDROwners := map[string]string{}
+	for _, node := range nodes {
+		if node.Spec.PodCIDR == "" {
+			continue
+		}
+		if _, ok := serverAddresses[node.Name]; !ok {
+			// Without the node's server we can't tell valid from invalid routes
+			logger.Log("msg", "skipping, no tagged server found for node", "node", node.Name, "v", 2)
+			return nil
+		}
+		podCIDRs[node.Name] = node.Spec.PodCIDR
+		podCIDROwners[node.Spec.PodCIDR] = node.Name
+	}
+
+	orphaned, wrongNexthop := 0, 0
 	newRoutes := make([]routers.Route, 0, len(router.Routes))
 	for _, route := range router.Routes {
 		if isResponsibleForRoute(clusterCIDR, route) {
-			if _, ok := validNexthops[route.NextHop]; !ok {
-				logger.Log("msg", "route orphaned", "cidr", route.DestinationCIDR, "nexthop", route.NextHop)
+			server, valid := validNexthops[route.NextHop]
+			if podCIDR, registered := podCIDRs[server]; valid && registered && podCIDR != route.DestinationCIDR {
+				valid = false
+			}
+			if !valid {
+				if owner, ok := podCIDROwners[route.DestinationCIDR]; ok {
+					logger.Log("msg", "route has wrong nexthop", "cidr", route.DestinationCIDR, "nexthop", route.NextHop, "node", owner, "dry_run", w.dryRun)
+					wrongNexthop++
+				} else {
+					logger.Log("msg", "route orphaned", "cidr", route.DestinationCIDR, "nexthop", route.NextHop, "dry_run", w.dryRun)
+					orphaned++
+				}
 				continue //delete the route (by not adding to newRoutes)
 			}
 		}
 		newRoutes = append(newRoutes, route)
 	}
+	setInvalidRoutes(kluster, orphaned, wrongNexthop)
 
 	//something was changed, update the router
-	if len(newRoutes) < len(router.Routes) {
+	if len(newRoutes) < len(router.Routes) && !w.dryRun {
 		_, err := routers.Update(networkClient, routerID, routers.UpdateOpts{
 			Routes: &newRoutes,
 		}).Extract()
 		if err != nil {
 			return fmt.Errorf("failed to remove routes: %s", err)
 		}
-		metrics.OrphanedRoutesTotal.With(prometheus.Labels{}).Add(float64(len(router.Routes) - len(newRoutes)))
+		metrics.OrphanedRoutesTotal.With(prometheus.Labels{"kluster": kluster.GetName(), "reason": "orphaned"}).Add(float64(orphaned))
+		metrics.OrphanedRoutesTotal.With(prometheus.Labels{"kluster": kluster.GetName(), "reason": "wrong_nexthop"}).Add(float64(wrongNexthop))
 		logger.Log("msg", "removed routes")
 	}
 	return nil
 
 }
 
+func setInvalidRoutes(kluster *v1.Kluster, orphaned, wrongNexthop int) {
+	metrics.InvalidRoutes.With(prometheus.Labels{"kluster": kluster.GetName(), "reason": "orphaned"}).Set(float64(orphaned))
+	metrics.InvalidRoutes.With(prometheus.Labels{"kluster": kluster.GetName(), "reason": "wrong_nexthop"}).Set(float64(wrongNexthop))
+}
+
 // adapted from  k8s.io/pkg/controller/route
 func isResponsibleForRoute(clusterCIDR *net.IPNet, route routers.Route) bool {
 	_, cidr, err := net.ParseCIDR(route.DestinationCIDR)
@@ -0,0 +1,97 @@
+package routegc
+
+import (
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	openstack_fake "github.com/sapcc/kubernikus/pkg/client/openstack/fake"
+	"github.com/sapcc/kubernikus/pkg/controller/metrics"
+	"github.com/sapcc/kubernikus/pkg/controller/nodeobservatory"
+)
+
+func newNode(name, podCIDR string) *core_v1.Node {
+	return &core_v1.Node{
+		ObjectMeta: meta_v1.ObjectMeta{Name: name},
+		Spec:       core_v1.NodeSpec{PodCIDR: podCIDR},
+	}
+}
+
+func setup(t *testing.T, dryRun bool) (*openstack_fake.Cloud, *routeGarbageCollector, *v1.Kluster, string, []openstack_fake.Route) {
+	cloud := openstack_fake.NewCloud()
+	t.Cleanup(cloud.Close)
+	cloud.AddProject("project", "project", openstack_fake.DefaultDomain)
+	router := cloud.Routers("project")[0]
+	tags := []string{"kubernikus", "kubernikus:kluster=test", "kubernikus:nodepool=pool"}
+	node1 := cloud.AddServer(openstack_fake.Server{ProjectID: "project", Name: "kks-test-pool-abcde", Tags: tags})
+	node2 := cloud.AddServer(openstack_fake.Server{ProjectID: "project", Name: "kks-test-pool-fghij", Tags: tags})
+	sibling := cloud.AddServer(openstack_fake.Server{ProjectID: "project", Name: "kks-other-pool-abcde", Tags: []string{"kubernikus", "kubernikus:kluster=other"}})
+
+	routes := []openstack_fake.Route{
+		{DestinationCIDR: "100.100.0.0/24", NextHop: node1.Address},
+		{DestinationCIDR: "100.100.1.0/24", NextHop: node2.Address},
+		{DestinationCIDR: "100.100.1.0/24", NextHop: node1.Address},   // wrong nexthop
+		{DestinationCIDR: "100.100.2.0/24", NextHop: "10.180.1.1"},    // orphaned
+		{DestinationCIDR: "100.100.3.0/24", NextHop: sibling.Address}, // orphaned, sibling kluster
+		{DestinationCIDR: "192.168.0.0/24", NextHop: "10.180.1.1"},
+	}
+	require.NoError(t, cloud.SetRoutes(router.ID, routes))
+
+	clusterCIDR := "100.100.0.0/16"
+	kluster := &v1.Kluster{
+		ObjectMeta: meta_v1.ObjectMeta{Namespace: "test", Name: "test", Labels: map[string]string{"account": "project"}},
+		Spec: models.KlusterSpec{
+			Name:        "test",
+			ClusterCIDR: &clusterCIDR,
+			Openstack:   models.OpenstackSpec{RouterID: router.ID},
+		},
+		Status: models.KlusterStatus{Phase: models.KlusterPhaseRunning},
+	}
+
+	gc := &routeGarbageCollector{
+		logger:          log.NewNopLogger(),
+		osClientFactory: openstack_fake.NewFactory(cloud),
+		nodeObservatory: nodeobservatory.NewFakeController(kluster,
+			newNode(node1.Name, "100.100.0.0/24"),
+			newNode(node2.Name, "100.100.1.0/24"),
+		),
+		dryRun: dryRun,
+	}
+	return cloud, gc, kluster, router.ID, routes
+}
+
+func TestReconcileRemovesInvalidRoutes(t *testing.T) {
+	cloud, gc, kluster, routerID, routes := setup(t, false)
+	require.NoError(t, gc.Reconcile(kluster))
+
+	router, _ := cloud.Router(routerID)
+	assert.Equal(t, []openstack_fake.Route{routes[0], routes[1], routes[5]}, router.Routes)
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.InvalidRoutes.WithLabelValues("test", "orphaned")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.InvalidRoutes.WithLabelValues("test", "wrong_nexthop")))
+}
+
+func TestReconcileDryRun(t *testing.T) {
+	cloud, gc, kluster, routerID, routes := setup(t, true)
+	require.NoError(t, gc.Reconcile(kluster))
+
+	router, _ := cloud.Router(routerID)
+	assert.Equal(t, routes, router.Routes)
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.InvalidRoutes.WithLabelValues("test", "orphaned")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.InvalidRoutes.WithLabelValues("test", "wrong_nexthop")))
+}
+
+func TestReconcileSkipsUntaggedNodes(t *testing.T) {
+	cloud, gc, kluster, routerID, routes := setup(t, false)
+	gc.nodeObservatory = nodeobservatory.NewFakeController(kluster, newNode("kks-test-pool-untagged", "100.100.4.0/24"))
+	require.NoError(t, gc.Reconcile(kluster))
+
+	router, _ := cloud.Router(routerID)
+	assert.Equal(t, routes, router.Routes)
+}
@@ -34,6 +34,7 @@ func CreateEtcdBackupStorageContainer(rawKluster []byte, current *v1.Kluster, cl
 
 	err = adminClient.CreateStorageContainer(
 		current.Account(),
+		"",
 		etcd_util.DefaultStorageContainer(current),
 		string(username),
 		string(domain),
@@ -0,0 +1,18 @@
+package migration
+
+import (
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+	"github.com/sapcc/kubernikus/pkg/controller/config"
+)
+
+// MoveTerminationProtectionToSpec turns the termination protection
+// annotation into the spec field so it is visible to API users
+func MoveTerminationProtectionToSpec(rawKluster []byte, current *v1.Kluster, clients config.Clients, factories config.Factories) (err error) {
+	if current.Annotations[v1.TerminationProtectionAnnotationKey] == "" {
+		return
+	}
+	enabled := true
+	current.Spec.TerminationProtection = &enabled
+	delete(current.Annotations, v1.TerminationProtectionAnnotationKey)
+	return
+}
@@ -4,7 +4,7 @@ package migration
 // IMPORTANT: Don't remove migrations, don't reorder them!
 // The position in the migrations slice is used for versioning,
 // so the only thing that is sane is to append migrations
-// to the end of the slice
+// to the end of the slice.
 func init() {
 	defaultRegistry.migrations = []Migration{
 		Init,
@@ -29,6 +29,7 @@ func init() {
 		KlusterSecretOpenStackIds,
 		Helm2to3,
 		KlusterSecretProjectName,
+		MoveTerminationProtectionToSpec,
 		// <-- Insert new migrations at the end only!
 	}
 }
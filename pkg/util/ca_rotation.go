@@ -0,0 +1,212 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	certutil "k8s.io/client-go/util/cert"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+)
+
+// A CA rotation replaces CAs of a kluster in three phases without interrupting it:
+//
+//   - TrustNew: new CAs are created and appended to the trust bundles (the
+//     CA certificate fields). The old CAs keep signing.
+//   - Reissue: the new CAs are moved to the front of the bundles and sign
+//     from now on. All leaf certificates are reissued.
+//   - RemoveOld: the old CAs are dropped from the trust bundles.
+//
+// The CA certificate fields are PEM bundles, the first certificate always
+// belongs to the private key stored next to it.
+
+type caFields struct {
+	name string
+	cert *string
+	key  *string
+}
+
+// NotRotatableCAs can't be rotated without interruption: the apiserver clients
+// CA key also signs the service account tokens.
+var NotRotatableCAs = map[string]string{
+	"apiserver-clients": "its key signs service account tokens",
+}
+
+func rotatableCAs(store *v1.Certificates) map[string]caFields {
+	return map[string]caFields{
+		"tls-etcd":        {"TLSEtcd", &store.TLSEtcdCACertificate, &store.TLSEtcdCAPrivateKey},
+		"etcd-clients":    {"Etcd Clients", &store.EtcdClientsCACertificate, &store.EtcdClientsCAPrivateKey},
+		"etcd-peers":      {"Etcd Peers", &store.EtcdPeersCACertificate, &store.EtcdPeersCAPrivateKey},
+		"apiserver-nodes": {"ApiServer Nodes", &store.ApiserverNodesCACertificate, &store.ApiserverNodesCAPrivateKey},
+		"kubelet-clients": {"Kubelet Clients", &store.KubeletClientsCACertificate, &store.KubeletClientsCAPrivateKey},
+		"tls":             {"TLS", &store.TLSCACertificate, &store.TLSCAPrivateKey},
+		"aggregation":     {"Aggregation", &store.AggregationCACertificate, &store.AggregationCAPrivateKey},
+		"admission":       {"Admission", &store.AdmissionCACertificate, &store.AdmissionCAPrivateKey},
+	}
+}
+
+// RotatableCAs returns the sorted names of all CAs that can be rotated
+func RotatableCAs() []string {
+	names := []string{}
+	for name := range rotatableCAs(&v1.Certificates{}) {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ValidateCARotation checks that all given CAs can be rotated
+func ValidateCARotation(names []string) error {
+	fields := rotatableCAs(&v1.Certificates{})
+	for _, name := range names {
+		if reason, found := NotRotatableCAs[name]; found {
+			return fmt.Errorf("CA %s can't be rotated: %s", name, reason)
+		}
+		if _, found := fields[name]; !found {
+			return fmt.Errorf("unknown CA %s, valid CAs are: %s", name, strings.Join(RotatableCAs(), ", "))
+		}
+	}
+	return nil
+}
+
+// NextCARotationPhase returns the phase following the given one
+func NextCARotationPhase(phase models.CARotationPhase) models.CARotationPhase {
+	switch phase {
+	case models.CARotationPhaseTrustNew:
+		return models.CARotationPhaseReissue
+	case models.CARotationPhaseReissue:
+		return models.CARotationPhaseRemoveOld
+	default:
+		return models.CARotationPhaseCompleted
+	}
+}
+
+// CATrustAdded tells if AddCATrust was already applied to the store
+func CATrustAdded(store *v1.Certificates, names []string) (bool, error) {
+	keys, err := pendingCAKeys(store)
+	if err != nil {
+		return false, err
+	}
+	for _, name := range names {
+		if _, found := keys[name]; !found {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// AddCATrust creates new CAs and appends them to the trust bundles of the
+// given CAs. Their keys are kept aside until SwitchCASigning.
+func AddCATrust(kluster *v1.Kluster, store *v1.Certificates, names []string) error {
+	keys, err := pendingCAKeys(store)
+	if err != nil {
+		return err
+	}
+	fields := rotatableCAs(store)
+	for _, name := range names {
+		if _, found := keys[name]; found {
+			continue
+		}
+		ca := fields[name]
+		bundle, err := createCA(kluster.Name, ca.name, caKeyAlgorithm(kluster, ca.name), nil, nil)
+		if err != nil {
+			return err
+		}
+		key, err := EncodePrivateKeyPEM(bundle.PrivateKey)
+		if err != nil {
+			return err
+		}
+		*ca.cert = strings.TrimSuffix(*ca.cert, "\n") + "\n" + string(EncodeCertPEM(bundle.Certificate))
+		keys[name] = string(key)
+	}
+	return setPendingCAKeys(store, keys)
+}
+
+// SwitchCASigning makes the new CAs sign. Leaf certificates of the old CAs
+// are reissued by the next CertificateFactory.Ensure.
+func SwitchCASigning(store *v1.Certificates, names []string) error {
+	keys, err := pendingCAKeys(store)
+	if err != nil {
+		return err
+	}
+	fields := rotatableCAs(store)
+	for _, name := range names {
+		key, found := keys[name]
+		if !found {
+			continue
+		}
+		ca := fields[name]
+		certificates, err := certutil.ParseCertsPEM([]byte(*ca.cert))
+		if err != nil {
+			return fmt.Errorf("failed to parse %s CA bundle: %s", name, err)
+		}
+		if len(certificates) < 2 {
+			return fmt.Errorf("%s CA bundle doesn't contain the new CA", name)
+		}
+		// the new CA is the last one in the bundle
+		bundle := EncodeCertPEM(certificates[len(certificates)-1])
+		for _, c := range certificates[:len(certificates)-1] {
+			bundle = append(bundle, EncodeCertPEM(c)...)
+		}
+		*ca.cert = string(bundle)
+		*ca.key = key
+		delete(keys, name)
+	}
+	return setPendingCAKeys(store, keys)
+}
+
+// OldCAsRemoved tells if RemoveOldCAs was already applied to the store
+func OldCAsRemoved(store *v1.Certificates, names []string) (bool, error) {
+	fields := rotatableCAs(store)
+	for _, name := range names {
+		certificates, err := certutil.ParseCertsPEM([]byte(*fields[name].cert))
+		if err != nil {
+			return false, fmt.Errorf("failed to parse %s CA bundle: %s", name, err)
+		}
+		if len(certificates) > 1 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// RemoveOldCAs drops everything but the signing CA from the trust bundles
+func RemoveOldCAs(store *v1.Certificates, names []string) error {
+	fields := rotatableCAs(store)
+	for _, name := range names {
+		ca := fields[name]
+		certificates, err := certutil.ParseCertsPEM([]byte(*ca.cert))
+		if err != nil {
+			return fmt.Errorf("failed to parse %s CA bundle: %s", name, err)
+		}
+		*ca.cert = string(EncodeCertPEM(certificates[0]))
+	}
+	return nil
+}
+
+func pendingCAKeys(store *v1.Certificates) (map[string]string, error) {
+	keys := map[string]string{}
+	if store.CARotationPrivateKeys == "" {
+		return keys, nil
+	}
+	if err := json.Unmarshal([]byte(store.CARotationPrivateKeys), &keys); err != nil {
+		return nil, fmt.Errorf("failed to parse pending CA keys: %s", err)
+	}
+	return keys, nil
+}
+
+func setPendingCAKeys(store *v1.Certificates, keys map[string]string) error {
+	if len(keys) == 0 {
+		store.CARotationPrivateKeys = ""
+		return nil
+	}
+	data, err := json.Marshal(keys)
+	if err != nil {
+		return err
+	}
+	store.CARotationPrivateKeys = string(data)
+	return nil
+}
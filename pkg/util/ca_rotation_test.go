@@ -0,0 +1,87 @@
+package util
+
+import (
+	"crypto/x509"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	certutil "k8s.io/client-go/util/cert"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+)
+
+func TestValidateCARotation(t *testing.T) {
+	assert.NoError(t, ValidateCARotation(RotatableCAs()))
+	assert.Error(t, ValidateCARotation([]string{"apiserver-clients"}))
+	assert.Error(t, ValidateCARotation([]string{"unknown"}))
+}
+
+func TestCARotation(t *testing.T) {
+	kluster := &v1.Kluster{ObjectMeta: metav1.ObjectMeta{Name: "test"}, Spec: models.KlusterSpec{AdvertiseAddress: "1.1.1.1", ServiceCIDR: "192.168.0.0/24"}}
+	store := new(v1.Certificates)
+	_, err := NewCertificateFactory(kluster, store, "test.local").Ensure()
+	require.NoError(t, err)
+
+	names := []string{"tls"}
+	oldCA := parseBundle(t, store.TLSCACertificate)
+	require.Len(t, oldCA, 1)
+	oldKey := store.TLSCAPrivateKey
+
+	// TrustNew
+	require.NoError(t, AddCATrust(kluster, store, names))
+	added, err := CATrustAdded(store, names)
+	require.NoError(t, err)
+	assert.True(t, added)
+	trusted := parseBundle(t, store.TLSCACertificate)
+	require.Len(t, trusted, 2)
+	assert.Equal(t, oldCA[0].Raw, trusted[0].Raw)
+	assert.Equal(t, oldKey, store.TLSCAPrivateKey)
+	_, err = ParseCertificates(store)
+	assert.NoError(t, err, "pending keys must not be parsed as certificates")
+
+	// applying it again doesn't create another CA
+	require.NoError(t, AddCATrust(kluster, store, names))
+	assert.Len(t, parseBundle(t, store.TLSCACertificate), 2)
+
+	// Reissue
+	require.NoError(t, SwitchCASigning(store, names))
+	assert.Empty(t, store.CARotationPrivateKeys)
+	switched := parseBundle(t, store.TLSCACertificate)
+	require.Len(t, switched, 2)
+	assert.Equal(t, trusted[1].Raw, switched[0].Raw)
+	assert.NotEqual(t, oldKey, store.TLSCAPrivateKey)
+	_, err = NewBundle([]byte(store.TLSCAPrivateKey), []byte(store.TLSCACertificate))
+	require.NoError(t, err)
+
+	updates, err := NewCertificateFactory(kluster, store, "test.local").Ensure()
+	require.NoError(t, err)
+	assert.NotEmpty(t, updates)
+	apiserver := parseBundle(t, store.TLSApiserverCertificate)[0]
+	assert.NoError(t, apiserver.CheckSignatureFrom(switched[0]))
+
+	removed, err := OldCAsRemoved(store, names)
+	require.NoError(t, err)
+	assert.False(t, removed)
+
+	// RemoveOld
+	require.NoError(t, RemoveOldCAs(store, names))
+	final := parseBundle(t, store.TLSCACertificate)
+	require.Len(t, final, 1)
+	assert.Equal(t, switched[0].Raw, final[0].Raw)
+	removed, err = OldCAsRemoved(store, names)
+	require.NoError(t, err)
+	assert.True(t, removed)
+
+	assert.Equal(t, models.CARotationPhaseReissue, NextCARotationPhase(models.CARotationPhaseTrustNew))
+	assert.Equal(t, models.CARotationPhaseRemoveOld, NextCARotationPhase(models.CARotationPhaseReissue))
+	assert.Equal(t, models.CARotationPhaseCompleted, NextCARotationPhase(models.CARotationPhaseRemoveOld))
+}
+
+func parseBundle(t *testing.T, bundle string) []*x509.Certificate {
+	certificates, err := certutil.ParseCertsPEM([]byte(bundle))
+	require.NoError(t, err)
+	return certificates
+}
@@ -2,6 +2,10 @@ package util
 
 import (
 	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
 	cryptorand "crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -14,6 +18,7 @@ import (
 	"math/big"
 	"net"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
@@ -38,7 +43,7 @@ const (
 
 type Bundle struct {
 	Certificate *x509.Certificate
-	PrivateKey  *rsa.PrivateKey
+	PrivateKey  crypto.Signer
 }
 
 func NewBundle(key, cert []byte) (*Bundle, error) {
@@ -53,12 +58,12 @@ func NewBundle(key, cert []byte) (*Bundle, error) {
 	if err != nil {
 		return nil, err
 	}
-	rsaKey, isRSAKey := k.(*rsa.PrivateKey)
-	if !isRSAKey {
-		return nil, errors.New("key does not seem to be of type RSA")
+	signer, err := toSigner(k)
+	if err != nil {
+		return nil, err
 	}
 
-	return &Bundle{PrivateKey: rsaKey, Certificate: certificates[0]}, nil
+	return &Bundle{PrivateKey: signer, Certificate: certificates[0]}, nil
 }
 
 type Config struct {
@@ -70,6 +75,8 @@ type Config struct {
 	AltNames           AltNames
 	Usages             []x509.ExtKeyUsage
 	ValidFor           time.Duration
+	// KeyAlgorithm of the generated key, defaults to RSA
+	KeyAlgorithm string
 }
 
 type AltNames struct {
@@ -86,7 +93,10 @@ func (ca Bundle) Sign(config Config) (*Bundle, error) {
 		config.ValidFor = defaultCertValidity
 	}
 
-	key, _ := NewPrivateKey()
+	key, err := NewPrivateKeyFor(config.KeyAlgorithm)
+	if err != nil {
+		return nil, err
+	}
 	serial, _ := cryptorand.Int(cryptorand.Reader, new(big.Int).SetInt64(math.MaxInt64))
 
 	//backdate not before to compensate clock skew
@@ -109,7 +119,7 @@ func (ca Bundle) Sign(config Config) (*Bundle, error) {
 		SerialNumber: serial,
 		NotBefore:    notBefore,
 		NotAfter:     time.Now().Add(config.ValidFor).UTC(),
-		KeyUsage:     x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		KeyUsage:     keyUsage(key),
 		ExtKeyUsage:  config.Usages,
 	}
 
@@ -132,6 +142,33 @@ type CertUpdates struct {
 	Reason string
 }
 
+// NamedCertificate is a certificate of a kluster with its name in the kluster secret
+type NamedCertificate struct {
+	Name string
+	*x509.Certificate
+}
+
+// ParseCertificates returns all certificates and CAs of the store sorted by name
+func ParseCertificates(store *v1.Certificates) ([]NamedCertificate, error) {
+	data, err := store.ToStringData()
+	if err != nil {
+		return nil, err
+	}
+	result := []NamedCertificate{}
+	for key, value := range data {
+		if value == "" || !strings.HasSuffix(key, ".pem") || strings.HasSuffix(key, "-key.pem") {
+			continue
+		}
+		certificates, err := certutil.ParseCertsPEM([]byte(value))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %s", key, err)
+		}
+		result = append(result, NamedCertificate{Name: strings.TrimSuffix(key, ".pem"), Certificate: certificates[0]})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result, nil
+}
+
 func NewCertificateFactory(kluster *v1.Kluster, store *v1.Certificates, domain string) *CertificateFactory {
 	return &CertificateFactory{kluster, store, domain}
 }
@@ -185,8 +222,12 @@ func (cf *CertificateFactory) Ensure() ([]CertUpdates, error) {
 	if err != nil {
 		return nil, err
 	}
+	_, err = loadOrCreateCA(cf.kluster, "ApiServer Users", &cf.store.ApiserverUsersCACertificate, &cf.store.ApiserverUsersCAPrivateKey, &certUpdates)
+	if err != nil {
+		return nil, err
+	}
 
-	if err := ensureClientCertificate(
+	if err := cf.ensureClientCertificate(
 		etcdClientsCA,
 		"apiserver",
 		nil,
@@ -195,7 +236,7 @@ func (cf *CertificateFactory) Ensure() ([]CertUpdates, error) {
 		&certUpdates); err != nil {
 		return nil, err
 	}
-	if err := ensureClientCertificate(
+	if err := cf.ensureClientCertificate(
 		etcdClientsCA,
 		"dex",
 		nil,
@@ -204,7 +245,7 @@ func (cf *CertificateFactory) Ensure() ([]CertUpdates, error) {
 		&certUpdates); err != nil {
 		return nil, err
 	}
-	if err := ensureClientCertificate(apiserverClientsCA,
+	if err := cf.ensureClientCertificate(apiserverClientsCA,
 		"cluster-admin",
 		[]string{"system:masters"},
 		&cf.store.ApiserverClientsClusterAdminCertificate,
@@ -212,7 +253,7 @@ func (cf *CertificateFactory) Ensure() ([]CertUpdates, error) {
 		&certUpdates); err != nil {
 		return nil, err
 	}
-	if err := ensureClientCertificate(etcdClientsCA,
+	if err := cf.ensureClientCertificate(etcdClientsCA,
 		"backup",
 		nil,
 		&cf.store.EtcdClientsBackupCertificate,
@@ -220,7 +261,7 @@ func (cf *CertificateFactory) Ensure() ([]CertUpdates, error) {
 		&certUpdates); err != nil {
 		return nil, err
 	}
-	if err := ensureClientCertificate(apiserverClientsCA,
+	if err := cf.ensureClientCertificate(apiserverClientsCA,
 		"system:kube-controller-manager",
 		nil,
 		&cf.store.ApiserverClientsKubeControllerManagerCertificate,
@@ -228,7 +269,7 @@ func (cf *CertificateFactory) Ensure() ([]CertUpdates, error) {
 		&certUpdates); err != nil {
 		return nil, err
 	}
-	if err := ensureClientCertificate(
+	if err := cf.ensureClientCertificate(
 		apiserverClientsCA,
 		"system:kube-proxy",
 		nil,
@@ -237,7 +278,7 @@ func (cf *CertificateFactory) Ensure() ([]CertUpdates, error) {
 		&certUpdates); err != nil {
 		return nil, err
 	}
-	if err := ensureClientCertificate(
+	if err := cf.ensureClientCertificate(
 		apiserverClientsCA,
 		"system:kube-scheduler",
 		nil,
@@ -246,7 +287,7 @@ func (cf *CertificateFactory) Ensure() ([]CertUpdates, error) {
 		&certUpdates); err != nil {
 		return nil, err
 	}
-	if err := ensureClientCertificate(
+	if err := cf.ensureClientCertificate(
 		apiserverClientsCA,
 		"kubernikus:wormhole",
 		nil,
@@ -255,7 +296,7 @@ func (cf *CertificateFactory) Ensure() ([]CertUpdates, error) {
 		&certUpdates); err != nil {
 		return nil, err
 	}
-	if err := ensureClientCertificate(
+	if err := cf.ensureClientCertificate(
 		apiserverClientsCA,
 		"system:serviceaccount:kube-system:csi-cinder-controller-sa",
 		nil,
@@ -264,7 +305,7 @@ func (cf *CertificateFactory) Ensure() ([]CertUpdates, error) {
 		&certUpdates); err != nil {
 		return nil, err
 	}
-	if err := ensureClientCertificate(
+	if err := cf.ensureClientCertificate(
 		kubeletClientsCA,
 		"apiserver",
 		nil,
@@ -273,7 +314,7 @@ func (cf *CertificateFactory) Ensure() ([]CertUpdates, error) {
 		&certUpdates); err != nil {
 		return nil, err
 	}
-	if err := ensureClientCertificate(
+	if err := cf.ensureClientCertificate(
 		aggregationCA,
 		"aggregator",
 		nil,
@@ -282,7 +323,7 @@ func (cf *CertificateFactory) Ensure() ([]CertUpdates, error) {
 		&certUpdates); err != nil {
 		return nil, err
 	}
-	if err := ensureClientCertificate(
+	if err := cf.ensureClientCertificate(
 		admissionCA,
 		"admission",
 		nil,
@@ -302,7 +343,7 @@ func (cf *CertificateFactory) Ensure() ([]CertUpdates, error) {
 		apiServerDNSNames = append(apiServerDNSNames, dnsNames...)
 		apiServerIPs = append(apiServerIPs, ips...)
 	}
-	if err := ensureServerCertificate(tlsCA, "apiserver",
+	if err := cf.ensureServerCertificate(tlsCA, "apiserver",
 		apiServerDNSNames,
 		apiServerIPs,
 		&cf.store.TLSApiserverCertificate,
@@ -319,7 +360,7 @@ func (cf *CertificateFactory) Ensure() ([]CertUpdates, error) {
 		}
 		wormholeDNSNames = append(wormholeDNSNames, dnsNames...)
 	}
-	if err := ensureServerCertificate(tlsCA, "wormhole",
+	if err := cf.ensureServerCertificate(tlsCA, "wormhole",
 		wormholeDNSNames,
 		nil,
 		&cf.store.TLSWormholeCertificate,
@@ -327,7 +368,7 @@ func (cf *CertificateFactory) Ensure() ([]CertUpdates, error) {
 		&certUpdates); err != nil {
 		return nil, err
 	}
-	if err := ensureServerCertificate(tlsEtcdCA, "etcd",
+	if err := cf.ensureServerCertificate(tlsEtcdCA, "etcd",
 		[]string{fmt.Sprintf("%v-etcd", cf.kluster.Name), fmt.Sprintf("%v-etcd.%v", cf.kluster.Name, cf.domain), "localhost"},
 		[]net.IP{net.IPv4(127, 0, 0, 1)},
 		&cf.store.TLSEtcdCertificate,
@@ -339,9 +380,21 @@ func (cf *CertificateFactory) Ensure() ([]CertUpdates, error) {
 	return certUpdates, nil
 }
 
-func (cf *CertificateFactory) UserCert(principal *models.Principal, apiURL string) (*Bundle, error) {
+// UserIdentity returns the common name of client certificates for the principal
+func UserIdentity(principal *models.Principal) string {
+	if principal.Domain != "" {
+		return fmt.Sprintf("%s@%s", principal.Name, principal.Domain)
+	}
+	return principal.Name
+}
 
-	caBundle, err := NewBundle([]byte(cf.store.ApiserverClientsCAPrivateKey), []byte(cf.store.ApiserverClientsCACertifcate))
+// UserCert signs a client certificate for the principal with the users CA.
+// Ensure creates the users CA, ErrUsersCAMissing is returned until it ran.
+func (cf *CertificateFactory) UserCert(principal *models.Principal, apiURL string, validFor time.Duration) (*Bundle, error) {
+	if cf.store.ApiserverUsersCACertificate == "" || cf.store.ApiserverUsersCAPrivateKey == "" {
+		return nil, ErrUsersCAMissing
+	}
+	caBundle, err := NewBundle([]byte(cf.store.ApiserverUsersCAPrivateKey), []byte(cf.store.ApiserverUsersCACertificate))
 	if err != nil {
 		return nil, err
 	}
@@ -351,10 +404,7 @@ func (cf *CertificateFactory) UserCert(principal *models.Principal, apiURL strin
 		organizations = append(organizations, "os:"+role)
 	}
 	projectid := cf.kluster.Account()
-	cn := principal.Name
-	if principal.Domain != "" {
-		cn = fmt.Sprintf("%s@%s", principal.Name, principal.Domain)
-	}
+	cn := UserIdentity(principal)
 
 	province := []string{projectid}
 	if a := auth.OpenStackAuthURL(); a != "" {
@@ -367,13 +417,14 @@ func (cf *CertificateFactory) UserCert(principal *models.Principal, apiURL strin
 		Province:     province,
 		Locality:     []string{apiURL},
 		Usages:       []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
-		ValidFor:     24 * time.Hour,
+		ValidFor:     validFor,
+		KeyAlgorithm: cf.kluster.Spec.KeyAlgorithm,
 	})
 
 }
 
 func loadOrCreateCA(kluster *v1.Kluster, name string, cert, key *string, certUpdates *[]CertUpdates) (*Bundle, error) {
-	var existingKey *rsa.PrivateKey
+	var existingKey crypto.Signer
 	var existingSubject []byte
 	regenerate := false
 
@@ -394,14 +445,12 @@ func loadOrCreateCA(kluster *v1.Kluster, name string, cert, key *string, certUpd
 		if caCert.SubjectKeyId == nil {
 			regenerate = true
 
-			var isRSAKey bool
 			k, err := keyutil.ParsePrivateKeyPEM([]byte(*key))
 			if err != nil {
 				return nil, err
 			}
-			existingKey, isRSAKey = k.(*rsa.PrivateKey)
-			if !isRSAKey {
-				return nil, errors.New("key does not seem to be of type RSA")
+			if existingKey, err = toSigner(k); err != nil {
+				return nil, err
 			}
 			existingSubject = caCert.RawSubject
 		}
@@ -411,7 +460,7 @@ func loadOrCreateCA(kluster *v1.Kluster, name string, cert, key *string, certUpd
 		return NewBundle([]byte(*key), []byte(*cert))
 	}
 
-	caBundle, err := createCA(kluster.Name, name, existingKey, existingSubject)
+	caBundle, err := createCA(kluster.Name, name, caKeyAlgorithm(kluster, name), existingKey, existingSubject)
 	if err != nil {
 		return nil, err
 	}
@@ -423,16 +472,21 @@ func loadOrCreateCA(kluster *v1.Kluster, name string, cert, key *string, certUpd
 	}
 	*certUpdates = append(*certUpdates, update)
 
+	keyPEM, err := EncodePrivateKeyPEM(caBundle.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
 	*cert = string(EncodeCertPEM(caBundle.Certificate))
-	*key = string(EncodePrivateKeyPEM(caBundle.PrivateKey))
+	*key = string(keyPEM)
 	return caBundle, nil
 }
 
-func ensureClientCertificate(ca *Bundle, cn string, groups []string, cert, key *string, certUpdates *[]CertUpdates) error {
+func (cf *CertificateFactory) ensureClientCertificate(ca *Bundle, cn string, groups []string, cert, key *string, certUpdates *[]CertUpdates) error {
 	certificate, err := ca.Sign(Config{
 		Sign:         cn,
 		Usages:       []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
 		Organization: groups,
+		KeyAlgorithm: cf.kluster.Spec.KeyAlgorithm,
 	})
 	if err != nil {
 		return err
@@ -465,13 +519,17 @@ func ensureClientCertificate(ca *Bundle, cn string, groups []string, cert, key *
 	}
 	*certUpdates = append(*certUpdates, update)
 
+	keyPEM, err := EncodePrivateKeyPEM(certificate.PrivateKey)
+	if err != nil {
+		return err
+	}
 	*cert = string(EncodeCertPEM(certificate.Certificate))
-	*key = string(EncodePrivateKeyPEM(certificate.PrivateKey))
+	*key = string(keyPEM)
 	return nil
 
 }
 
-func ensureServerCertificate(ca *Bundle, cn string, dnsNames []string, ips []net.IP, cert, key *string, certUpdates *[]CertUpdates) error {
+func (cf *CertificateFactory) ensureServerCertificate(ca *Bundle, cn string, dnsNames []string, ips []net.IP, cert, key *string, certUpdates *[]CertUpdates) error {
 	certificate, err := ca.Sign(Config{
 		Sign:   cn,
 		Usages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
@@ -479,6 +537,7 @@ func ensureServerCertificate(ca *Bundle, cn string, dnsNames []string, ips []net
 			DNSNames: dnsNames,
 			IPs:      ips,
 		},
+		KeyAlgorithm: cf.kluster.Spec.KeyAlgorithm,
 	})
 	if err != nil {
 		return err
@@ -511,19 +570,23 @@ func ensureServerCertificate(ca *Bundle, cn string, dnsNames []string, ips []net
 	}
 	*certUpdates = append(*certUpdates, update)
 
+	keyPEM, err := EncodePrivateKeyPEM(certificate.PrivateKey)
+	if err != nil {
+		return err
+	}
 	*cert = string(EncodeCertPEM(certificate.Certificate))
-	*key = string(EncodePrivateKeyPEM(certificate.PrivateKey))
+	*key = string(keyPEM)
 	return nil
 }
 
-func createCA(klusterName, name string, existingKey *rsa.PrivateKey, existingSubject []byte) (*Bundle, error) {
-	var privateKey *rsa.PrivateKey
+func createCA(klusterName, name, keyAlgorithm string, existingKey crypto.Signer, existingSubject []byte) (*Bundle, error) {
+	var privateKey crypto.Signer
 	var err error
 
 	if existingKey != nil {
 		privateKey = existingKey
 	} else {
-		privateKey, err = NewPrivateKey()
+		privateKey, err = NewPrivateKeyFor(keyAlgorithm)
 		if err != nil {
 			return nil, fmt.Errorf("failed to generate private key for %s ca: %s", name, err)
 		}
@@ -538,7 +601,7 @@ func createCA(klusterName, name string, existingKey *rsa.PrivateKey, existingSub
 		},
 		NotBefore:             now.UTC(),
 		NotAfter:              now.Add(caValidity).UTC(),
-		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		KeyUsage:              keyUsage(privateKey) | x509.KeyUsageCertSign,
 		BasicConstraintsValid: true,
 		IsCA:                  true,
 	}
@@ -669,16 +732,73 @@ func EncodeCertPEM(cert *x509.Certificate) []byte {
 	return pem.EncodeToMemory(&block)
 }
 
-// EncodePrivateKeyPEM returns PEM-encoded private key data
-func EncodePrivateKeyPEM(key *rsa.PrivateKey) []byte {
-	block := pem.Block{
-		Type:  RSAPrivateKeyBlockType,
-		Bytes: x509.MarshalPKCS1PrivateKey(key),
+// EncodePrivateKeyPEM returns PEM-encoded private key data. RSA keys are
+// encoded as PKCS#1 for compatibility, all others as PKCS#8.
+func EncodePrivateKeyPEM(key crypto.Signer) ([]byte, error) {
+	if rsaKey, ok := key.(*rsa.PrivateKey); ok {
+		return pem.EncodeToMemory(&pem.Block{
+			Type:  RSAPrivateKeyBlockType,
+			Bytes: x509.MarshalPKCS1PrivateKey(rsaKey),
+		}), nil
 	}
-	return pem.EncodeToMemory(&block)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode private key: %s", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  PrivateKeyBlockType,
+		Bytes: der,
+	}), nil
 }
 
 // NewPrivateKey creates an RSA private key
 func NewPrivateKey() (*rsa.PrivateKey, error) {
 	return rsa.GenerateKey(cryptorand.Reader, rsaKeySize)
 }
+
+// NewPrivateKeyFor creates a private key of the given algorithm, RSA by default
+func NewPrivateKeyFor(algorithm string) (crypto.Signer, error) {
+	switch algorithm {
+	case "", models.KlusterSpecKeyAlgorithmRsa:
+		return NewPrivateKey()
+	case models.KlusterSpecKeyAlgorithmEcdsaDashP256:
+		return ecdsa.GenerateKey(elliptic.P256(), cryptorand.Reader)
+	case models.KlusterSpecKeyAlgorithmEd25519:
+		_, key, err := ed25519.GenerateKey(cryptorand.Reader)
+		return key, err
+	default:
+		return nil, fmt.Errorf("unsupported key algorithm %s", algorithm)
+	}
+}
+
+// caKeyAlgorithm returns the key algorithm for a new CA of the kluster. The
+// apiserver clients CA key also signs service account tokens, which doesn't
+// work with Ed25519.
+func caKeyAlgorithm(kluster *v1.Kluster, name string) string {
+	if name == "ApiServer Clients" && kluster.Spec.KeyAlgorithm == models.KlusterSpecKeyAlgorithmEd25519 {
+		return models.KlusterSpecKeyAlgorithmEcdsaDashP256
+	}
+	return kluster.Spec.KeyAlgorithm
+}
+
+// keyUsage returns the key usages of a certificate for the given key, only
+// RSA keys are used for key encipherment
+func keyUsage(key crypto.Signer) x509.KeyUsage {
+	if _, ok := key.(*rsa.PrivateKey); ok {
+		return x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature
+	}
+	return x509.KeyUsageDigitalSignature
+}
+
+func toSigner(key interface{}) (crypto.Signer, error) {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return k, nil
+	case *ecdsa.PrivateKey:
+		return k, nil
+	case ed25519.PrivateKey:
+		return k, nil
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+}
@@ -1,10 +1,20 @@
 package util
 
 import (
+	"crypto/x509"
 	"net"
+	"sort"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
 )
 
 func TestSliceDiff(t *testing.T) {
@@ -33,3 +43,169 @@ func TestSliceDiff(t *testing.T) {
 	assert.Equal(t, []string{"+2.2.2.2", "-1.1.1.1"}, IPSliceDiff([]net.IP{net.IPv4(1, 1, 1, 1)}, []net.IP{net.IPv4(2, 2, 2, 2)}))
 	assert.Equal(t, []string{"+d", "-a"}, StringSliceDiff([]string{"a", "b", "c"}, []string{"b", "c", "d"}))
 }
+
+func TestParseCertificates(t *testing.T) {
+	kluster := &v1.Kluster{ObjectMeta: metav1.ObjectMeta{Name: "test"}, Spec: models.KlusterSpec{AdvertiseAddress: "1.1.1.1", ServiceCIDR: "192.168.0.0/24"}}
+	store := new(v1.Certificates)
+	_, err := NewCertificateFactory(kluster, store, "test.local").Ensure()
+	require.NoError(t, err)
+
+	certificates, err := ParseCertificates(store)
+	require.NoError(t, err)
+	require.NotEmpty(t, certificates)
+	assert.True(t, sort.SliceIsSorted(certificates, func(i, j int) bool { return certificates[i].Name < certificates[j].Name }))
+
+	byName := map[string]NamedCertificate{}
+	for _, cert := range certificates {
+		assert.False(t, strings.HasSuffix(cert.Name, "-key"), "private key %s listed", cert.Name)
+		byName[cert.Name] = cert
+	}
+	require.Contains(t, byName, "tls-ca")
+	assert.True(t, byName["tls-ca"].IsCA)
+	require.Contains(t, byName, "tls-apiserver")
+	assert.False(t, byName["tls-apiserver"].IsCA)
+	assert.True(t, byName["tls-apiserver"].NotAfter.Before(byName["tls-ca"].NotAfter))
+}
+
+func TestKeyAlgorithms(t *testing.T) {
+	cases := []struct {
+		algorithm        string
+		caKey            x509.PublicKeyAlgorithm
+		apiserverClients x509.PublicKeyAlgorithm
+	}{
+		{"", x509.RSA, x509.RSA},
+		{models.KlusterSpecKeyAlgorithmEcdsaDashP256, x509.ECDSA, x509.ECDSA},
+		{models.KlusterSpecKeyAlgorithmEd25519, x509.Ed25519, x509.ECDSA},
+	}
+	for _, c := range cases {
+		kluster := &v1.Kluster{ObjectMeta: metav1.ObjectMeta{Name: "test"}, Spec: models.KlusterSpec{AdvertiseAddress: "1.1.1.1", ServiceCIDR: "192.168.0.0/24", KeyAlgorithm: c.algorithm}}
+		store := new(v1.Certificates)
+		_, err := NewCertificateFactory(kluster, store, "test.local").Ensure()
+		require.NoError(t, err, c.algorithm)
+
+		tlsCA, err := NewBundle([]byte(store.TLSCAPrivateKey), []byte(store.TLSCACertificate))
+		require.NoError(t, err, c.algorithm)
+		assert.Equal(t, c.caKey, tlsCA.Certificate.PublicKeyAlgorithm, c.algorithm)
+		apiserver, err := NewBundle([]byte(store.TLSApiserverPrivateKey), []byte(store.TLSApiserverCertificate))
+		require.NoError(t, err, c.algorithm)
+		assert.Equal(t, c.caKey, apiserver.Certificate.PublicKeyAlgorithm, c.algorithm)
+		assert.NoError(t, apiserver.Certificate.CheckSignatureFrom(tlsCA.Certificate), c.algorithm)
+		clientsCA, err := NewBundle([]byte(store.ApiserverClientsCAPrivateKey), []byte(store.ApiserverClientsCACertifcate))
+		require.NoError(t, err, c.algorithm)
+		assert.Equal(t, c.apiserverClients, clientsCA.Certificate.PublicKeyAlgorithm, c.algorithm)
+	}
+}
+
+func TestKeyAlgorithmMigration(t *testing.T) {
+	kluster := &v1.Kluster{ObjectMeta: metav1.ObjectMeta{Name: "test"}, Spec: models.KlusterSpec{AdvertiseAddress: "1.1.1.1", ServiceCIDR: "192.168.0.0/24"}}
+	store := new(v1.Certificates)
+	_, err := NewCertificateFactory(kluster, store, "test.local").Ensure()
+	require.NoError(t, err)
+
+	// existing RSA material is kept
+	kluster.Spec.KeyAlgorithm = models.KlusterSpecKeyAlgorithmEcdsaDashP256
+	updates, err := NewCertificateFactory(kluster, store, "test.local").Ensure()
+	require.NoError(t, err)
+	assert.Empty(t, updates)
+
+	// and replaced by rotating the CA
+	require.NoError(t, AddCATrust(kluster, store, []string{"tls"}))
+	require.NoError(t, SwitchCASigning(store, []string{"tls"}))
+	_, err = NewCertificateFactory(kluster, store, "test.local").Ensure()
+	require.NoError(t, err)
+	tlsCA, err := NewBundle([]byte(store.TLSCAPrivateKey), []byte(store.TLSCACertificate))
+	require.NoError(t, err)
+	assert.Equal(t, x509.ECDSA, tlsCA.Certificate.PublicKeyAlgorithm)
+	apiserver, err := NewBundle([]byte(store.TLSApiserverPrivateKey), []byte(store.TLSApiserverCertificate))
+	require.NoError(t, err)
+	assert.Equal(t, x509.ECDSA, apiserver.Certificate.PublicKeyAlgorithm)
+	etcd, err := NewBundle([]byte(store.TLSEtcdPrivateKey), []byte(store.TLSEtcdCertificate))
+	require.NoError(t, err)
+	assert.Equal(t, x509.RSA, etcd.Certificate.PublicKeyAlgorithm)
+}
+
+func TestUserCert(t *testing.T) {
+	kluster := &v1.Kluster{ObjectMeta: metav1.ObjectMeta{Name: "test"}, Spec: models.KlusterSpec{AdvertiseAddress: "1.1.1.1", ServiceCIDR: "192.168.0.0/24"}}
+	secret := &v1.Secret{}
+	factory := NewCertificateFactory(kluster, &secret.Certificates, "test.local")
+	_, err := factory.UserCert(&models.Principal{Name: "user", Domain: "domain"}, "https://api", time.Hour)
+	assert.Equal(t, ErrUsersCAMissing, err)
+	_, err = factory.Ensure()
+	require.NoError(t, err)
+
+	verify := func(cert *x509.Certificate) error {
+		data, err := secret.ToStringData()
+		require.NoError(t, err)
+		roots := x509.NewCertPool()
+		require.True(t, roots.AppendCertsFromPEM([]byte(data["apiserver-clients-and-nodes-ca.pem"])))
+		_, err = cert.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}})
+		return err
+	}
+
+	user, err := factory.UserCert(&models.Principal{Name: "user", Domain: "domain"}, "https://api", time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, "user@domain", user.Certificate.Subject.CommonName)
+	assert.Equal(t, "ApiServer Users", user.Certificate.Issuer.CommonName)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), user.Certificate.NotAfter, time.Minute)
+	assert.NoError(t, verify(user.Certificate))
+
+	// replacing the users CA revokes the certificate
+	require.NoError(t, RotateUsersCA(kluster, &secret.Certificates, "1"))
+	assert.Error(t, verify(user.Certificate))
+}
+
+func TestUserCertificates(t *testing.T) {
+	now := time.Now()
+	users := &UserCertificates{}
+	users.Record("a", "1", now.Add(-2*time.Hour), now.Add(-time.Hour))
+	users.Record("a", "2", now, now.Add(time.Hour))
+	users.Record("b", "3", now, now.Add(time.Hour))
+	require.Len(t, users.Issued, 2, "expired certificates are pruned")
+
+	users.RevokeAll(now)
+	assert.True(t, users.Issued[0].Revoked)
+	assert.True(t, users.Issued[1].Revoked)
+
+	users.Deny("b", "a", "b")
+	assert.Equal(t, []string{"a", "b"}, users.DeniedUsers)
+	assert.True(t, users.Denied("a"))
+	assert.True(t, users.Allow("a"))
+	assert.False(t, users.Allow("a"))
+	assert.False(t, users.Denied("a"))
+}
+
+func TestUpdateUserCertificatesWithRetries(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	kluster := &v1.Kluster{ObjectMeta: metav1.ObjectMeta{Namespace: "kubernikus", Name: "test"}}
+	writes := func() int {
+		count := 0
+		for _, action := range client.Actions() {
+			if action.GetVerb() == "create" || action.GetVerb() == "update" {
+				count++
+			}
+		}
+		return count
+	}
+
+	_, err := UpdateUserCertificatesWithRetries(client, kluster, func(users *UserCertificates) error {
+		users.Deny("a")
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, writes())
+
+	users, err := UpdateUserCertificatesWithRetries(client, kluster, func(users *UserCertificates) error {
+		users.Deny("a")
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, users.DeniedUsers)
+	assert.Equal(t, 1, writes(), "unchanged certificates aren't written")
+
+	_, err = UpdateUserCertificatesWithRetries(client, kluster, func(users *UserCertificates) error {
+		users.Allow("a")
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, writes())
+}
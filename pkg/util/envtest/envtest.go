@@ -0,0 +1,252 @@
+// Package envtest runs a throwaway Kubernetes control plane (etcd and
+// kube-apiserver) as local processes.
+//
+// It uses the same binaries as controller-runtime's envtest. They are looked
+// up in the directory given by KUBEBUILDER_ASSETS and then in PATH, so
+// `setup-envtest use -p path` can be used to install them.
+package envtest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/go-kit/log"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const AssetsEnv = "KUBEBUILDER_ASSETS"
+
+type Environment struct {
+	// BinaryAssetsDirectory containing etcd and kube-apiserver, defaults to $KUBEBUILDER_ASSETS
+	BinaryAssetsDirectory string
+	// StartTimeout is the time to wait for the apiserver to become ready
+	StartTimeout time.Duration
+	Logger       log.Logger
+
+	// Kubeconfig is the path of an admin kubeconfig, set after Start
+	Kubeconfig string
+	// URL of the apiserver, set after Start
+	URL string
+
+	dir       string
+	token     string
+	etcd      *process
+	apiserver *process
+}
+
+type process struct {
+	cmd    *exec.Cmd
+	exited chan struct{}
+}
+
+// Start launches etcd and kube-apiserver and waits for the apiserver to become ready
+func (e *Environment) Start() (err error) {
+	if e.Logger == nil {
+		e.Logger = log.NewNopLogger()
+	}
+	if e.StartTimeout == 0 {
+		e.StartTimeout = 60 * time.Second
+	}
+	if e.BinaryAssetsDirectory == "" {
+		e.BinaryAssetsDirectory = os.Getenv(AssetsEnv)
+	}
+
+	etcdBin, err := e.binary("etcd")
+	if err != nil {
+		return err
+	}
+	apiserverBin, err := e.binary("kube-apiserver")
+	if err != nil {
+		return err
+	}
+
+	if e.dir, err = os.MkdirTemp("", "kubernikus-envtest-"); err != nil {
+		return fmt.Errorf("failed to create temp dir: %s", err)
+	}
+	defer func() {
+		if err != nil {
+			e.Stop()
+		}
+	}()
+
+	ports, err := freePorts(3)
+	if err != nil {
+		return fmt.Errorf("failed to allocate ports: %s", err)
+	}
+	etcdURL := fmt.Sprintf("http://127.0.0.1:%d", ports[0])
+	e.URL = fmt.Sprintf("https://127.0.0.1:%d", ports[2])
+
+	e.etcd, err = e.start("etcd", etcdBin,
+		"--data-dir="+filepath.Join(e.dir, "etcd"),
+		"--listen-client-urls="+etcdURL,
+		"--advertise-client-urls="+etcdURL,
+		fmt.Sprintf("--listen-peer-urls=http://127.0.0.1:%d", ports[1]),
+		"--unsafe-no-fsync=true",
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := e.writeCredentials(); err != nil {
+		return err
+	}
+
+	e.apiserver, err = e.start("kube-apiserver", apiserverBin,
+		"--etcd-servers="+etcdURL,
+		"--cert-dir="+filepath.Join(e.dir, "certs"),
+		"--bind-address=127.0.0.1",
+		fmt.Sprintf("--secure-port=%d", ports[2]),
+		"--service-cluster-ip-range=10.0.0.0/24",
+		"--allow-privileged=true",
+		"--authorization-mode=RBAC",
+		"--token-auth-file="+filepath.Join(e.dir, "tokens.csv"),
+		"--service-account-issuer=https://127.0.0.1",
+		"--service-account-key-file="+filepath.Join(e.dir, "sa.key"),
+		"--service-account-signing-key-file="+filepath.Join(e.dir, "sa.key"),
+		"--disable-admission-plugins=ServiceAccount",
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := e.waitForReady(); err != nil {
+		return err
+	}
+
+	e.Kubeconfig = filepath.Join(e.dir, "kubeconfig")
+	return clientcmd.WriteToFile(e.kubeconfig(), e.Kubeconfig)
+}
+
+// Stop terminates the control plane and removes all state
+func (e *Environment) Stop() error {
+	for _, p := range []*process{e.apiserver, e.etcd} {
+		if p == nil {
+			continue
+		}
+		p.cmd.Process.Signal(os.Interrupt)
+		select {
+		case <-p.exited:
+		case <-time.After(10 * time.Second):
+			p.cmd.Process.Kill()
+			<-p.exited
+		}
+	}
+	if e.dir != "" {
+		return os.RemoveAll(e.dir)
+	}
+	return nil
+}
+
+func (e *Environment) binary(name string) (string, error) {
+	if e.BinaryAssetsDirectory != "" {
+		path := filepath.Join(e.BinaryAssetsDirectory, name)
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+	path, err := exec.LookPath(name)
+	if err != nil {
+		return "", fmt.Errorf("%s binary not found, set %s or add it to PATH", name, AssetsEnv)
+	}
+	return path, nil
+}
+
+func (e *Environment) start(name, binary string, args ...string) (*process, error) {
+	logFile, err := os.Create(filepath.Join(e.dir, name+".log"))
+	if err != nil {
+		return nil, err
+	}
+	p := &process{cmd: exec.Command(binary, args...), exited: make(chan struct{})}
+	p.cmd.Stdout = logFile
+	p.cmd.Stderr = logFile
+	e.Logger.Log("msg", "starting process", "name", name, "log", logFile.Name(), "v", 2)
+	if err := p.cmd.Start(); err != nil {
+		logFile.Close()
+		return nil, fmt.Errorf("failed to start %s: %s", name, err)
+	}
+	go func() {
+		p.cmd.Wait()
+		logFile.Close()
+		close(p.exited)
+	}()
+	return p, nil
+}
+
+func (e *Environment) writeCredentials() error {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return err
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	if err := os.WriteFile(filepath.Join(e.dir, "sa.key"), keyPEM, 0600); err != nil {
+		return err
+	}
+
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return err
+	}
+	e.token = hex.EncodeToString(token)
+	return os.WriteFile(filepath.Join(e.dir, "tokens.csv"), []byte(e.token+",admin,admin,system:masters\n"), 0600)
+}
+
+func (e *Environment) waitForReady() error {
+	client := &http.Client{
+		Timeout: 5 * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //nolint:gosec
+		},
+	}
+	deadline := time.Now().Add(e.StartTimeout)
+	for time.Now().Before(deadline) {
+		select {
+		case <-e.apiserver.exited:
+			return fmt.Errorf("kube-apiserver exited, see %s", filepath.Join(e.dir, "kube-apiserver.log"))
+		default:
+		}
+		req, _ := http.NewRequest(http.MethodGet, e.URL+"/readyz", nil)
+		req.Header.Set("Authorization", "Bearer "+e.token)
+		if resp, err := client.Do(req); err == nil {
+			resp.Body.Close()
+			if resp.StatusCode == http.StatusOK {
+				return nil
+			}
+		}
+		time.Sleep(250 * time.Millisecond)
+	}
+	return fmt.Errorf("kube-apiserver not ready after %s", e.StartTimeout)
+}
+
+func (e *Environment) kubeconfig() clientcmdapi.Config {
+	config := clientcmdapi.NewConfig()
+	config.Clusters["envtest"] = &clientcmdapi.Cluster{Server: e.URL, InsecureSkipTLSVerify: true}
+	config.AuthInfos["admin"] = &clientcmdapi.AuthInfo{Token: e.token}
+	config.Contexts["envtest"] = &clientcmdapi.Context{Cluster: "envtest", AuthInfo: "admin"}
+	config.CurrentContext = "envtest"
+	return *config
+}
+
+func freePorts(n int) ([]int, error) {
+	ports := make([]int, 0, n)
+	for i := 0; i < n; i++ {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			return nil, err
+		}
+		defer l.Close()
+		ports = append(ports, l.Addr().(*net.TCPAddr).Port)
+	}
+	return ports, nil
+}
@@ -0,0 +1,123 @@
+package etcd
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+)
+
+// SwiftContainer is the container etcdbr writes the snapshots of a kluster to
+type SwiftContainer struct {
+	// Region is empty for the region of the kluster
+	Region    string
+	ProjectID string
+	Name      string
+}
+
+// SwiftBackupContainer returns the Swift container of the kluster, false if
+// the backup is off or stored elsewhere
+func SwiftBackupContainer(kluster *v1.Kluster) (SwiftContainer, bool) {
+	if kluster.Spec.Backup != "" && kluster.Spec.Backup != models.KlusterSpecBackupOn {
+		return SwiftContainer{}, false
+	}
+	container := SwiftContainer{ProjectID: kluster.Account(), Name: DefaultStorageContainer(kluster)}
+	target := kluster.Spec.BackupTarget
+	if target == nil {
+		return container, true
+	}
+	if target.Type == nil || *target.Type != models.BackupTargetTypeSwift {
+		return SwiftContainer{}, false
+	}
+	if target.Swift != nil {
+		container.Region = target.Swift.Region
+		if target.Swift.ProjectID != "" {
+			container.ProjectID = target.Swift.ProjectID
+		}
+		if target.Swift.Container != "" {
+			container.Name = target.Swift.Container
+		}
+	}
+	return container, true
+}
+
+// SwiftBackup tells if etcdbr writes the snapshots of the kluster to a Swift
+// container the service user of the kluster can access with its own project
+func SwiftBackup(kluster *v1.Kluster) bool {
+	container, ok := SwiftBackupContainer(kluster)
+	return ok && container.ProjectID == kluster.Account()
+}
+
+// S3Backup returns the S3 bucket of the kluster, nil unless the backup target is S3
+func S3Backup(kluster *v1.Kluster) *models.BackupTargetS3 {
+	if kluster.Spec.Backup != "" && kluster.Spec.Backup != models.KlusterSpecBackupOn {
+		return nil
+	}
+	target := kluster.Spec.BackupTarget
+	if target == nil || target.Type == nil || *target.Type != models.BackupTargetTypeS3 {
+		return nil
+	}
+	return target.S3
+}
+
+// BackupRetention returns the retention settings of the kluster, nil for the defaults
+func BackupRetention(kluster *v1.Kluster) *models.BackupRetention {
+	if kluster.Spec.BackupTarget == nil {
+		return nil
+	}
+	return kluster.Spec.BackupTarget.Retention
+}
+
+// ValidateBackupTarget checks the combination of settings the schema can't
+func ValidateBackupTarget(spec models.KlusterSpec) error {
+	target := spec.BackupTarget
+	if target == nil {
+		return nil
+	}
+	if spec.Backup != "" && spec.Backup != models.KlusterSpecBackupOn {
+		return fmt.Errorf("backupTarget requires backup %s", models.KlusterSpecBackupOn)
+	}
+	if target.Type == nil {
+		return errors.New("backupTarget.type is required")
+	}
+
+	switch *target.Type {
+	case models.BackupTargetTypeSwift:
+		if target.S3 != nil {
+			return errors.New("backupTarget.s3 can't be set for type swift")
+		}
+	case models.BackupTargetTypeS3:
+		if target.Swift != nil {
+			return errors.New("backupTarget.swift can't be set for type s3")
+		}
+		if target.S3 == nil || target.S3.Endpoint == nil || target.S3.Bucket == nil {
+			return errors.New("backupTarget.s3 with endpoint and bucket is required for type s3")
+		}
+		endpoint, err := url.Parse(*target.S3.Endpoint)
+		if err != nil || (endpoint.Scheme != "https" && endpoint.Scheme != "http") || endpoint.Host == "" {
+			return fmt.Errorf("backupTarget.s3.endpoint %s is not a valid URL", *target.S3.Endpoint)
+		}
+		if *target.S3.Bucket == "" {
+			return errors.New("backupTarget.s3.bucket can't be empty")
+		}
+	}
+
+	return ValidateBackupRetention(target.Retention)
+}
+
+// ValidateBackupRetention checks that maxBackups is given for LimitBased retention only
+func ValidateBackupRetention(retention *models.BackupRetention) error {
+	if retention == nil {
+		return nil
+	}
+	limitBased := retention.Policy == models.BackupRetentionPolicyLimitBased
+	if limitBased && retention.MaxBackups < 1 {
+		return errors.New("backupTarget.retention.maxBackups is required for policy LimitBased")
+	}
+	if !limitBased && retention.MaxBackups != 0 {
+		return errors.New("backupTarget.retention.maxBackups is only used with policy LimitBased")
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package etcd
+
+import (
+	"testing"
+
+	"github.com/go-openapi/swag/conv"
+	"github.com/stretchr/testify/assert"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+)
+
+func TestSwiftBackupContainer(t *testing.T) {
+	kluster := &v1.Kluster{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "test-project", UID: "uid", Labels: map[string]string{"account": "project"}},
+		Spec:       models.KlusterSpec{Name: "test", Backup: "on"},
+	}
+
+	container, ok := SwiftBackupContainer(kluster)
+	assert.True(t, ok)
+	assert.Equal(t, SwiftContainer{ProjectID: "project", Name: "kubernikus-etcd-backup-test-uid"}, container)
+	assert.True(t, SwiftBackup(kluster))
+	assert.Nil(t, S3Backup(kluster))
+
+	kluster.Spec.BackupTarget = &models.BackupTarget{Type: conv.Pointer(models.BackupTargetTypeSwift), Swift: &models.BackupTargetSwift{Region: "eu-de-2"}}
+	container, ok = SwiftBackupContainer(kluster)
+	assert.True(t, ok)
+	assert.Equal(t, SwiftContainer{Region: "eu-de-2", ProjectID: "project", Name: "kubernikus-etcd-backup-test-uid"}, container)
+	assert.True(t, SwiftBackup(kluster))
+
+	kluster.Spec.BackupTarget.Swift = &models.BackupTargetSwift{ProjectID: "dr", Container: "etcd"}
+	container, ok = SwiftBackupContainer(kluster)
+	assert.True(t, ok)
+	assert.Equal(t, SwiftContainer{ProjectID: "dr", Name: "etcd"}, container)
+	assert.False(t, SwiftBackup(kluster), "containers in other projects can't be accessed with the kluster's project")
+
+	kluster.Spec.BackupTarget = &models.BackupTarget{Type: conv.Pointer(models.BackupTargetTypeS3), S3: &models.BackupTargetS3{Bucket: conv.Pointer("bucket")}}
+	_, ok = SwiftBackupContainer(kluster)
+	assert.False(t, ok)
+	assert.NotNil(t, S3Backup(kluster))
+
+	kluster.Spec.Backup = "externalAWS"
+	_, ok = SwiftBackupContainer(kluster)
+	assert.False(t, ok)
+	assert.Nil(t, S3Backup(kluster))
+}
@@ -0,0 +1,57 @@
+package etcd
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// FakeSnapshotStore keeps snapshots in memory for tests
+type FakeSnapshotStore struct {
+	Snapshots []models.EtcdSnapshot
+	// Archived maps the archived snapshots to their archive
+	Archived map[string]string
+	// Err is returned by all calls if set
+	Err error
+
+	// mu guards the snapshots against concurrent API requests in dev mode
+	mu sync.Mutex
+}
+
+func (s *FakeSnapshotStore) List() ([]models.EtcdSnapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.Err != nil {
+		return nil, s.Err
+	}
+	snapshots := append([]models.EtcdSnapshot{}, s.Snapshots...)
+	SortSnapshots(snapshots)
+	return snapshots, nil
+}
+
+func (s *FakeSnapshotStore) Archive(name, archive string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.Err != nil {
+		return s.Err
+	}
+	for i, snapshot := range s.Snapshots {
+		if snapshot.Name == name {
+			s.Snapshots = append(s.Snapshots[:i], s.Snapshots[i+1:]...)
+			if s.Archived == nil {
+				s.Archived = map[string]string{}
+			}
+			s.Archived[name] = archive
+			return nil
+		}
+	}
+	return fmt.Errorf("snapshot %s not found", name)
+}
+
+// Add stores a snapshot
+func (s *FakeSnapshotStore) Add(snapshot models.EtcdSnapshot) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.Snapshots = append(s.Snapshots, snapshot)
+}
@@ -0,0 +1,97 @@
+package etcd
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+// ArchivePrefix holds snapshots newer than a restored one. etcdbr ignores
+// them, they are kept to undo the restore.
+const ArchivePrefix = "archive/"
+
+// etcdbr names snapshots <Full|Incr>-<startRevision>-<lastRevision>-<unix timestamp>
+var snapshotName = regexp.MustCompile(`^(Full|Incr)-(\d+)-(\d+)-(\d+)(\.gz)?$`)
+
+// SnapshotStore is the object store etcdbr writes the snapshots of a kluster to
+type SnapshotStore interface {
+	// List returns the snapshots etcdbr would restore from, sorted by revision
+	List() ([]models.EtcdSnapshot, error)
+	// Archive moves a snapshot below the ArchivePrefix
+	Archive(name, archive string) error
+}
+
+// ParseSnapshot tells if an object of the backup container is a snapshot
+func ParseSnapshot(object string, size int64) (models.EtcdSnapshot, bool) {
+	if strings.HasPrefix(object, ArchivePrefix) {
+		return models.EtcdSnapshot{}, false
+	}
+	match := snapshotName.FindStringSubmatch(path.Base(object))
+	if match == nil {
+		return models.EtcdSnapshot{}, false
+	}
+	startRevision, _ := strconv.ParseInt(match[2], 10, 64)
+	lastRevision, _ := strconv.ParseInt(match[3], 10, 64)
+	timestamp, _ := strconv.ParseInt(match[4], 10, 64)
+
+	kind := models.EtcdSnapshotKindFull
+	if match[1] == "Incr" {
+		kind = models.EtcdSnapshotKindDelta
+	}
+	return models.EtcdSnapshot{
+		Name:          object,
+		Kind:          kind,
+		StartRevision: startRevision,
+		LastRevision:  lastRevision,
+		CreatedAt:     time.Unix(timestamp, 0).UTC().Format(time.RFC3339),
+		Size:          size,
+	}, true
+}
+
+// SortSnapshots orders snapshots by revision, full snapshots before the deltas
+// ending at the same revision
+func SortSnapshots(snapshots []models.EtcdSnapshot) {
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		if snapshots[i].LastRevision != snapshots[j].LastRevision {
+			return snapshots[i].LastRevision < snapshots[j].LastRevision
+		}
+		if snapshots[i].Kind != snapshots[j].Kind {
+			return snapshots[i].Kind == models.EtcdSnapshotKindFull
+		}
+		return snapshots[i].Name < snapshots[j].Name
+	})
+}
+
+// FindSnapshot returns the snapshot with the given name
+func FindSnapshot(snapshots []models.EtcdSnapshot, name string) (models.EtcdSnapshot, bool) {
+	for _, snapshot := range snapshots {
+		if snapshot.Name == name {
+			return snapshot, true
+		}
+	}
+	return models.EtcdSnapshot{}, false
+}
+
+// SnapshotsAfter returns the snapshots which need to be archived so that
+// etcdbr restores the given one, i.e. it becomes the latest snapshot. The
+// order of the snapshots is kept.
+func SnapshotsAfter(snapshots []models.EtcdSnapshot, name string) ([]models.EtcdSnapshot, error) {
+	target, found := FindSnapshot(snapshots, name)
+	if !found {
+		return nil, fmt.Errorf("snapshot %s not found", name)
+	}
+
+	after := []models.EtcdSnapshot{}
+	for _, snapshot := range snapshots {
+		if snapshot.LastRevision > target.LastRevision {
+			after = append(after, snapshot)
+		}
+	}
+	return after, nil
+}
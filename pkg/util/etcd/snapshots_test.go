@@ -0,0 +1,69 @@
+package etcd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+func TestParseSnapshot(t *testing.T) {
+	snapshot, ok := ParseSnapshot("v1/Full-00000000-00012345-1700000000.gz", 42)
+	require.True(t, ok)
+	assert.Equal(t, models.EtcdSnapshot{
+		Name:          "v1/Full-00000000-00012345-1700000000.gz",
+		Kind:          models.EtcdSnapshotKindFull,
+		StartRevision: 0,
+		LastRevision:  12345,
+		CreatedAt:     "2023-11-14T22:13:20Z",
+		Size:          42,
+	}, snapshot)
+
+	snapshot, ok = ParseSnapshot("v1/Incr-00012346-00012400-1700000060", 1)
+	require.True(t, ok)
+	assert.Equal(t, models.EtcdSnapshotKindDelta, snapshot.Kind)
+	assert.EqualValues(t, 12346, snapshot.StartRevision)
+
+	for _, object := range []string{"archive/restore-1/v1/Full-00000000-00012345-1700000000.gz", "v1/something-else", "v1/"} {
+		_, ok := ParseSnapshot(object, 0)
+		assert.False(t, ok, object)
+	}
+}
+
+func TestSnapshotsAfter(t *testing.T) {
+	snapshots := []models.EtcdSnapshot{}
+	for _, object := range []string{
+		"v1/Incr-00000101-00000200-1700000060",
+		"v1/Full-00000000-00000300-1700000120",
+		"v1/Full-00000000-00000100-1700000000",
+		"v1/Incr-00000201-00000300-1700000120",
+	} {
+		snapshot, ok := ParseSnapshot(object, 0)
+		require.True(t, ok)
+		snapshots = append(snapshots, snapshot)
+	}
+	SortSnapshots(snapshots)
+	names := []string{}
+	for _, s := range snapshots {
+		names = append(names, s.Name)
+	}
+	assert.Equal(t, []string{
+		"v1/Full-00000000-00000100-1700000000",
+		"v1/Incr-00000101-00000200-1700000060",
+		"v1/Full-00000000-00000300-1700000120",
+		"v1/Incr-00000201-00000300-1700000120",
+	}, names)
+
+	after, err := SnapshotsAfter(snapshots, "v1/Incr-00000101-00000200-1700000060")
+	require.NoError(t, err)
+	assert.Len(t, after, 2)
+
+	after, err = SnapshotsAfter(snapshots, "v1/Incr-00000201-00000300-1700000120")
+	require.NoError(t, err)
+	assert.Empty(t, after)
+
+	_, err = SnapshotsAfter(snapshots, "v1/missing")
+	assert.Error(t, err)
+}
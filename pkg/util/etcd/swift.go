@@ -0,0 +1,75 @@
+package etcd
+
+import (
+	"errors"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+	"github.com/gophercloud/gophercloud/pagination"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+)
+
+type swiftSnapshotStore struct {
+	client    *gophercloud.ServiceClient
+	container string
+}
+
+// NewKlusterSnapshotStore accesses the backup container of the kluster, see
+// SwiftBackup. The provider needs to be authenticated as its service user.
+func NewKlusterSnapshotStore(provider *gophercloud.ProviderClient, kluster *v1.Kluster) (SnapshotStore, error) {
+	if !SwiftBackup(kluster) {
+		return nil, errors.New("backups of the kluster are not stored in its project")
+	}
+	container, _ := SwiftBackupContainer(kluster)
+	return NewSwiftSnapshotStore(provider, container.Region, container.Name)
+}
+
+// NewSwiftSnapshotStore accesses the snapshots in a Swift container, an empty
+// region selects the region of the provider
+func NewSwiftSnapshotStore(provider *gophercloud.ProviderClient, region, container string) (SnapshotStore, error) {
+	client, err := openstack.NewObjectStorageV1(provider, gophercloud.EndpointOpts{Region: region})
+	if err != nil {
+		return nil, err
+	}
+	return &swiftSnapshotStore{client: client, container: container}, nil
+}
+
+func (s *swiftSnapshotStore) List() ([]models.EtcdSnapshot, error) {
+	snapshots := []models.EtcdSnapshot{}
+	err := objects.List(s.client, s.container, objects.ListOpts{Full: true}).EachPage(func(page pagination.Page) (bool, error) {
+		infos, err := objects.ExtractInfo(page)
+		if err != nil {
+			return false, err
+		}
+		for _, info := range infos {
+			if snapshot, ok := ParseSnapshot(info.Name, info.Bytes); ok {
+				snapshots = append(snapshots, snapshot)
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	SortSnapshots(snapshots)
+	return snapshots, nil
+}
+
+func (s *swiftSnapshotStore) Archive(name, archive string) error {
+	destination := s.container + "/" + ArchivePrefix + archive + "/" + name
+	if _, err := objects.Copy(s.client, s.container, name, objects.CopyOpts{Destination: destination}).Extract(); err != nil {
+		return err
+	}
+	_, err := objects.Delete(s.client, s.container, name, nil).Extract()
+	return err
+}
+
+// VerifySwiftRegion checks that the catalog of the provider has an object
+// store in the region
+func VerifySwiftRegion(provider *gophercloud.ProviderClient, region string) error {
+	_, err := openstack.NewObjectStorageV1(provider, gophercloud.EndpointOpts{Region: region})
+	return err
+}
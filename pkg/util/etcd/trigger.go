@@ -0,0 +1,115 @@
+package etcd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+)
+
+const (
+	// FullSnapshotTimeout limits how long to wait for etcdbr to take and upload a snapshot
+	FullSnapshotTimeout = 5 * time.Minute
+	// MaxRecordedSnapshots is the number of snapshots kept in the status of a kluster
+	MaxRecordedSnapshots = 10
+	// SnapshotReuseWindow avoids another snapshot when a failed operation is retried
+	SnapshotReuseWindow = time.Hour
+	// backupPort is where the etcdbr sidecar serves its API
+	backupPort = "8080"
+)
+
+// etcdbrSnapshot is the response of the snapshot endpoints of etcdbr
+type etcdbrSnapshot struct {
+	Kind              string    `json:"kind"`
+	StartRevision     int64     `json:"startRevision"`
+	LastRevision      int64     `json:"lastRevision"`
+	CreatedOn         time.Time `json:"createdOn"`
+	Prefix            string    `json:"prefix"`
+	SnapDir           string    `json:"snapDir"`
+	SnapName          string    `json:"snapName"`
+	CompressionSuffix string    `json:"compressionSuffix"`
+}
+
+// BackupEnabled tells if the kluster runs the etcdbr sidecar
+func BackupEnabled(kluster *v1.Kluster) bool {
+	return kluster.Spec.Backup != models.KlusterSpecBackupOff
+}
+
+// TakeFullSnapshot asks the etcdbr sidecar of the kluster for a full
+// snapshot and returns once it is stored. The request is proxied by the
+// apiserver of the control plane.
+func TakeFullSnapshot(client kubernetes.Interface, kluster *v1.Kluster, reason string) (models.EtcdSnapshotReference, error) {
+	if !BackupEnabled(kluster) {
+		return models.EtcdSnapshotReference{}, errors.New("etcd backup is off")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), FullSnapshotTimeout)
+	defer cancel()
+	data, err := client.CoreV1().Services(kluster.Namespace).ProxyGet("http", kluster.GetName()+"-etcd", backupPort, "/snapshot/full", nil).DoRaw(ctx)
+	if err != nil {
+		return models.EtcdSnapshotReference{}, fmt.Errorf("full snapshot failed: %s", err)
+	}
+	var snapshot etcdbrSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return models.EtcdSnapshotReference{}, fmt.Errorf("couldn't parse snapshot %q: %s", string(data), err)
+	}
+	if snapshot.SnapName == "" {
+		return models.EtcdSnapshotReference{}, fmt.Errorf("etcdbr didn't return a snapshot: %s", string(data))
+	}
+
+	return models.EtcdSnapshotReference{
+		Name:      path.Join(snapshot.Prefix, snapshot.SnapDir, snapshot.SnapName) + snapshot.CompressionSuffix,
+		Revision:  snapshot.LastRevision,
+		CreatedAt: snapshot.CreatedOn.UTC().Format(time.RFC3339),
+		Reason:    reason,
+	}, nil
+}
+
+// RecordSnapshot adds a snapshot to the status of the kluster, only the
+// latest MaxRecordedSnapshots are kept
+func RecordSnapshot(kluster *v1.Kluster, snapshot models.EtcdSnapshotReference) {
+	snapshots := append(kluster.Status.EtcdSnapshots, snapshot)
+	if len(snapshots) > MaxRecordedSnapshots {
+		snapshots = snapshots[len(snapshots)-MaxRecordedSnapshots:]
+	}
+	kluster.Status.EtcdSnapshots = snapshots
+}
+
+// RecentSnapshot returns the latest snapshot recorded for the reason since
+// the given time. It avoids taking another snapshot when an operation is retried.
+func RecentSnapshot(kluster *v1.Kluster, reason string, since time.Time) (models.EtcdSnapshotReference, bool) {
+	for i := len(kluster.Status.EtcdSnapshots) - 1; i >= 0; i-- {
+		snapshot := kluster.Status.EtcdSnapshots[i]
+		if snapshot.Reason != reason {
+			continue
+		}
+		if created, err := time.Parse(time.RFC3339, snapshot.CreatedAt); err == nil && created.After(since) {
+			return snapshot, true
+		}
+	}
+	return models.EtcdSnapshotReference{}, false
+}
+
+// EnsureFullSnapshot takes a full snapshot before a risky operation unless
+// one was recorded for the same reason within SnapshotReuseWindow. A new
+// snapshot is passed to record which persists it in the status. Klusters
+// without backup are skipped.
+func EnsureFullSnapshot(client kubernetes.Interface, kluster *v1.Kluster, reason string, record func(models.EtcdSnapshotReference) error) (snapshot models.EtcdSnapshotReference, taken bool, err error) {
+	if !BackupEnabled(kluster) {
+		return snapshot, false, nil
+	}
+	if snapshot, found := RecentSnapshot(kluster, reason, time.Now().Add(-SnapshotReuseWindow)); found {
+		return snapshot, false, nil
+	}
+	if snapshot, err = TakeFullSnapshot(client, kluster, reason); err != nil {
+		return snapshot, false, err
+	}
+	return snapshot, true, record(snapshot)
+}
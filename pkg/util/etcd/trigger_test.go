@@ -0,0 +1,101 @@
+package etcd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	core "k8s.io/client-go/testing"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+)
+
+type rawResponse string
+
+func (r rawResponse) DoRaw(context.Context) ([]byte, error) {
+	return []byte(r), nil
+}
+
+func (r rawResponse) Stream(context.Context) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader(string(r))), nil
+}
+
+func etcdbrClient(calls *int) *fake.Clientset {
+	client := fake.NewSimpleClientset()
+	client.PrependProxyReactor("services", func(action core.Action) (bool, rest.ResponseWrapper, error) {
+		proxy := action.(core.ProxyGetAction)
+		if proxy.GetName() != "test-project-etcd" || proxy.GetPort() != "8080" || proxy.GetPath() != "/snapshot/full" {
+			return true, nil, errors.New("unexpected proxy request")
+		}
+		*calls++
+		return true, rawResponse(fmt.Sprintf(`{"kind":"Full","startRevision":0,"lastRevision":%d,"createdOn":"2020-10-19T10:00:00Z","prefix":"v2","snapDir":"Backup-1","snapName":"Full-00000000-00000042-1","compressionSuffix":".gz"}`, 41+*calls)), nil
+	})
+	return client
+}
+
+func TestTakeFullSnapshot(t *testing.T) {
+	var calls int
+	kluster := &v1.Kluster{ObjectMeta: meta_v1.ObjectMeta{Namespace: "kubernikus", Name: "test-project"}, Spec: models.KlusterSpec{Backup: "on"}}
+
+	snapshot, err := TakeFullSnapshot(etcdbrClient(&calls), kluster, "upgrade to 1.30.1")
+	if assert.NoError(t, err) {
+		assert.Equal(t, models.EtcdSnapshotReference{Name: "v2/Backup-1/Full-00000000-00000042-1.gz", Revision: 42, CreatedAt: "2020-10-19T10:00:00Z", Reason: "upgrade to 1.30.1"}, snapshot)
+	}
+
+	kluster.Spec.Backup = models.KlusterSpecBackupOff
+	_, err = TakeFullSnapshot(etcdbrClient(&calls), kluster, "upgrade to 1.30.1")
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestEnsureFullSnapshot(t *testing.T) {
+	var calls int
+	client := etcdbrClient(&calls)
+	kluster := &v1.Kluster{ObjectMeta: meta_v1.ObjectMeta{Namespace: "kubernikus", Name: "test-project"}, Spec: models.KlusterSpec{Backup: "on"}}
+	record := func(snapshot models.EtcdSnapshotReference) error {
+		RecordSnapshot(kluster, snapshot)
+		return nil
+	}
+
+	_, taken, err := EnsureFullSnapshot(client, kluster, "CA rotation", record)
+	assert.NoError(t, err)
+	assert.True(t, taken)
+	assert.Len(t, kluster.Status.EtcdSnapshots, 1)
+
+	// the snapshot is older than the reuse window
+	_, taken, err = EnsureFullSnapshot(client, kluster, "CA rotation", record)
+	assert.NoError(t, err)
+	assert.True(t, taken)
+	assert.Len(t, kluster.Status.EtcdSnapshots, 2)
+
+	kluster.Status.EtcdSnapshots[1].CreatedAt = time.Now().UTC().Format(time.RFC3339)
+	snapshot, taken, err := EnsureFullSnapshot(client, kluster, "CA rotation", record)
+	assert.NoError(t, err)
+	assert.False(t, taken)
+	assert.Equal(t, int64(43), snapshot.Revision)
+	assert.Equal(t, 2, calls)
+
+	_, taken, err = EnsureFullSnapshot(client, kluster, "migration", record)
+	assert.NoError(t, err)
+	assert.True(t, taken)
+	assert.Equal(t, 3, calls)
+}
+
+func TestRecordSnapshot(t *testing.T) {
+	kluster := &v1.Kluster{}
+	for i := 1; i <= MaxRecordedSnapshots+2; i++ {
+		RecordSnapshot(kluster, models.EtcdSnapshotReference{Revision: int64(i)})
+	}
+	assert.Len(t, kluster.Status.EtcdSnapshots, MaxRecordedSnapshots)
+	assert.Equal(t, int64(3), kluster.Status.EtcdSnapshots[0].Revision)
+	assert.Equal(t, int64(MaxRecordedSnapshots+2), kluster.Status.EtcdSnapshots[MaxRecordedSnapshots-1].Revision)
+}
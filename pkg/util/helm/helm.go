@@ -56,9 +56,21 @@ type etcdValues struct {
 }
 
 type etcdBackupValues struct {
-	Schedule        string `yaml:"schedule,omitempty" json:"schedule,omitempty"`
-	Enabled         bool   `yaml:"enabled" json:"enabled"`
-	StorageProvider string `yaml:"storageProvider,omitempty" json:"storageProvider,omitempty"`
+	Schedule                string        `yaml:"schedule,omitempty" json:"schedule,omitempty"`
+	Enabled                 bool          `yaml:"enabled" json:"enabled"`
+	StorageProvider         string        `yaml:"storageProvider,omitempty" json:"storageProvider,omitempty"`
+	GarbageCollectionPolicy string        `yaml:"garbageCollectionPolicy,omitempty" json:"garbageCollectionPolicy,omitempty"`
+	MaxBackups              int64         `yaml:"maxBackups,omitempty" json:"maxBackups,omitempty"`
+	S3                      *etcdS3Values `yaml:"s3,omitempty" json:"s3,omitempty"`
+}
+
+type etcdS3Values struct {
+	Endpoint        string `yaml:"endpoint" json:"endpoint"`
+	Bucket          string `yaml:"bucket" json:"bucket"`
+	Region          string `yaml:"region,omitempty" json:"region,omitempty"`
+	ForcePathStyle  bool   `yaml:"forcePathStyle,omitempty" json:"forcePathStyle,omitempty"`
+	AccessKeyID     string `yaml:"accessKeyID" json:"accessKeyID"`
+	SecretAccessKey string `yaml:"secretAccessKey" json:"secretAccessKey"`
 }
 
 type apiValues struct {
@@ -78,6 +90,10 @@ type dashboardValues struct {
 	Enabled bool `yaml:"enabled,omitempty" json:"enabled,omitempty"`
 }
 
+type controllerManagerValues struct {
+	PauseNodeEviction bool `yaml:"pauseNodeEviction,omitempty" json:"pauseNodeEviction,omitempty"`
+}
+
 type dexValues struct {
 	Enabled            bool           `yaml:"enabled,omitempty" json:"enabled,omitempty"`
 	StaticClientSecret string         `yaml:"staticClientSecret,omitempty" json:"staticClientSecret,omitempty"`
@@ -107,22 +123,23 @@ type staticPassword struct {
 }
 
 type kubernikusHelmValues struct {
-	Openstack        openstackValues        `yaml:"openstack,omitempty" json:"openstack,omitempty"`
-	Audit            string                 `yaml:"audit" json:"audit"`
-	ClusterCIDR      string                 `yaml:"clusterCIDR,omitempty" json:"clusterCIDR,omitempty"`
-	ServiceCIDR      string                 `yaml:"serviceCIDR,omitempty" json:"serviceCIDR,omitempty"`
-	AdvertiseAddress string                 `yaml:"advertiseAddress,omitempty" json:"advertiseAddress,omitempty"`
-	AdvertisePort    int64                  `yaml:"advertisePort,omitempty" json:"advertisePort,omitempty"`
-	BootstrapToken   string                 `yaml:"bootstrapToken,omitempty" json:"bootstrapToken,omitempty"`
-	Version          versionValues          `yaml:"version,omitempty" json:"version,omitempty"`
-	Etcd             etcdValues             `yaml:"etcd,omitempty" json:"etcd,omitempty"`
-	Api              apiValues              `yaml:"api,omitempty" json:"api,omitempty"`
-	Name             string                 `yaml:"name" json:"name"`
-	Account          string                 `yaml:"account" json:"account"`
-	SecretName       string                 `yaml:"secretName" json:"secretName"`
-	Images           version.KlusterVersion `yaml:"images" json:"images"`
-	Dex              dexValues              `yaml:"dex,omitempty" json:"dex,omitempty"`
-	Dashboard        dashboardValues        `yaml:"dashboard,omitempty" json:"dashboard,omitempty"`
+	Openstack         openstackValues         `yaml:"openstack,omitempty" json:"openstack,omitempty"`
+	Audit             string                  `yaml:"audit" json:"audit"`
+	ClusterCIDR       string                  `yaml:"clusterCIDR,omitempty" json:"clusterCIDR,omitempty"`
+	ServiceCIDR       string                  `yaml:"serviceCIDR,omitempty" json:"serviceCIDR,omitempty"`
+	AdvertiseAddress  string                  `yaml:"advertiseAddress,omitempty" json:"advertiseAddress,omitempty"`
+	AdvertisePort     int64                   `yaml:"advertisePort,omitempty" json:"advertisePort,omitempty"`
+	BootstrapToken    string                  `yaml:"bootstrapToken,omitempty" json:"bootstrapToken,omitempty"`
+	Version           versionValues           `yaml:"version,omitempty" json:"version,omitempty"`
+	Etcd              etcdValues              `yaml:"etcd,omitempty" json:"etcd,omitempty"`
+	Api               apiValues               `yaml:"api,omitempty" json:"api,omitempty"`
+	Name              string                  `yaml:"name" json:"name"`
+	Account           string                  `yaml:"account" json:"account"`
+	SecretName        string                  `yaml:"secretName" json:"secretName"`
+	Images            version.KlusterVersion  `yaml:"images" json:"images"`
+	Dex               dexValues               `yaml:"dex,omitempty" json:"dex,omitempty"`
+	Dashboard         dashboardValues         `yaml:"dashboard,omitempty" json:"dashboard,omitempty"`
+	ControllerManager controllerManagerValues `yaml:"controllerManager,omitempty" json:"controllerManager,omitempty"`
 }
 
 func KlusterToHelmValues(kluster *v1.Kluster, secret *v1.Secret, kubernetesVersion string, registry *version.ImageRegistry, accessMode string) (map[string]interface{}, error) {
@@ -184,7 +201,7 @@ func KlusterToHelmValues(kluster *v1.Kluster, secret *v1.Secret, kubernetesVersi
 				Schedule: fmt.Sprintf("%d * * * *", backupMinute),
 				// Default storage provider is Swift, add more providers here
 				StorageProvider: func(backupType string) string {
-					if backupType == "externalAWS" {
+					if backupType == "externalAWS" || etcd_util.S3Backup(kluster) != nil {
 						return "S3"
 					}
 					return "Swift"
@@ -216,6 +233,31 @@ func KlusterToHelmValues(kluster *v1.Kluster, secret *v1.Secret, kubernetesVersi
 			Enabled: conv.Value(kluster.Spec.Dashboard),
 		},
 		Dex: dex,
+		ControllerManager: controllerManagerValues{
+			PauseNodeEviction: kluster.NodeEvictionPaused(),
+		},
+	}
+	if backup, ok := etcd_util.SwiftBackupContainer(kluster); ok {
+		values.Etcd.StorageContainer = backup.Name
+		values.Etcd.Openstack.Region = backup.Region
+		if backup.ProjectID != secret.ProjectID {
+			values.Etcd.Openstack.ProjectID = backup.ProjectID
+			values.Etcd.Openstack.ProjectName = secret.Backup.SwiftProjectName
+		}
+	}
+	if s3 := etcd_util.S3Backup(kluster); s3 != nil {
+		values.Etcd.Backup.S3 = &etcdS3Values{
+			Endpoint:        conv.Value(s3.Endpoint),
+			Bucket:          conv.Value(s3.Bucket),
+			Region:          s3.Region,
+			ForcePathStyle:  s3.ForcePathStyle,
+			AccessKeyID:     secret.Backup.S3AccessKeyID,
+			SecretAccessKey: secret.Backup.S3SecretAccessKey,
+		}
+	}
+	if retention := etcd_util.BackupRetention(kluster); retention != nil {
+		values.Etcd.Backup.GarbageCollectionPolicy = retention.Policy
+		values.Etcd.Backup.MaxBackups = retention.MaxBackups
 	}
 	if registry != nil {
 		values.Images = registry.Versions[kubernetesVersion]
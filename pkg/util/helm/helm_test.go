@@ -3,8 +3,12 @@ package helm
 import (
 	"testing"
 
+	"github.com/go-openapi/swag/conv"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/sapcc/kubernikus/pkg/api/models"
 	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
 )
 
@@ -26,3 +30,40 @@ func TestKlusterToHelmValues(t *testing.T) {
 	// Make sure the object was overwritten
 	assert.Equal(t, mbck["backup"], "b")
 }
+
+func TestKlusterToHelmValuesBackupTarget(t *testing.T) {
+	kluster := &v1.Kluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-project", UID: "uid", Labels: map[string]string{"account": "project"}},
+		Spec: models.KlusterSpec{
+			Name:   "test",
+			Backup: "on",
+			BackupTarget: &models.BackupTarget{
+				Type:      conv.Pointer(models.BackupTargetTypeSwift),
+				Swift:     &models.BackupTargetSwift{Region: "eu-de-2", ProjectID: "dr"},
+				Retention: &models.BackupRetention{Policy: models.BackupRetentionPolicyLimitBased, MaxBackups: 24},
+			},
+		},
+	}
+	secret := &v1.Secret{Openstack: v1.Openstack{ProjectID: "project", ProjectName: "project-name"}, Backup: v1.Backup{SwiftProjectName: "dr-name"}}
+
+	values, err := KlusterToHelmValues(kluster, secret, "1.30.1", nil, "ReadWriteOnce")
+	require.NoError(t, err)
+	etcd := values["etcd"].(map[string]interface{})
+	assert.Equal(t, "kubernikus-etcd-backup-test-uid", etcd["storageContainer"])
+	assert.Equal(t, map[string]interface{}{"projectID": "dr", "projectName": "dr-name", "region": "eu-de-2"}, etcd["openstack"])
+	backup := etcd["backup"].(map[string]interface{})
+	assert.Equal(t, "Swift", backup["storageProvider"])
+	assert.Equal(t, "LimitBased", backup["garbageCollectionPolicy"])
+	assert.EqualValues(t, 24, backup["maxBackups"])
+
+	kluster.Spec.BackupTarget = &models.BackupTarget{
+		Type: conv.Pointer(models.BackupTargetTypeS3),
+		S3:   &models.BackupTargetS3{Endpoint: conv.Pointer("https://s3.example.com"), Bucket: conv.Pointer("bucket"), ForcePathStyle: true},
+	}
+	secret.Backup = v1.Backup{S3AccessKeyID: "id", S3SecretAccessKey: "secret"}
+	values, err = KlusterToHelmValues(kluster, secret, "1.30.1", nil, "ReadWriteOnce")
+	require.NoError(t, err)
+	backup = values["etcd"].(map[string]interface{})["backup"].(map[string]interface{})
+	assert.Equal(t, "S3", backup["storageProvider"])
+	assert.Equal(t, map[string]interface{}{"endpoint": "https://s3.example.com", "bucket": "bucket", "forcePathStyle": true, "accessKeyID": "id", "secretAccessKey": "secret"}, backup["s3"])
+}
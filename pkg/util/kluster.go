@@ -90,7 +90,17 @@ func EnsureKlusterSecret(client kubernetes.Interface, kluster *v1.Kluster) (*v1.
 	}
 	apiSecret, err := client.CoreV1().Secrets(kluster.Namespace).Create(context.TODO(), &s, meta_v1.CreateOptions{})
 	if apierrors.IsAlreadyExists(err) {
-		return KlusterSecret(client, kluster)
+		apiSecret, err = client.CoreV1().Secrets(kluster.Namespace).Get(context.TODO(), klusterSecretName(kluster), meta_v1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		// adopt a secret prepared by the api
+		if len(apiSecret.OwnerReferences) == 0 {
+			apiSecret.OwnerReferences = []meta_v1.OwnerReference{*klusterRef}
+			if apiSecret, err = client.CoreV1().Secrets(kluster.Namespace).Update(context.TODO(), apiSecret, meta_v1.UpdateOptions{}); err != nil {
+				return nil, err
+			}
+		}
 	}
 	if err != nil {
 		return nil, err
@@ -98,6 +108,25 @@ func EnsureKlusterSecret(client kubernetes.Interface, kluster *v1.Kluster) (*v1.
 	return v1.NewSecret(apiSecret)
 }
 
+// PrepareKlusterSecret creates the secret before the kluster exists, e.g. to
+// pass credentials given on creation. It fails if the secret already exists.
+// EnsureKlusterSecret adopts it once the kluster has been created.
+func PrepareKlusterSecret(client kubernetes.Interface, kluster *v1.Kluster, secret *v1.Secret) error {
+	data, err := secret.ToData()
+	if err != nil {
+		return fmt.Errorf("failed to serialize secret data: %s", err)
+	}
+	s := api_v1.Secret{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:   klusterSecretName(kluster),
+			Labels: kluster.Labels,
+		},
+		Data: data,
+	}
+	_, err = client.CoreV1().Secrets(kluster.Namespace).Create(context.TODO(), &s, meta_v1.CreateOptions{})
+	return err
+}
+
 // NOTE: this is not threadsafe (but we are only calling this once per kluster for the time being)
 func UpdateKlusterSecret(client kubernetes.Interface, kluster *v1.Kluster, secret *v1.Secret) error {
 	api_secret, err := client.CoreV1().Secrets(kluster.Namespace).Get(context.TODO(), klusterSecretName(kluster), meta_v1.GetOptions{})
@@ -173,3 +202,21 @@ func KlusterPodsReadyCount(kluster *v1.Kluster, podLister listers_core_v1.PodLis
 	return podsReady, len(pods), nil
 
 }
+
+// TerminateKluster moves the kluster into the terminating phase and issues a
+// delete request for the Kluster CRD.
+//
+// It actually adds a `metadata.DeletionTimestamp` to the Kluster. The Garbage-
+// Controller will pick up on that and delete the resource. But only when the
+// metadata.Finalizers array is empty. Until then the Kluster will keep on
+// existing.
+//
+// Kubernikus Controllers are required to add/remove Finalizers if clean-up is
+// required once a Kluster is deleted.
+func TerminateKluster(client clientset.KubernikusV1Interface, kluster *v1.Kluster) error {
+	if err := UpdateKlusterPhase(client, kluster, models.KlusterPhaseTerminating); err != nil {
+		return err
+	}
+	propagationPolicy := meta_v1.DeletePropagationBackground
+	return client.Klusters(kluster.Namespace).Delete(context.TODO(), kluster.Name, meta_v1.DeleteOptions{PropagationPolicy: &propagationPolicy})
+}
@@ -0,0 +1,95 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	api_v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+const (
+	TerminationReportLabel = "kubernikus.cloud.sap/termination-report"
+	terminationReportKey   = "report.json"
+
+	// TerminationReportRetention is how long reports are kept around
+	TerminationReportRetention = 30 * 24 * time.Hour
+)
+
+// SaveTerminationReport persists the report in a ConfigMap next to the
+// kluster. The ConfigMap is deliberately not owned by the kluster so it
+// survives the kluster's deletion. It is removed when a kluster with the same
+// name is created or by PruneTerminationReports.
+func SaveTerminationReport(client kubernetes.Interface, namespace, klusterName string, report *models.TerminationReport) error {
+	data, err := json.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("failed to serialize termination report: %s", err)
+	}
+	cm := &api_v1.ConfigMap{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name: terminationReportName(klusterName),
+			Labels: map[string]string{
+				TerminationReportLabel: "true",
+				"account":              report.Account,
+			},
+		},
+		Data: map[string]string{terminationReportKey: string(data)},
+	}
+	_, err = client.CoreV1().ConfigMaps(namespace).Create(context.TODO(), cm, meta_v1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		_, err = client.CoreV1().ConfigMaps(namespace).Update(context.TODO(), cm, meta_v1.UpdateOptions{})
+	}
+	return err
+}
+
+func TerminationReport(client kubernetes.Interface, namespace, klusterName string) (*models.TerminationReport, error) {
+	cm, err := client.CoreV1().ConfigMaps(namespace).Get(context.TODO(), terminationReportName(klusterName), meta_v1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	report := &models.TerminationReport{}
+	if err := json.Unmarshal([]byte(cm.Data[terminationReportKey]), report); err != nil {
+		return nil, fmt.Errorf("failed to parse termination report: %s", err)
+	}
+	return report, nil
+}
+
+// DeleteTerminationReport removes the report of a previous kluster with the
+// same name, a missing report is not an error
+func DeleteTerminationReport(client kubernetes.Interface, namespace, klusterName string) error {
+	err := client.CoreV1().ConfigMaps(namespace).Delete(context.TODO(), terminationReportName(klusterName), meta_v1.DeleteOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// PruneTerminationReports deletes reports older than maxAge and returns
+// the names of the deleted ConfigMaps
+func PruneTerminationReports(client kubernetes.Interface, namespace string, maxAge time.Duration) ([]string, error) {
+	list, err := client.CoreV1().ConfigMaps(namespace).List(context.TODO(), meta_v1.ListOptions{LabelSelector: TerminationReportLabel + "=true"})
+	if err != nil {
+		return nil, err
+	}
+	deleted := []string{}
+	for _, cm := range list.Items {
+		if time.Since(cm.CreationTimestamp.Time) < maxAge {
+			continue
+		}
+		if err := client.CoreV1().ConfigMaps(namespace).Delete(context.TODO(), cm.Name, meta_v1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return deleted, err
+		}
+		deleted = append(deleted, cm.Name)
+	}
+	return deleted, nil
+}
+
+func terminationReportName(klusterName string) string {
+	return klusterName + "-termination-report"
+}
@@ -0,0 +1,41 @@
+package util
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+func TestTerminationReportCleanup(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	for _, name := range []string{"old", "new", "recreated"} {
+		require.NoError(t, SaveTerminationReport(client, "kubernikus", name, &models.TerminationReport{Name: name, Account: "account"}))
+	}
+	// the fake clientset doesn't set creation timestamps
+	for name, created := range map[string]time.Time{"old": time.Now().Add(-2 * TerminationReportRetention), "new": time.Now()} {
+		cm, err := client.CoreV1().ConfigMaps("kubernikus").Get(context.Background(), name+"-termination-report", meta_v1.GetOptions{})
+		require.NoError(t, err)
+		cm.CreationTimestamp = meta_v1.NewTime(created)
+		_, err = client.CoreV1().ConfigMaps("kubernikus").Update(context.Background(), cm, meta_v1.UpdateOptions{})
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, DeleteTerminationReport(client, "kubernikus", "recreated"))
+	require.NoError(t, DeleteTerminationReport(client, "kubernikus", "recreated"), "a missing report is fine")
+	_, err := TerminationReport(client, "kubernikus", "recreated")
+	assert.Error(t, err)
+
+	deleted, err := PruneTerminationReports(client, "kubernikus", TerminationReportRetention)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"old-termination-report"}, deleted)
+	report, err := TerminationReport(client, "kubernikus", "new")
+	require.NoError(t, err)
+	assert.Equal(t, "new", report.Name)
+}
@@ -0,0 +1,194 @@
+package util
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	api_v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+
+	v1 "github.com/sapcc/kubernikus/pkg/apis/kubernikus/v1"
+)
+
+const userCertificatesKey = "registry.json"
+
+// ErrUserDenied is returned when issuing a certificate for a denied user
+var ErrUserDenied = errors.New("user is denied")
+
+// ErrUsersCAMissing is returned when issuing a user certificate before the
+// certs controller created the users CA
+var ErrUsersCAMissing = errors.New("users CA is missing")
+
+// IssuedUserCertificate is a client certificate handed out to a user
+type IssuedUserCertificate struct {
+	Serial    string    `json:"serial"`
+	User      string    `json:"user"`
+	IssuedAt  time.Time `json:"issuedAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	Revoked   bool      `json:"revoked,omitempty"`
+}
+
+// UserCertificates keeps track of the client certificates issued to the users
+// of a kluster and of the users which aren't allowed to get new ones. It is
+// stored in its own secret which is only written by the API.
+type UserCertificates struct {
+	Issued      []IssuedUserCertificate `json:"issued"`
+	DeniedUsers []string                `json:"deniedUsers"`
+}
+
+// Record adds an issued certificate, expired ones are dropped
+func (u *UserCertificates) Record(user, serial string, issuedAt, expiresAt time.Time) {
+	u.Prune(issuedAt)
+	u.Issued = append(u.Issued, IssuedUserCertificate{Serial: serial, User: user, IssuedAt: issuedAt, ExpiresAt: expiresAt})
+}
+
+// Prune drops all certificates expired at the given time
+func (u *UserCertificates) Prune(now time.Time) {
+	issued := []IssuedUserCertificate{}
+	for _, cert := range u.Issued {
+		if cert.ExpiresAt.After(now) {
+			issued = append(issued, cert)
+		}
+	}
+	u.Issued = issued
+}
+
+// Denied tells if the user is on the deny list
+func (u *UserCertificates) Denied(user string) bool {
+	for _, denied := range u.DeniedUsers {
+		if denied == user {
+			return true
+		}
+	}
+	return false
+}
+
+// Deny adds users to the deny list
+func (u *UserCertificates) Deny(users ...string) {
+	for _, user := range users {
+		if !u.Denied(user) {
+			u.DeniedUsers = append(u.DeniedUsers, user)
+		}
+	}
+	sort.Strings(u.DeniedUsers)
+}
+
+// Allow removes a user from the deny list and tells if it was on it
+func (u *UserCertificates) Allow(user string) bool {
+	for i, denied := range u.DeniedUsers {
+		if denied == user {
+			u.DeniedUsers = append(u.DeniedUsers[:i], u.DeniedUsers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// RevokeAll marks all valid certificates as revoked. Single certificates
+// can't be revoked, revocation replaces the users CA.
+func (u *UserCertificates) RevokeAll(now time.Time) {
+	u.Prune(now)
+	for i := range u.Issued {
+		u.Issued[i].Revoked = true
+	}
+}
+
+func userCertificatesSecretName(kluster *v1.Kluster) string {
+	return kluster.Name + "-user-certificates"
+}
+
+// GetUserCertificates returns the user certificates of the kluster, an empty
+// registry if there are none
+func GetUserCertificates(client kubernetes.Interface, kluster *v1.Kluster) (*UserCertificates, error) {
+	certs, _, err := getUserCertificates(client, kluster)
+	return certs, err
+}
+
+func getUserCertificates(client kubernetes.Interface, kluster *v1.Kluster) (*UserCertificates, *api_v1.Secret, error) {
+	certs := &UserCertificates{Issued: []IssuedUserCertificate{}, DeniedUsers: []string{}}
+	secret, err := client.CoreV1().Secrets(kluster.Namespace).Get(context.TODO(), userCertificatesSecretName(kluster), meta_v1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return certs, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if data := secret.Data[userCertificatesKey]; len(data) > 0 {
+		if err := json.Unmarshal(data, certs); err != nil {
+			return nil, nil, fmt.Errorf("failed to parse user certificates: %s", err)
+		}
+	}
+	return certs, secret, nil
+}
+
+// UpdateUserCertificatesWithRetries applies the update to the user
+// certificates of the kluster, concurrent updates are retried. The secret is
+// only written if the update changed it.
+func UpdateUserCertificatesWithRetries(client kubernetes.Interface, kluster *v1.Kluster, applyUpdate func(*UserCertificates) error) (*UserCertificates, error) {
+	var certs *UserCertificates
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		var secret *api_v1.Secret
+		var err error
+		certs, secret, err = getUserCertificates(client, kluster)
+		if err != nil {
+			return err
+		}
+		if err := applyUpdate(certs); err != nil {
+			return err
+		}
+		data, err := json.Marshal(certs)
+		if err != nil {
+			return err
+		}
+		if secret != nil && bytes.Equal(secret.Data[userCertificatesKey], data) {
+			return nil
+		}
+
+		if secret == nil {
+			secret = &api_v1.Secret{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Name:            userCertificatesSecretName(kluster),
+					Labels:          kluster.Labels,
+					OwnerReferences: []meta_v1.OwnerReference{*NewOwnerRef(kluster, v1.SchemeGroupVersion.WithKind("Kluster"))},
+				},
+				Data: map[string][]byte{userCertificatesKey: data},
+			}
+			_, err = client.CoreV1().Secrets(kluster.Namespace).Create(context.TODO(), secret, meta_v1.CreateOptions{})
+			if apierrors.IsAlreadyExists(err) {
+				return apierrors.NewConflict(api_v1.Resource("secrets"), secret.Name, err)
+			}
+			return err
+		}
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+		secret.Data[userCertificatesKey] = data
+		_, err = client.CoreV1().Secrets(kluster.Namespace).Update(context.TODO(), secret, meta_v1.UpdateOptions{})
+		return err
+	})
+	return certs, err
+}
+
+// RotateUsersCA replaces the users CA, which revokes all user certificates it signed
+func RotateUsersCA(kluster *v1.Kluster, store *v1.Certificates, revision string) error {
+	bundle, err := createCA(kluster.Name, "ApiServer Users", caKeyAlgorithm(kluster, "ApiServer Users"), nil, nil)
+	if err != nil {
+		return err
+	}
+	key, err := EncodePrivateKeyPEM(bundle.PrivateKey)
+	if err != nil {
+		return err
+	}
+	store.ApiserverUsersCACertificate = string(EncodeCertPEM(bundle.Certificate))
+	store.ApiserverUsersCAPrivateKey = string(key)
+	store.ApiserverUsersCARevision = revision
+	return nil
+}
@@ -49,7 +49,7 @@ func (s *CleanupTests) Run(t *testing.T) {
 }
 
 func (s *CleanupTests) TerminateCluster(t *testing.T) {
-	_, err := s.Kubernikus.Client.Operations.TerminateCluster(
+	_, _, err := s.Kubernikus.Client.Operations.TerminateCluster(
 		operations.NewTerminateClusterParams().WithName(s.KlusterName),
 		s.Kubernikus.AuthInfo,
 	)
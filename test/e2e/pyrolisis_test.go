@@ -71,7 +71,7 @@ func (p *PyrolisisTests) SettingKlustersOnFire(t *testing.T) {
 	for _, kluster := range klusters {
 		if strings.HasPrefix(kluster.Name, "e2e-") {
 			t.Run(fmt.Sprintf("TerminatingKluster-%v", kluster.Name), func(t *testing.T) {
-				_, err := p.Kubernikus.Client.Operations.TerminateCluster(
+				_, _, err := p.Kubernikus.Client.Operations.TerminateCluster(
 					operations.NewTerminateClusterParams().WithName(kluster.Name),
 					p.Kubernikus.AuthInfo,
 				)